@@ -0,0 +1,135 @@
+// Package cache 提供 Steam API 响应缓存能力
+// 包含内存 LRU/磁盘实现、按接口/方法解析 TTL 以及命中率统计; 公开类型见 pkg/cache
+// Package cache provides response caching for Steam API
+// Includes in-memory LRU/on-disk implementations, per interface/method TTL resolution and hit-ratio stats; public types live in pkg/cache
+
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	sdkcache "github.com/GoFurry/gf-steam-sdk/pkg/cache"
+)
+
+// 公开类型的别名, 内部实现与调用方共用同一套类型
+// Aliases of the public types so the internal implementation and callers share them
+type (
+	Entry = sdkcache.Entry
+	Cache = sdkcache.Cache
+	Stats = sdkcache.Stats
+)
+
+// evictionCounter 可选接口, 由会淘汰条目的实现提供
+// evictionCounter is an optional interface for implementations that evict entries
+type evictionCounter interface {
+	Evictions() uint64
+}
+
+// Manager 缓存管理器
+// 封装存储实现、TTL 解析和统计计数
+// Manager wraps a cache store with TTL resolution and stats counters
+type Manager struct {
+	store      Cache
+	defaultTTL time.Duration
+	ttls       map[string]time.Duration
+
+	hits        atomic.Uint64
+	misses      atomic.Uint64
+	revalidated atomic.Uint64
+	stores      atomic.Uint64
+}
+
+// NewManager 创建缓存管理器
+// 参数:
+//   - store: 缓存存储实现 | Cache store
+//   - defaultTTL: 默认 TTL(<=0 表示仅缓存 ttls 中配置的接口) | Default TTL (<=0 caches only names configured in ttls)
+//   - ttls: 按 "Interface/Method" 或 "Interface" 配置的 TTL | TTLs keyed by "Interface/Method" or "Interface"
+func NewManager(store Cache, defaultTTL time.Duration, ttls map[string]time.Duration) *Manager {
+	copied := make(map[string]time.Duration, len(ttls))
+	for k, v := range ttls {
+		copied[k] = v
+	}
+	return &Manager{store: store, defaultTTL: defaultTTL, ttls: copied}
+}
+
+// TTL 解析指定名称的 TTL
+// 优先匹配 "Interface/Method", 其次 "Interface", 最后使用默认 TTL; 返回值 <=0 表示不缓存
+// TTL resolves the TTL for a name
+// Matches "Interface/Method" first, then "Interface", then the default; a result <=0 means do not cache
+func (m *Manager) TTL(name string) time.Duration {
+	if ttl, ok := m.ttls[name]; ok {
+		return ttl
+	}
+	if i := strings.Index(name, "/"); i > 0 {
+		if ttl, ok := m.ttls[name[:i]]; ok {
+			return ttl
+		}
+	}
+	return m.defaultTTL
+}
+
+// Get 查询缓存, 返回条目及其是否有效; 有效计为命中, 否则计为未命中
+// Get looks up an entry and whether it is fresh; fresh counts as a hit, anything else as a miss
+func (m *Manager) Get(key string) (*Entry, bool) {
+	e, ok := m.store.Get(key)
+	if ok && e.Fresh(time.Now()) {
+		m.hits.Add(1)
+		return e, true
+	}
+	m.misses.Add(1)
+	if ok && !e.HasValidators() {
+		// 过期且无法条件请求的条目直接删除 | Expired entries without validators are useless
+		m.store.Delete(key)
+		return nil, false
+	}
+	return e, false
+}
+
+// Put 写入缓存条目的副本, 过期时间为当前时间 + ttl
+// 传入的条目不会被修改, 可以是其他 goroutine 正在读取的、由 Get 返回的条目
+// Put stores a copy of the entry expiring after ttl
+// The entry passed in is never modified, so it may be one returned by Get that other goroutines are still reading
+func (m *Manager) Put(key string, e *Entry, ttl time.Duration) {
+	stored := *e
+	stored.ExpiresAt = time.Now().Add(ttl)
+	m.store.Set(key, &stored)
+	m.stores.Add(1)
+}
+
+// Revalidate 条件请求返回 304 后刷新条目有效期
+// Revalidate extends an entry after a conditional request returned 304
+func (m *Manager) Revalidate(key string, e *Entry, ttl time.Duration) {
+	m.revalidated.Add(1)
+	m.Put(key, e, ttl)
+}
+
+// Stats 获取统计快照
+// Stats returns a snapshot of the counters
+func (m *Manager) Stats() Stats {
+	s := Stats{
+		Hits:        m.hits.Load(),
+		Misses:      m.misses.Load(),
+		Revalidated: m.revalidated.Load(),
+		Stores:      m.stores.Load(),
+		Entries:     m.store.Len(),
+	}
+	if ec, ok := m.store.(evictionCounter); ok {
+		s.Evictions = ec.Evictions()
+	}
+	return s
+}
+
+// Key 由多个部分生成缓存键(SHA-256 十六进制), 避免 API Key/Token 明文落盘
+// Key builds a cache key (hex SHA-256) from parts so that API keys/tokens are never stored in plain text
+func Key(parts ...string) string {
+	h := sha256.New()
+	for _, p := range parts {
+		h.Write([]byte(p))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
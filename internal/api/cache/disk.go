@@ -0,0 +1,95 @@
+package cache
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/bytedance/sonic"
+)
+
+// diskExt 磁盘缓存文件后缀 | Disk cache file extension
+const diskExt = ".json"
+
+// Disk 磁盘缓存
+// 每个条目保存为一个 JSON 文件, 文件名为缓存键, 进程重启后仍然有效
+// Disk is an on-disk cache
+// Each entry is a JSON file named after its key, so entries survive process restarts
+type Disk struct {
+	dir string
+}
+
+// NewDisk 创建磁盘缓存, 目录不存在时自动创建
+// 参数:
+//   - dir: 缓存目录 | Cache directory
+//
+// 返回值:
+//   - *Disk: 磁盘缓存实例 | Disk cache instance
+//   - error: 创建目录失败时返回错误 | Error if the directory cannot be created
+func NewDisk(dir string) (*Disk, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("create cache dir failed: %w", err)
+	}
+	return &Disk{dir: dir}, nil
+}
+
+// path 缓存键对应的文件路径 | File path of a cache key
+func (d *Disk) path(key string) string {
+	return filepath.Join(d.dir, key+diskExt)
+}
+
+// Get 实现 Cache 接口, 文件损坏时视为不存在
+func (d *Disk) Get(key string) (*Entry, bool) {
+	data, err := os.ReadFile(d.path(key))
+	if err != nil {
+		return nil, false
+	}
+	var e Entry
+	if err := sonic.Unmarshal(data, &e); err != nil {
+		_ = os.Remove(d.path(key))
+		return nil, false
+	}
+	return &e, true
+}
+
+// Set 实现 Cache 接口, 先写临时文件再重命名, 避免并发读到半写入的文件
+func (d *Disk) Set(key string, e *Entry) {
+	data, err := sonic.Marshal(e)
+	if err != nil {
+		return
+	}
+	tmp, err := os.CreateTemp(d.dir, key+".*.tmp")
+	if err != nil {
+		return
+	}
+	_, werr := tmp.Write(data)
+	cerr := tmp.Close()
+	if werr != nil || cerr != nil {
+		_ = os.Remove(tmp.Name())
+		return
+	}
+	if err := os.Rename(tmp.Name(), d.path(key)); err != nil {
+		_ = os.Remove(tmp.Name())
+	}
+}
+
+// Delete 实现 Cache 接口
+func (d *Disk) Delete(key string) {
+	_ = os.Remove(d.path(key))
+}
+
+// Len 实现 Cache 接口
+func (d *Disk) Len() int {
+	entries, err := os.ReadDir(d.dir)
+	if err != nil {
+		return 0
+	}
+	n := 0
+	for _, e := range entries {
+		if !e.IsDir() && strings.HasSuffix(e.Name(), diskExt) {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,89 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"sync/atomic"
+)
+
+// Memory 内存 LRU 缓存
+// 超出容量时淘汰最久未使用的条目
+// Memory is an in-memory LRU cache
+// Evicts the least recently used entry once capacity is exceeded
+type Memory struct {
+	mu        sync.Mutex
+	capacity  int
+	ll        *list.List               // 最近使用在前 | Most recently used first
+	items     map[string]*list.Element // 键 -> 链表节点 | Key -> list element
+	evictions atomic.Uint64
+}
+
+// memoryItem 链表节点数据 | List element payload
+type memoryItem struct {
+	key   string
+	entry *Entry
+}
+
+// NewMemory 创建内存 LRU 缓存
+// 参数:
+//   - capacity: 最大条目数(<=0 表示不限制) | Max entries (<=0 means unbounded)
+func NewMemory(capacity int) *Memory {
+	return &Memory{
+		capacity: capacity,
+		ll:       list.New(),
+		items:    make(map[string]*list.Element),
+	}
+}
+
+// Get 实现 Cache 接口
+func (m *Memory) Get(key string) (*Entry, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	el, ok := m.items[key]
+	if !ok {
+		return nil, false
+	}
+	m.ll.MoveToFront(el)
+	return el.Value.(*memoryItem).entry, true
+}
+
+// Set 实现 Cache 接口
+func (m *Memory) Set(key string, e *Entry) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if el, ok := m.items[key]; ok {
+		el.Value.(*memoryItem).entry = e
+		m.ll.MoveToFront(el)
+		return
+	}
+	m.items[key] = m.ll.PushFront(&memoryItem{key: key, entry: e})
+	for m.capacity > 0 && m.ll.Len() > m.capacity {
+		oldest := m.ll.Back()
+		m.ll.Remove(oldest)
+		delete(m.items, oldest.Value.(*memoryItem).key)
+		m.evictions.Add(1)
+	}
+}
+
+// Delete 实现 Cache 接口
+func (m *Memory) Delete(key string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if el, ok := m.items[key]; ok {
+		m.ll.Remove(el)
+		delete(m.items, key)
+	}
+}
+
+// Len 实现 Cache 接口
+func (m *Memory) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.ll.Len()
+}
+
+// Evictions 累计淘汰次数
+// Evictions returns the number of LRU evictions so far
+func (m *Memory) Evictions() uint64 {
+	return m.evictions.Load()
+}
@@ -3,15 +3,19 @@
 package api
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/url"
 
 	"github.com/GoFurry/gf-steam-sdk/internal/client"
+	"github.com/GoFurry/gf-steam-sdk/pkg/option"
 	ue "github.com/GoFurry/gf-steam-sdk/pkg/util/errors"
 	"github.com/bytedance/sonic"
 )
 
-// GetRawBytes executes an API request and returns the serialized byte array 执行API请求并返回序列化后的字节数组
+// GetRawBytes executes an API request and returns the raw response body 执行API请求并返回原始响应体
 //
 // 参数说明 (Parameters):
 //
@@ -19,27 +23,34 @@ import (
 //	method - HTTP请求方法（如GET/POST/PUT等） (HTTP request method (e.g. GET/POST/PUT))
 //	url - 请求的目标URL地址 (Target URL address for the request)
 //	params - URL查询参数 (URL query parameters)
+//	opts - 单次请求配置, 覆盖全局配置 (Per-request options overriding global config)
 //
 // 返回值 (Returns):
 //
-//	respBytes - API响应序列化后的字节数组 (Serialized byte array of API response)
+//	respBytes - API原始响应体 (Raw API response body)
 //	err - 执行过程中的错误，包含：
 //	      1. 客户端请求错误(如网络错误、超时)
-//	      2. 响应数据序列化错误(包装ue.ErrAPIResponse)
+//	      2. 响应不是合法 JSON(包装ue.ErrAPIResponse)
 //	err - Error during execution, including:
 //	      1. Client request errors (e.g. network error, timeout)
-//	      2. Response data serialization error (wrapped with ue.ErrAPIResponse)
-func GetRawBytes(c *client.Client, method, url string, params url.Values) (respBytes []byte, err error) {
-	// 执行请求
-	resp, err := c.DoRequest(method, url, params)
+//	      2. Response is not valid JSON (wrapped with ue.ErrAPIResponse)
+func GetRawBytes(c *client.Client, method, url string, params url.Values, opts ...option.RequestOption) (respBytes []byte, err error) {
+	return GetRawBytesCtx(context.Background(), c, method, url, params, opts...)
+}
+
+// GetRawBytesCtx is the context-aware variant of GetRawBytes 支持 context 的 GetRawBytes
+// ctx 会传递到限流等待、HTTP 请求、重试与退避休眠中
+// ctx is carried through to rate-limiter waits, HTTP requests, retries and backoff sleeps
+func GetRawBytesCtx(ctx context.Context, c *client.Client, method, url string, params url.Values, opts ...option.RequestOption) (respBytes []byte, err error) {
+	// 执行请求, 直接返回原始响应体
+	respBytes, err = c.DoRequestRawCtx(ctx, method, url, params, opts...)
 	if err != nil {
-		return respBytes, err
+		return nil, err
 	}
 
-	// 转换为字节
-	respBytes, err = sonic.Marshal(resp)
-	if err != nil {
-		return respBytes, fmt.Errorf("%w: marshal resp failed: %v", ue.ErrAPIResponse, err)
+	// 校验 JSON 合法性(仅扫描, 不分配)
+	if !sonic.Valid(respBytes) {
+		return nil, fmt.Errorf("%w: invalid json response", ue.ErrAPIResponse)
 	}
 	return respBytes, nil
 }
@@ -62,28 +73,43 @@ func GetRawBytes(c *client.Client, method, url string, params url.Values) (respB
 //	method - HTTP请求方法（如GET/POST/PUT等） (HTTP request method (e.g. GET/POST/PUT))
 //	reqUrl - 请求的目标URL地址 (Target URL address for the request)
 //	params - URL查询参数 (URL query parameters)
+//	opts - 单次请求配置, 覆盖全局配置 (Per-request options overriding global config)
 //
 // 返回值 (Returns):
 //
 //	T - 反序列化后的目标类型实例，错误时返回该类型的零值
 //	err - 执行过程中的错误，包含：
-//	      1. GetRawBytes返回的所有错误类型
-//	      2. 响应数据反序列化错误(包装ue.ErrAPIResponse)
+//	      1. 客户端请求错误(如网络错误、超时)
+//	      2. 响应软失败(如 ue.ErrPrivateProfile、ue.ErrNoStats, 携带接口名)
+//	      3. 响应数据反序列化错误(包装ue.ErrAPIResponse)
 //	T - Instance of the target type after deserialization, returns zero value of the type on error
 //	err - Error during execution, including:
-//	      1. All error types returned by GetRawBytes
-//	      2. Response data deserialization error (wrapped with ue.ErrAPIResponse)
-func GetRawModel[T any](c *client.Client, method, reqUrl string, params url.Values) (T, error) {
+//	      1. Client request errors (e.g. network error, timeout)
+//	      2. Soft failures in the response (e.g. ue.ErrPrivateProfile, ue.ErrNoStats, carrying the endpoint)
+//	      3. Response data deserialization error (wrapped with ue.ErrAPIResponse)
+func GetRawModel[T any](c *client.Client, method, reqUrl string, params url.Values, opts ...option.RequestOption) (T, error) {
+	return GetRawModelCtx[T](context.Background(), c, method, reqUrl, params, opts...)
+}
+
+// GetRawModelCtx is the context-aware variant of GetRawModel 支持 context 的 GetRawModel
+// ctx 会传递到限流等待、HTTP 请求、重试与退避休眠中
+// ctx is carried through to rate-limiter waits, HTTP requests, retries and backoff sleeps
+func GetRawModelCtx[T any](ctx context.Context, c *client.Client, method, reqUrl string, params url.Values, opts ...option.RequestOption) (T, error) {
 	// 定义零值
 	var zero T
 
-	// 获取原始字节数据
-	bytes, err := GetRawBytes(c, method, reqUrl, params)
+	// 获取原始响应体(只读取一次)
+	bytes, err := c.DoRequestRawCtx(ctx, method, reqUrl, params, opts...)
 	if err != nil {
 		return zero, err
 	}
 
-	// 反序列化为传入的泛型类型
+	// 识别 200 响应中的软失败(资料未公开、无统计数据等)
+	if err = Inspect(reqUrl, bytes); err != nil {
+		return zero, err
+	}
+
+	// 直接反序列化为传入的泛型类型(非法 JSON 在此报错)
 	var resp T
 	if err = sonic.Unmarshal(bytes, &resp); err != nil {
 		return zero, fmt.Errorf("%w: unmarshal %T resp failed: %v", ue.ErrAPIResponse, resp, err)
@@ -91,3 +117,83 @@ func GetRawModel[T any](c *client.Client, method, reqUrl string, params url.Valu
 
 	return resp, nil
 }
+
+// StreamArrayCtx streams a JSON array out of the response and decodes it element by element 流式解析响应中的 JSON 数组并逐个元素反序列化
+// 响应体不会整体读入内存, 适用于 ISteamApps/GetAppList 等超大响应; 流式解析使用标准库 encoding/json 的 Token API
+// The body is never held in memory as a whole, which suits huge responses such as ISteamApps/GetAppList;
+// streaming relies on the token API of the standard encoding/json package
+//
+// 泛型参数 (Generic Parameters):
+//
+//	T - 数组元素类型 (Array element type)
+//
+// 参数说明 (Parameters):
+//
+//	c - 客户端实例 (Client instance)
+//	method - HTTP请求方法 (HTTP request method)
+//	reqUrl - 请求的目标URL地址 (Target URL address for the request)
+//	params - URL查询参数 (URL query parameters)
+//	path - 数组所在的对象键路径, 如 []string{"applist", "apps"} (Object key path of the array, e.g. []string{"applist", "apps"})
+//	fn - 每个元素的回调, 返回 false 停止解析 (Called for each element, return false to stop)
+//	opts - 单次请求配置, 覆盖全局配置 (Per-request options overriding global config)
+//
+// 返回值 (Returns):
+//
+//	err - 客户端请求错误, 或响应结构不符时包装 ue.ErrAPIResponse 的错误
+//	err - Client request errors, or an error wrapping ue.ErrAPIResponse if the response does not match the path
+func StreamArrayCtx[T any](ctx context.Context, c *client.Client, method, reqUrl string, params url.Values, path []string, fn func(T) bool, opts ...option.RequestOption) error {
+	return c.DoRequestStreamCtx(ctx, method, reqUrl, params, func(body io.Reader) error {
+		dec := json.NewDecoder(body)
+		for _, key := range path {
+			if err := seekKey(dec, key); err != nil {
+				return fmt.Errorf("%w: %v", ue.ErrAPIResponse, err)
+			}
+		}
+		if err := expectDelim(dec, '['); err != nil {
+			return fmt.Errorf("%w: %v", ue.ErrAPIResponse, err)
+		}
+		for dec.More() {
+			var item T
+			if err := dec.Decode(&item); err != nil {
+				return fmt.Errorf("%w: decode %T item failed: %v", ue.ErrAPIResponse, item, err)
+			}
+			if !fn(item) {
+				return nil
+			}
+		}
+		return nil
+	}, opts...)
+}
+
+// seekKey 进入当前对象并定位到指定键的值, 跳过其他键 | Enter the current object and stop at the value of key, skipping other keys
+func seekKey(dec *json.Decoder, key string) error {
+	if err := expectDelim(dec, '{'); err != nil {
+		return err
+	}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		if name, _ := tok.(string); name == key {
+			return nil
+		}
+		var skip json.RawMessage
+		if err = dec.Decode(&skip); err != nil {
+			return err
+		}
+	}
+	return fmt.Errorf("key %q not found", key)
+}
+
+// expectDelim 读取下一个分隔符并校验 | Read the next delimiter and check it
+func expectDelim(dec *json.Decoder, want json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if d, ok := tok.(json.Delim); !ok || d != want {
+		return fmt.Errorf("expected %q, got %v", want, tok)
+	}
+	return nil
+}
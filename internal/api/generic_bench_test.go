@@ -0,0 +1,200 @@
+package api_test
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/GoFurry/gf-steam-sdk/internal/api"
+	"github.com/GoFurry/gf-steam-sdk/internal/client"
+	"github.com/GoFurry/gf-steam-sdk/pkg/models"
+	"github.com/GoFurry/gf-steam-sdk/pkg/steamtest"
+	"github.com/GoFurry/gf-steam-sdk/pkg/util"
+	"github.com/bytedance/sonic"
+)
+
+// 对比旧路径(map 解码 -> 重新序列化 -> 反序列化为模型)与新路径(原始字节直接反序列化为模型)在大响应上的耗时和内存分配
+// Compares the legacy path (decode into map -> re-marshal -> unmarshal into model) with the new path
+// (raw bytes straight into the model) on large responses
+//
+//	go test -run '^$' -bench . -benchmem ./internal/api
+
+const (
+	ownedGamesCount = 5000 // 模拟 5k 游戏的游戏库 | Simulated 5k-game library
+	sharedAppsCount = 3000 // 模拟家庭共享库应用数 | Simulated family shared library size
+
+	ownedGamesEndpoint = "IPlayerService/GetOwnedGames"
+	sharedAppsEndpoint = "IFamilyGroupsService/GetSharedLibraryApps"
+)
+
+func BenchmarkDecode(b *testing.B) {
+	b.Run("GetOwnedGames5k", func(b *testing.B) {
+		benchDecode[models.SteamOwnedGamesResponse](b, ownedGamesFixture(ownedGamesCount))
+	})
+	b.Run("GetSharedLibraryApps3k", func(b *testing.B) {
+		benchDecode[models.FamilySharedLibraryResponse](b, sharedAppsFixture(sharedAppsCount))
+	})
+}
+
+func BenchmarkGetRawModel(b *testing.B) {
+	srv := steamtest.NewServer()
+	defer srv.Close()
+	srv.SetFixture(ownedGamesEndpoint, ownedGamesFixture(ownedGamesCount))
+	srv.SetFixture(sharedAppsEndpoint, sharedAppsFixture(sharedAppsCount))
+
+	// 放开限速, 只测量请求与解码路径 | Lift the rate limit so only the request and decode path is measured
+	c, err := client.NewClient(srv.Config().WithRateLimit(1e9, 1e9))
+	if err != nil {
+		b.Fatalf("create client: %v", err)
+	}
+	defer c.Close()
+
+	b.Run("GetOwnedGames5k", func(b *testing.B) {
+		benchRequest[models.SteamOwnedGamesResponse](b, c, util.STEAM_API_BASE_URL+ownedGamesEndpoint+"/v1/")
+	})
+	b.Run("GetSharedLibraryApps3k", func(b *testing.B) {
+		benchRequest[models.FamilySharedLibraryResponse](b, c, util.STEAM_API_BASE_URL+sharedAppsEndpoint+"/v1/")
+	})
+}
+
+// benchDecode 对同一样本执行新旧解码路径 | Run the legacy and direct decode paths on one fixture
+func benchDecode[T any](b *testing.B, body []byte) {
+	b.Run("legacy", func(b *testing.B) {
+		b.ReportAllocs()
+		b.SetBytes(int64(len(body)))
+		for i := 0; i < b.N; i++ {
+			if _, err := legacyDecode[T](body); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("direct", func(b *testing.B) {
+		b.ReportAllocs()
+		b.SetBytes(int64(len(body)))
+		for i := 0; i < b.N; i++ {
+			var v T
+			if err := sonic.Unmarshal(body, &v); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+// benchRequest 对同一接口执行新旧完整请求路径 | Run the legacy and direct request paths on one endpoint
+func benchRequest[T any](b *testing.B, c *client.Client, reqURL string) {
+	params := url.Values{"steamid": {steamtest.SteamID}}
+	b.Run("legacy", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := legacyGetRawModel[T](c, reqURL, params); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("direct", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := api.GetRawModel[T](c, "GET", reqURL, params); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+// legacyDecode 旧解码路径: map -> Marshal -> Unmarshal
+// legacyDecode is the legacy decoding path: map -> Marshal -> Unmarshal
+func legacyDecode[T any](body []byte) (T, error) {
+	var zero T
+	var m map[string]interface{}
+	if err := sonic.Unmarshal(body, &m); err != nil {
+		return zero, err
+	}
+	data, err := sonic.Marshal(m)
+	if err != nil {
+		return zero, err
+	}
+	var v T
+	if err := sonic.Unmarshal(data, &v); err != nil {
+		return zero, err
+	}
+	return v, nil
+}
+
+// legacyGetRawModel 旧请求路径: DoRequest 解码为 map 后再转换为模型
+// legacyGetRawModel is the legacy request path: DoRequest decodes into a map, then converts to the model
+func legacyGetRawModel[T any](c *client.Client, reqURL string, params url.Values) (T, error) {
+	var zero T
+	m, err := c.DoRequestCtx(context.Background(), "GET", reqURL, params)
+	if err != nil {
+		return zero, err
+	}
+	data, err := sonic.Marshal(m)
+	if err != nil {
+		return zero, err
+	}
+	var v T
+	if err := sonic.Unmarshal(data, &v); err != nil {
+		return zero, err
+	}
+	return v, nil
+}
+
+// ownedGamesFixture 生成 GetOwnedGames 样本 | Build a GetOwnedGames fixture
+func ownedGamesFixture(n int) []byte {
+	games := make([]map[string]interface{}, 0, n)
+	for i := 0; i < n; i++ {
+		games = append(games, map[string]interface{}{
+			"appid":                       10 + i*10,
+			"name":                        fmt.Sprintf("Game Title Number %d", i),
+			"playtime_2weeks":             i % 120,
+			"playtime_forever":            i * 37,
+			"img_icon_url":                "0123456789abcdef0123456789abcdef01234567",
+			"has_community_visible_stats": i%2 == 0,
+			"playtime_windows_forever":    i * 30,
+			"playtime_mac_forever":        i % 7,
+			"playtime_linux_forever":      i % 11,
+			"playtime_deck_forever":       i % 13,
+			"rtime_last_played":           1698822000 + i,
+			"capsule_filename":            "capsule_sm_120.jpg",
+			"has_workshop":                i%3 == 0,
+			"has_market":                  i%5 == 0,
+			"has_dlc":                     i%4 == 0,
+			"content_descriptorids":       []int{2, 5},
+			"playtime_disconnected":       0,
+		})
+	}
+	return mustMarshal(map[string]interface{}{
+		"response": map[string]interface{}{"game_count": n, "games": games},
+	})
+}
+
+// sharedAppsFixture 生成 GetSharedLibraryApps 样本 | Build a GetSharedLibraryApps fixture
+func sharedAppsFixture(n int) []byte {
+	var resp models.FamilySharedLibraryResponse
+	resp.Response.OwnerSteamID = "76561198000000000"
+	resp.Response.Apps = make([]models.FamilySharedLibraryApp, 0, n)
+	for i := 0; i < n; i++ {
+		resp.Response.Apps = append(resp.Response.Apps, models.FamilySharedLibraryApp{
+			AppID:           uint64(10 + i*10),
+			OwnerSteamIDs:   []string{"76561198000000000", "76561198000000001"},
+			Name:            fmt.Sprintf("Shared App Number %d", i),
+			CapsuleFilename: "library_capsule.jpg",
+			ImgIconHash:     "0123456789abcdef0123456789abcdef01234567",
+			RtTimeAcquired:  int64(1600000000 + i),
+			RtLastPlayed:    int64(1698822000 + i),
+			RtPlaytime:      int64(i * 17),
+			AppType:         1,
+		})
+	}
+	return mustMarshal(resp)
+}
+
+// mustMarshal 序列化样本, 失败直接 panic | Marshal a fixture, panic on failure
+func mustMarshal(v interface{}) []byte {
+	data, err := sonic.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
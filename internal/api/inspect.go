@@ -0,0 +1,284 @@
+package api
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/GoFurry/gf-steam-sdk/internal/client"
+	ue "github.com/GoFurry/gf-steam-sdk/pkg/util/errors"
+	"github.com/bytedance/sonic"
+)
+
+// Inspector 检查 HTTP 200 响应中的"软失败"
+// Steam 经常以 200 返回空响应或 success=false, 检查函数将其转换为类型化错误, 无问题时返回 nil
+// Inspector checks an HTTP 200 response for Steam "soft failures"
+// Steam often answers 200 with an empty response or success=false; an inspector maps those to typed errors and returns nil otherwise
+type Inspector func(body []byte) error
+
+var (
+	inspectorsMu sync.RWMutex
+	// inspectors 接口名("Interface/Method") -> 检查函数 | Endpoint ("Interface/Method") -> inspector
+	inspectors = map[string]Inspector{
+		"IPlayerService/GetOwnedGames":              inspectEmptyResponse,
+		"IPlayerService/GetRecentlyPlayedGames":     inspectEmptyResponse,
+		"IPlayerService/GetSteamLevel":              inspectEmptyResponse,
+		"IPlayerService/GetBadges":                  inspectEmptyResponse,
+		"IPlayerService/GetCommunityBadgeProgress":  inspectEmptyResponse,
+		"ISteamUserStats/GetPlayerAchievements":     inspectPlayerStats,
+		"ISteamUser/ResolveVanityURL":               inspectVanityURL,
+		"ISteamUser/GetUserGroupList":               inspectSuccessFlag,
+		"ISteamApps/UpToDateCheck":                  inspectUpToDateCheck,
+		"ISteamApps/GetServersAtAddress":            inspectServersAtAddress,
+		"ISteamUserStats/GetSchemaForGame":          inspectGameSchema,
+		"ISteamUserStats/GetUserStatsForGame":       inspectPlayerStats,
+		"ISteamUserStats/GetNumberOfCurrentPlayers": inspectResultCode,
+		"api/appdetails":                            inspectStoreDetails(ue.ErrAppNotFound),
+		"api/packagedetails":                        inspectStoreDetails(ue.ErrPackageNotFound),
+		"appreviews":                                inspectSuccessCode("app reviews query"),
+		"search/results":                            inspectSuccessCode("search query"),
+	}
+)
+
+// RegisterInspector 为接口注册响应检查函数, 重复注册会覆盖
+// 参数:
+//   - endpoint: 接口名, 格式为 "Interface/Method" | Endpoint name in "Interface/Method" form
+//   - fn: 检查函数 | Inspector
+func RegisterInspector(endpoint string, fn Inspector) {
+	inspectorsMu.Lock()
+	defer inspectorsMu.Unlock()
+	inspectors[endpoint] = fn
+}
+
+// Inspect 按接口检查响应体, 返回的错误携带接口名
+// 参数:
+//   - reqUrl: 请求地址 | Request URL
+//   - body: 响应体 | Response body
+//
+// 返回值:
+//   - error: 识别到软失败时返回类型化错误, 否则返回 nil | Typed error if a soft failure is recognized, nil otherwise
+func Inspect(reqUrl string, body []byte) error {
+	u, err := url.Parse(reqUrl)
+	if err != nil {
+		return nil
+	}
+	endpoint := client.EndpointName(u)
+
+	inspectorsMu.RLock()
+	fn, ok := inspectors[endpoint]
+	inspectorsMu.RUnlock()
+	if !ok {
+		return nil
+	}
+	if err := fn(body); err != nil {
+		return ue.WithEndpoint(err, endpoint)
+	}
+	return nil
+}
+
+// inspectEmptyResponse 识别 {"response":{}}, 资料未公开时 Steam 以此代替错误
+// inspectEmptyResponse recognizes {"response":{}}, which Steam returns instead of an error for private profiles
+func inspectEmptyResponse(body []byte) error {
+	var envelope struct {
+		Response map[string]sonic.NoCopyRawMessage `json:"response"`
+	}
+	if err := sonic.Unmarshal(body, &envelope); err != nil {
+		return nil // 解析错误由模型反序列化报告 | Decode errors are reported by model unmarshalling
+	}
+	if len(envelope.Response) == 0 {
+		return ue.ErrPrivateProfile
+	}
+	return nil
+}
+
+// inspectPlayerStats 识别 {"playerstats":{"success":false,"error":"..."}}
+// inspectPlayerStats recognizes {"playerstats":{"success":false,"error":"..."}}
+func inspectPlayerStats(body []byte) error {
+	var envelope struct {
+		PlayerStats *struct {
+			Success *bool  `json:"success"`
+			Error   string `json:"error"`
+		} `json:"playerstats"`
+	}
+	if err := sonic.Unmarshal(body, &envelope); err != nil {
+		return nil
+	}
+	stats := envelope.PlayerStats
+	if stats == nil {
+		return ue.ErrNoStats
+	}
+	if stats.Error == "" && (stats.Success == nil || *stats.Success) {
+		return nil
+	}
+	return playerStatsError(stats.Error)
+}
+
+// playerStatsError 将 playerstats.error 文本映射为预设错误
+// playerStatsError maps the playerstats.error text to a preset error
+func playerStatsError(msg string) error {
+	lower := strings.ToLower(msg)
+	switch {
+	case strings.Contains(lower, "not public"), strings.Contains(lower, "private"):
+		return ue.ErrPrivateProfile
+	case strings.Contains(lower, "no stats"):
+		return ue.ErrNoStats
+	case strings.Contains(lower, "invalid steamid"):
+		return ue.ErrInvalidSteamID
+	case strings.Contains(lower, "app") && (strings.Contains(lower, "invalid") || strings.Contains(lower, "not found")):
+		return ue.ErrAppNotFound
+	case msg == "":
+		return ue.ErrAchievementFailed
+	default:
+		// 保留预设错误码, 原始错误替换为 Steam 返回的文本 | Keep the preset code, use Steam's text as the cause
+		failed := *ue.ErrAchievementFailed
+		failed.Err = errors.New(msg)
+		return &failed
+	}
+}
+
+// vanityNoMatch ResolveVanityURL 未匹配时的 success 值 | success value of an unmatched ResolveVanityURL
+const vanityNoMatch = 42
+
+// inspectVanityURL 识别 {"response":{"success":42,"message":"No match"}}
+// inspectVanityURL recognizes {"response":{"success":42,"message":"No match"}}
+func inspectVanityURL(body []byte) error {
+	var envelope struct {
+		Response struct {
+			Success int    `json:"success"`
+			Message string `json:"message"`
+		} `json:"response"`
+	}
+	if err := sonic.Unmarshal(body, &envelope); err != nil {
+		return nil
+	}
+	switch envelope.Response.Success {
+	case 1:
+		return nil
+	case vanityNoMatch:
+		return ue.ErrVanityURLNotFound
+	default:
+		return ue.New("resolve vanity url failed: " + envelope.Response.Message)
+	}
+}
+
+// inspectSuccessFlag 识别 {"response":{"success":false}}, 资料未公开时 Steam 以此代替错误
+// inspectSuccessFlag recognizes {"response":{"success":false}}, which Steam returns instead of an error for private profiles
+func inspectSuccessFlag(body []byte) error {
+	var envelope struct {
+		Response struct {
+			Success *bool `json:"success"`
+		} `json:"response"`
+	}
+	if err := sonic.Unmarshal(body, &envelope); err != nil {
+		return nil
+	}
+	if envelope.Response.Success != nil && !*envelope.Response.Success {
+		return ue.ErrPrivateProfile
+	}
+	return nil
+}
+
+// inspectGameSchema 识别 {"game":{}}, 游戏没有成就与统计项定义时 Steam 以此代替错误
+// inspectGameSchema recognizes {"game":{}}, which Steam returns instead of an error for apps without a stats schema
+func inspectGameSchema(body []byte) error {
+	var envelope struct {
+		Game map[string]sonic.NoCopyRawMessage `json:"game"`
+	}
+	if err := sonic.Unmarshal(body, &envelope); err != nil {
+		return nil
+	}
+	if len(envelope.Game) == 0 {
+		return ue.ErrNoStats
+	}
+	return nil
+}
+
+// inspectResultCode 识别 {"response":{"result":42}}, result 不为 1 表示应用不存在
+// inspectResultCode recognizes {"response":{"result":42}}; any result other than 1 means the app does not exist
+func inspectResultCode(body []byte) error {
+	var envelope struct {
+		Response struct {
+			Result *int `json:"result"`
+		} `json:"response"`
+	}
+	if err := sonic.Unmarshal(body, &envelope); err != nil {
+		return nil
+	}
+	if envelope.Response.Result != nil && *envelope.Response.Result != 1 {
+		return ue.ErrAppNotFound
+	}
+	return nil
+}
+
+// inspectUpToDateCheck 识别 {"response":{"success":false,"error":"..."}}, 应用不存在时 Steam 以此代替错误
+// inspectUpToDateCheck recognizes {"response":{"success":false,"error":"..."}}, which Steam returns instead of an error for unknown apps
+func inspectUpToDateCheck(body []byte) error {
+	var envelope struct {
+		Response struct {
+			Success *bool `json:"success"`
+		} `json:"response"`
+	}
+	if err := sonic.Unmarshal(body, &envelope); err != nil {
+		return nil
+	}
+	if envelope.Response.Success != nil && !*envelope.Response.Success {
+		return ue.ErrAppNotFound
+	}
+	return nil
+}
+
+// inspectServersAtAddress 识别 {"response":{"success":false,"message":"..."}}, 地址无效时 Steam 以此代替错误
+// inspectServersAtAddress recognizes {"response":{"success":false,"message":"..."}}, which Steam returns instead of an error for invalid addresses
+func inspectServersAtAddress(body []byte) error {
+	var envelope struct {
+		Response struct {
+			Success *bool  `json:"success"`
+			Message string `json:"message"`
+		} `json:"response"`
+	}
+	if err := sonic.Unmarshal(body, &envelope); err != nil {
+		return nil
+	}
+	if envelope.Response.Success != nil && !*envelope.Response.Success {
+		return ue.NewWithType(ue.ErrTypeParam, "get servers at address failed: "+envelope.Response.Message, nil)
+	}
+	return nil
+}
+
+// inspectStoreDetails 识别 {"<id>":{"success":false}}, 应用/礼包不存在或当前地区不可用时商店以此代替错误
+// inspectStoreDetails recognizes {"<id>":{"success":false}}, which the store returns instead of an error for unknown or region-locked items
+func inspectStoreDetails(notFound error) Inspector {
+	return func(body []byte) error {
+		var entries map[string]struct {
+			Success bool `json:"success"`
+		}
+		if err := sonic.Unmarshal(body, &entries); err != nil || len(entries) == 0 {
+			return nil
+		}
+		for _, entry := range entries {
+			if entry.Success {
+				return nil
+			}
+		}
+		return notFound
+	}
+}
+
+// inspectSuccessCode 识别 {"success":2}, 商店评测/搜索等查询参数无效时以此代替错误
+// inspectSuccessCode recognizes {"success":2}, which store queries such as reviews and search return instead of an error for invalid params
+func inspectSuccessCode(query string) Inspector {
+	return func(body []byte) error {
+		var envelope struct {
+			Success *int `json:"success"`
+		}
+		if err := sonic.Unmarshal(body, &envelope); err != nil {
+			return nil
+		}
+		if envelope.Success != nil && *envelope.Success != 1 {
+			return ue.NewWithType(ue.ErrTypeParam, query+" rejected, success="+strconv.Itoa(*envelope.Success), nil)
+		}
+		return nil
+	}
+}
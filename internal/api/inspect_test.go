@@ -0,0 +1,172 @@
+package api_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/GoFurry/gf-steam-sdk/internal/api"
+	"github.com/GoFurry/gf-steam-sdk/pkg/steam"
+	"github.com/GoFurry/gf-steam-sdk/pkg/steamtest"
+	ue "github.com/GoFurry/gf-steam-sdk/pkg/util/errors"
+)
+
+func TestInspectSoftFailures(t *testing.T) {
+	tests := []struct {
+		name     string
+		endpoint string
+		body     string // 空表示使用内置样例 | Empty means the built-in fixture
+		call     func(sdk *steam.SteamSDK) error
+		want     error // nil 表示调用成功 | nil means the call succeeds
+	}{
+		{
+			name:     "vanity match",
+			endpoint: "ISteamUser/ResolveVanityURL",
+			call:     resolveVanity,
+		},
+		{
+			name:     "vanity no match",
+			endpoint: "ISteamUser/ResolveVanityURL",
+			body:     `{"response":{"success":42,"message":"No match"}}`,
+			call:     resolveVanity,
+			want:     ue.ErrVanityURLNotFound,
+		},
+		{
+			name:     "achievements",
+			endpoint: "ISteamUserStats/GetPlayerAchievements",
+			call:     playerAchievements,
+		},
+		{
+			name:     "achievements not public",
+			endpoint: "ISteamUserStats/GetPlayerAchievements",
+			body:     `{"playerstats":{"error":"Profile is not public","success":false}}`,
+			call:     playerAchievements,
+			want:     ue.ErrPrivateProfile,
+		},
+		{
+			name:     "achievements no stats",
+			endpoint: "ISteamUserStats/GetPlayerAchievements",
+			body:     `{"playerstats":{"error":"Requested app has no stats","success":false}}`,
+			call:     playerAchievements,
+			want:     ue.ErrNoStats,
+		},
+		{
+			name:     "achievements invalid app",
+			endpoint: "ISteamUserStats/GetPlayerAchievements",
+			body:     `{"playerstats":{"error":"Invalid appid","success":false}}`,
+			call:     playerAchievements,
+			want:     ue.ErrAppNotFound,
+		},
+		{
+			name:     "achievements unknown error",
+			endpoint: "ISteamUserStats/GetPlayerAchievements",
+			body:     `{"playerstats":{"error":"Something else","success":false}}`,
+			call:     playerAchievements,
+			want:     ue.ErrAchievementFailed,
+		},
+		{
+			name:     "user stats without playerstats",
+			endpoint: "ISteamUserStats/GetUserStatsForGame",
+			body:     `{}`,
+			call: func(sdk *steam.SteamSDK) error {
+				_, err := sdk.Develop.GetUserStatsForGame(steamtest.SteamID, steamtest.AppID)
+				return err
+			},
+			want: ue.ErrNoStats,
+		},
+		{
+			name:     "owned games empty response",
+			endpoint: "IPlayerService/GetOwnedGames",
+			body:     `{"response":{}}`,
+			call: func(sdk *steam.SteamSDK) error {
+				_, err := sdk.Develop.GetOwnedGames(steamtest.SteamID, false)
+				return err
+			},
+			want: ue.ErrPrivateProfile,
+		},
+		{
+			name:     "group list success false",
+			endpoint: "ISteamUser/GetUserGroupList",
+			body:     `{"response":{"success":false}}`,
+			call: func(sdk *steam.SteamSDK) error {
+				_, err := sdk.Develop.GetUserGroupList(steamtest.SteamID)
+				return err
+			},
+			want: ue.ErrPrivateProfile,
+		},
+		{
+			name:     "current players unknown app",
+			endpoint: "ISteamUserStats/GetNumberOfCurrentPlayers",
+			body:     `{"response":{"result":42}}`,
+			call: func(sdk *steam.SteamSDK) error {
+				_, err := sdk.Develop.GetNumberOfCurrentPlayers(1)
+				return err
+			},
+			want: ue.ErrAppNotFound,
+		},
+		{
+			name:     "app details",
+			endpoint: "api/appdetails",
+			call:     appDetails,
+		},
+		{
+			name:     "app details success false",
+			endpoint: "api/appdetails",
+			body:     `{"620":{"success":false}}`,
+			call:     appDetails,
+			want:     ue.ErrAppNotFound,
+		},
+		{
+			name:     "package details success false",
+			endpoint: "api/packagedetails",
+			body:     `{"7877":{"success":false}}`,
+			call: func(sdk *steam.SteamSDK) error {
+				_, err := sdk.Store.GetPackageDetails(7877, nil)
+				return err
+			},
+			want: ue.ErrPackageNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sdk, srv := steamtest.NewSDK(t)
+			if tt.body != "" {
+				srv.SetFixture(tt.endpoint, []byte(tt.body))
+			}
+
+			err := tt.call(sdk)
+			if tt.want == nil {
+				if err != nil {
+					t.Fatalf("want success, got %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("want %v, got %v", tt.want, err)
+			}
+			if got := ue.GetEndpoint(err); got != tt.endpoint {
+				t.Fatalf("want endpoint %q, got %q", tt.endpoint, got)
+			}
+		})
+	}
+}
+
+func TestInspectUnregisteredEndpoint(t *testing.T) {
+	if err := api.Inspect("https://api.steampowered.com/ISteamNews/GetNewsForApp/v2/", []byte(`{"response":{}}`)); err != nil {
+		t.Fatalf("want nil for an endpoint without inspector, got %v", err)
+	}
+}
+
+func resolveVanity(sdk *steam.SteamSDK) error {
+	_, err := sdk.Develop.ResolveVanityURL("gabelogannewell", nil)
+	return err
+}
+
+func playerAchievements(sdk *steam.SteamSDK) error {
+	_, err := sdk.Develop.GetPlayerAchievements(steamtest.SteamID, steamtest.AppID, "english")
+	return err
+}
+
+func appDetails(sdk *steam.SteamSDK) error {
+	_, err := sdk.Store.GetAppDetails(steamtest.AppID, nil)
+	return err
+}
@@ -9,11 +9,17 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/GoFurry/gf-steam-sdk/internal/api/cache"
 	"github.com/GoFurry/gf-steam-sdk/pkg/config"
+	"github.com/GoFurry/gf-steam-sdk/pkg/observe"
+	"github.com/GoFurry/gf-steam-sdk/pkg/option"
 	"github.com/GoFurry/gf-steam-sdk/pkg/util"
 	"github.com/GoFurry/gf-steam-sdk/pkg/util/errors"
 	"github.com/bytedance/sonic"
@@ -25,9 +31,15 @@ import (
 // Client is the Steam API client structure
 // Encapsulates HTTP client, rate limiter and configuration to provide unified API request capabilities
 type Client struct {
-	cfg     *config.SteamConfig // 全局配置 | Global configuration
-	client  *http.Client        // 可复用 HTTP 客户端 | Reusable HTTP client
-	limiter *rate.Limiter       // 速率限制器 | Rate limiter
+	cfg      *config.SteamConfig // 全局配置 | Global configuration
+	client   *http.Client        // 可复用 HTTP 客户端 | Reusable HTTP client
+	limiter  *rate.Limiter       // 速率限制器 | Rate limiter
+	cache    *cache.Manager      // 响应缓存(未启用为 nil) | Response cache (nil if disabled)
+	keys     *keyPool            // API Key 池(未配置为 nil) | API key pool (nil if not configured)
+	retry    *config.RetryPolicy // 重试策略 | Retry policy
+	breaker  *breaker            // 按主机熔断器(未启用为 nil) | Per-host circuit breaker (nil if disabled)
+	observer observe.Observer    // 请求观察者 | Request observer
+	log      *slog.Logger        // 结构化日志(已脱敏) | Structured logger (redacting)
 }
 
 // NewClient 创建 Steam API 客户端实例
@@ -44,21 +56,21 @@ func NewClient(cfg *config.SteamConfig) (*Client, error) {
 		cfg = config.NewDefaultConfig()
 	}
 
-	if cfg.IsDebug {
-		fmt.Printf("[Info] Start NewClient Init \n")
-	}
-
 	// 校验配置合法性
 	// Validate configuration legality
 	if err := cfg.Validate(); err != nil {
 		return nil, fmt.Errorf("config validate failed: %w", err)
 	}
 
-	// 构建可复用的 HTTP 客户端
-	// Build reusable HTTP client
+	// 构建可复用的 HTTP 客户端(Transport 外层包装中间件链)
+	// Build reusable HTTP client (transport wrapped by the middleware chain)
+	var base http.RoundTripper = http.DefaultTransport
+	if cfg.Transport != nil {
+		base = cfg.Transport
+	}
 	httpClient := &http.Client{
 		Timeout:   cfg.Timeout,
-		Transport: cfg.Transport,
+		Transport: cfg.WrapTransport(base),
 	}
 
 	// 初始化速率限制器
@@ -73,47 +85,239 @@ func NewClient(cfg *config.SteamConfig) (*Client, error) {
 	}
 	limiter := rate.NewLimiter(rate.Limit(qps), burst)
 
-	if cfg.IsDebug {
-		fmt.Printf("[Info] End NewClient Init \n")
+	// 初始化 API Key 池(每个 Key 独立限流)
+	// Initialize API key pool (each key has its own limiter)
+	var keys *keyPool
+	if len(cfg.APIKeyPool) > 0 {
+		quarantine := cfg.APIKeyQuarantine
+		if quarantine <= 0 {
+			quarantine = util.DEFAULT_KEY_QUARANTINE
+		}
+		keys = newKeyPool(cfg.APIKeyPool, cfg.APIKeyStrategy, qps, burst, cfg.APIKeyDailyLimit, quarantine)
+	}
+
+	// 初始化响应缓存
+	// Initialize response cache
+	cacheManager, err := newCacheManager(cfg.Cache)
+	if err != nil {
+		return nil, err
+	}
+
+	// 初始化重试策略与熔断器
+	// Initialize retry policy and circuit breaker
+	retry := cfg.RetryPolicy
+	if retry == nil {
+		retry = config.NewDefaultRetryPolicy()
 	}
+	var cb *breaker
+	if cfg.CircuitBreaker != nil && cfg.CircuitBreaker.FailureThreshold > 0 {
+		cb = newBreaker(cfg.CircuitBreaker.FailureThreshold, cfg.CircuitBreaker.Cooldown)
+	}
+
+	logger := cfg.Log()
+	logger.Debug("steam client initialized",
+		"qps", qps, "burst", burst, "key_pool", len(cfg.APIKeyPool),
+		"cache", cfg.Cache != nil, "circuit_breaker", cb != nil)
 
 	return &Client{
-		cfg:     cfg,
-		client:  httpClient,
-		limiter: limiter,
+		cfg:      cfg,
+		client:   httpClient,
+		limiter:  limiter,
+		cache:    cacheManager,
+		keys:     keys,
+		retry:    retry,
+		breaker:  cb,
+		observer: observe.OrNop(cfg.Observer),
+		log:      logger,
 	}, nil
 }
 
+// newCacheManager 根据缓存配置创建缓存管理器, 未配置时返回 nil
+// newCacheManager builds the cache manager from config, returns nil if caching is not configured
+func newCacheManager(cc *config.CacheConfig) (*cache.Manager, error) {
+	if cc == nil {
+		return nil, nil
+	}
+	store := cc.Store
+	switch {
+	case store != nil:
+		// 使用调用方提供的存储 | Use the caller's store
+	case cc.Backend == util.CACHE_BACKEND_DISK:
+		dir := cc.Dir
+		if dir == "" {
+			dir = util.DEFAULT_CACHE_DIR
+		}
+		disk, err := cache.NewDisk(dir)
+		if err != nil {
+			return nil, err
+		}
+		store = disk
+	default:
+		store = cache.NewMemory(cc.MaxEntries)
+	}
+	return cache.NewManager(store, cc.DefaultTTL, cc.TTLs), nil
+}
+
 // DoRequest 通用 API 请求方法
 // 支持速率限制、自动重试、状态码校验和 JSON 响应解析
 // 参数:
 //   - method: HTTP 请求方法(GET/POST 等) | HTTP request method (GET/POST, etc.)
 //   - baseURL: 请求基础地址 | Request base URL
 //   - params: 请求查询参数 | Request query parameters
+//   - opts: 单次请求配置(覆盖全局配置) | Per-request options (override global config)
 //
 // 返回值:
 //   - map[string]interface{}: 解析后的 JSON 响应 | Parsed JSON response
 //   - error: 请求/解析失败时返回错误 | Error if request/parsing fails
-func (c *Client) DoRequest(method, baseURL string, params url.Values) (map[string]interface{}, error) {
-	if c.cfg.IsDebug {
-		fmt.Printf("[Info] Start DoRequest \n")
+func (c *Client) DoRequest(method, baseURL string, params url.Values, opts ...option.RequestOption) (map[string]interface{}, error) {
+	return c.DoRequestCtx(context.Background(), method, baseURL, params, opts...)
+}
+
+// DoRequestCtx 支持 context 的通用 API 请求方法
+// 调用方的取消/截止时间会传递到限流等待、HTTP 请求和重试退避中
+// DoRequestCtx is the context-aware variant of DoRequest
+// Caller cancellation/deadline is propagated to rate-limiter waits, HTTP requests and retry backoff sleeps
+// 参数:
+//   - ctx: 调用方上下文 | Caller context
+//   - method: HTTP 请求方法(GET/POST 等) | HTTP request method (GET/POST, etc.)
+//   - baseURL: 请求基础地址 | Request base URL
+//   - params: 请求查询参数 | Request query parameters
+//   - opts: 单次请求配置(覆盖全局配置) | Per-request options (override global config)
+//
+// 返回值:
+//   - map[string]interface{}: 解析后的 JSON 响应 | Parsed JSON response
+//   - error: 请求/解析失败时返回错误 | Error if request/parsing fails
+func (c *Client) DoRequestCtx(ctx context.Context, method, baseURL string, params url.Values, opts ...option.RequestOption) (map[string]interface{}, error) {
+	body, err := c.DoRequestRawCtx(ctx, method, baseURL, params, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return decodeJSON(body)
+}
+
+// DoRequestRaw 通用 API 请求方法, 返回原始响应体
+// 响应体只读取一次且不做解析, 由调用方直接反序列化为目标类型, 避免 map 中转带来的额外分配
+// DoRequestRaw is the universal API request method returning the raw response body
+// The body is read once and not parsed, so callers decode it straight into their target type without a map round trip
+// 参数:
+//   - method: HTTP 请求方法(GET/POST 等) | HTTP request method (GET/POST, etc.)
+//   - baseURL: 请求基础地址 | Request base URL
+//   - params: 请求查询参数 | Request query parameters
+//   - opts: 单次请求配置(覆盖全局配置) | Per-request options (override global config)
+//
+// 返回值:
+//   - []byte: 原始响应体 | Raw response body
+//   - error: 请求失败时返回错误 | Error if request fails
+func (c *Client) DoRequestRaw(method, baseURL string, params url.Values, opts ...option.RequestOption) ([]byte, error) {
+	return c.DoRequestRawCtx(context.Background(), method, baseURL, params, opts...)
+}
+
+// DoRequestRawCtx 支持 context 的 DoRequestRaw
+// 支持速率限制、按重试策略重试、按主机熔断、状态码校验和响应缓存
+// 失败时返回类型化错误: 429 -> ErrAPIQuotaExceeded, 400 -> ErrBadRequest, 401/403 -> ErrUnauthorized,
+// 5xx -> ErrServerError, 超时 -> ErrTimeout, 熔断中 -> ErrCircuitOpen, 其他 -> ErrRequestFailed; RegisterStatusError 注册的映射优先
+// 既无 Key 也无 Access Token 的 Web API 请求不会发出, 直接返回 ErrMissingAPIKey
+// DoRequestRawCtx is the context-aware variant of DoRequestRaw
+// Supports rate limiting, policy-driven retries, per-host circuit breaking, status code validation and response caching
+// Failures are typed: 429 -> ErrAPIQuotaExceeded, 400 -> ErrBadRequest, 401/403 -> ErrUnauthorized,
+// 5xx -> ErrServerError, timeout -> ErrTimeout, open circuit -> ErrCircuitOpen, anything else -> ErrRequestFailed;
+// mappings registered with RegisterStatusError take precedence
+// A Web API request with neither a key nor an access token is never sent and returns ErrMissingAPIKey
+func (c *Client) DoRequestRawCtx(ctx context.Context, method, baseURL string, params url.Values, opts ...option.RequestOption) ([]byte, error) {
+	return c.do(ctx, method, baseURL, params, nil, opts...)
+}
+
+// DoRequestStreamCtx 流式读取响应体的 DoRequestRawCtx, 适用于无法整体放入内存的大响应
+// 限流、重试、熔断和类型化错误与 DoRequestRawCtx 一致, 但不读取缓存也不写入缓存; consume 返回的错误原样返回
+// DoRequestStreamCtx is DoRequestRawCtx with a streamed body, for responses too large to hold in memory
+// Rate limiting, retries, circuit breaking and typed errors match DoRequestRawCtx, but the response cache is bypassed;
+// an error returned by consume is passed through unchanged
+// 参数:
+//   - method: HTTP 请求方法(GET/POST 等) | HTTP request method (GET/POST, etc.)
+//   - baseURL: 请求基础地址 | Request base URL
+//   - params: 请求查询参数 | Request query parameters
+//   - consume: 读取 200 响应体的函数, 返回后响应体即被关闭 | Reads the 200 response body, which is closed once it returns
+//   - opts: 单次请求配置(覆盖全局配置) | Per-request options (override global config)
+//
+// 返回值:
+//   - error: 请求失败或 consume 返回的错误 | Request failure or the error returned by consume
+func (c *Client) DoRequestStreamCtx(ctx context.Context, method, baseURL string, params url.Values, consume func(body io.Reader) error, opts ...option.RequestOption) error {
+	_, err := c.do(ctx, method, baseURL, params, consume, opts...)
+	return err
+}
+
+// do DoRequestRawCtx 与 DoRequestStreamCtx 的公共实现, 负责观察者通知与地址改写
+// do is shared by DoRequestRawCtx and DoRequestStreamCtx, handling observer notification and URL rebasing
+func (c *Client) do(ctx context.Context, method, baseURL string, params url.Values, consume func(io.Reader) error, opts ...option.RequestOption) ([]byte, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	// 接口名取自官方地址, 再替换为配置的基础地址(反向代理/镜像等)
+	// The endpoint name comes from the official URL, which is then rebased onto the configured endpoints (proxy, mirror, ...)
+	info := observe.RequestInfo{Kind: observe.KindAPI, Method: method}
+	if u, err := url.Parse(baseURL); err == nil {
+		info.Endpoint = EndpointName(u)
+	}
+	svc := serviceOf(baseURL)
+	baseURL = c.cfg.Endpoints.Rebase(baseURL)
+
+	// 通知观察者, 地址去掉查询参数避免泄露 Key
+	// Notify the observer, the query is stripped so the key never leaks
+	info.Target = baseURL
+	if u, err := url.Parse(baseURL); err == nil {
+		info.Target = u.Scheme + "://" + u.Host + u.Path
+	}
+	ctx = c.observer.RequestStart(ctx, info)
+	ev := observe.Event{RequestInfo: info}
+	startTime := time.Now()
+	body, err := c.doRequestRaw(ctx, method, baseURL, svc, params, &ev, consume, opts...)
+	ev.Latency, ev.Err = time.Since(startTime), err
+	if consume == nil {
+		ev.Bytes = len(body)
+	}
+	c.observer.RequestEnd(ctx, ev)
+	return body, err
+}
+
+// doRequestRaw DoRequestRawCtx 的实现, 同时填充观察事件中的状态码、尝试次数、限流等待和缓存命中
+// consume 不为 nil 时响应体交给 consume 流式读取, 跳过缓存并返回 nil
+// doRequestRaw implements DoRequestRawCtx, filling status, attempts, rate-limit wait and cache hit into the observer event
+// With a non-nil consume the body is streamed to consume, the cache is skipped and nil is returned
+func (c *Client) doRequestRaw(ctx context.Context, method, baseURL string, svc service, params url.Values, ev *observe.Event, consume func(io.Reader) error, opts ...option.RequestOption) ([]byte, error) {
+	// 合并单次请求配置与全局配置, 未设置的参数不发送
+	// Merge per-request options with global config, unset params are omitted
+	reqOpts := option.Apply(opts...)
+	if params == nil {
+		params = url.Values{}
 	}
+	c.applyParams(params, reqOpts, svc)
 
-	// 创建带超时的上下文
-	// Create context with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), c.cfg.Timeout)
-	defer cancel()
+	// 配置了 Key 池且本次未指定 Key 时, 每次尝试从池中取 Key 并使用该 Key 的限流器; 商店请求不使用 Key, 不占用池中 Key 的配额
+	// With a key pool and no per-request key, every attempt takes a key from the pool and waits on that key's limiter;
+	// store requests carry no key, so they never use up a pooled key's quota
+	usePool := c.keys != nil && reqOpts.APIKey == nil && svc != serviceStore
 
-	// 速率限制: 等待获取令牌
-	// Rate limit: wait for token
-	if err := c.limiter.Wait(ctx); err != nil { // 等待获取令牌
-		return nil, fmt.Errorf("%w: request rate limit exceeded: %v", errors.ErrRequestFailed, err)
+	// Web API 请求既无 Key 也无 Access Token 时直接失败, 不发出必然 403 的请求
+	// A Web API request with neither a key nor an access token fails fast instead of sending a request bound to get a 403
+	if svc == serviceAPI && !usePool && params.Get("key") == "" && params.Get("access_token") == "" {
+		return nil, fmt.Errorf("%w: set APIKey/APIKeyPool or pass option.WithAPIKey/option.WithAccessToken", errors.ErrMissingAPIKey)
 	}
+	if !usePool {
+		// 创建带超时的上下文(仅约束限流等待)
+		// Create context with timeout (only bounds the rate limiter wait)
+		waitCtx, cancel := context.WithTimeout(ctx, c.cfg.Timeout)
+		defer cancel()
 
-	// 追加 API Key 到请求参数
-	// Append API Key to request parameters
-	params.Set("key", c.cfg.APIKey)
-	params.Set("access_token", c.cfg.AccessToken)
+		// 速率限制: 等待获取令牌
+		// Rate limit: wait for token
+		waitStart := time.Now()
+		err := c.limiter.Wait(waitCtx) // 等待获取令牌
+		ev.RateLimitWait += time.Since(waitStart)
+		if err != nil {
+			return nil, fmt.Errorf("%w: request rate limit exceeded: %w", errors.ErrRequestFailed, err)
+		}
+	}
 
 	// 构建完整请求 URL
 	// Build full request URL
@@ -123,20 +327,68 @@ func (c *Client) DoRequest(method, baseURL string, params url.Values) (map[strin
 	}
 	requestURL.RawQuery = params.Encode()
 
-	// 带重试机制发送请求
-	// Send request with retry mechanism
+	// 查询响应缓存(仅 GET), 有效则直接返回, 过期但带校验信息则发起条件请求
+	// Look up the response cache (GET only): return fresh entries directly, revalidate stale entries that carry validators
+	var (
+		cacheKey string
+		cacheTTL time.Duration
+		cached   *cache.Entry
+	)
+	if c.cache != nil && method == http.MethodGet && consume == nil {
+		cacheTTL = c.cache.TTL(ev.Endpoint)
+		if cacheTTL > 0 {
+			cacheKey = cacheKeyOf(method, requestURL)
+			entry, fresh := c.cache.Get(cacheKey)
+			if fresh {
+				ev.Status, ev.CacheHit = http.StatusOK, true
+				c.log.Debug("cache hit", "endpoint", ev.Endpoint, "method", method)
+				return entry.Body, nil
+			}
+			cached = entry
+		}
+	}
+
+	// 按重试策略发送请求
+	// Send request following the retry policy
 	var resp *http.Response
-	var errRequest error
+	var errRequest, errTransport error
 	var costTime time.Duration
-	for i := 0; i <= c.cfg.RetryTimes; i++ {
+	host := requestURL.Host
+	attempts := c.retry.Attempts(c.cfg.RetryTimes)
+	firstAttempt := time.Now()
+	for i := 0; i < attempts; i++ {
+		// 主机熔断中则快速失败
+		// Fail fast while the host's circuit is open
+		if c.breaker != nil {
+			if err := c.breaker.allow(host); err != nil {
+				c.log.Warn("circuit open, failing fast", "endpoint", ev.Endpoint, "attempt", i+1, "host", host)
+				return nil, err
+			}
+		}
+
+		// 从 Key 池取 Key(每次尝试重新选取, 被隔离的 Key 不会再被选中)
+		// Take a key from the pool (re-picked per attempt so quarantined keys are skipped)
+		var key *apiKey
+		if usePool {
+			waitCtx, cancel := context.WithTimeout(ctx, c.cfg.Timeout)
+			waitStart := time.Now()
+			key, err = c.keys.acquire(waitCtx)
+			ev.RateLimitWait += time.Since(waitStart)
+			cancel()
+			if err != nil {
+				return nil, err
+			}
+			params.Set("key", key.key)
+			requestURL.RawQuery = params.Encode()
+		}
+
 		startTime := time.Now()
 
 		// 创建 HTTP 请求
 		// Create HTTP request
-		req, err := http.NewRequest(method, requestURL.String(), nil)
+		req, err := http.NewRequestWithContext(ctx, method, requestURL.String(), nil)
 		if err != nil {
-			errRequest = fmt.Errorf("create request failed: %w", err)
-			continue
+			return nil, fmt.Errorf("%w: create request failed: %v", errors.ErrRequestFailed, err)
 		}
 
 		// 设置请求头
@@ -145,92 +397,460 @@ func (c *Client) DoRequest(method, baseURL string, params url.Values) (map[strin
 		for k, v := range c.cfg.Headers {
 			req.Header.Set(k, v)
 		}
-
-		if c.cfg.IsDebug {
-			fmt.Printf("[Info] Start %s %s \n", method, requestURL.String())
+		for k, v := range reqOpts.Headers {
+			req.Header.Set(k, v)
+		}
+		if cached != nil {
+			if cached.ETag != "" {
+				req.Header.Set("If-None-Match", cached.ETag)
+			}
+			if cached.LastModified != "" {
+				req.Header.Set("If-Modified-Since", cached.LastModified)
+			}
 		}
+
+		c.log.Debug("request attempt", "endpoint", ev.Endpoint, "method", method, "attempt", i+1, "url", requestURL.String())
 		// 发送请求
 		// Send request
 		resp, err = c.client.Do(req)
 		costTime = time.Since(startTime)
+		ev.Attempts++
+		ev.Status = 0
+		if resp != nil {
+			ev.Status = resp.StatusCode
+		}
+		c.recordCircuit(ctx, host, resp, err)
 
-		// 请求成功(200 状态码)则退出重试
-		// Exit retry if request succeeds (200 status code)
-		if err == nil && resp.StatusCode == http.StatusOK {
-			if c.cfg.IsDebug {
-				fmt.Printf("[Success] cost time: %v, %s %s \n", costTime, method, requestURL.String())
-			}
+		// 请求成功(200 状态码, 或条件请求返回 304)则退出重试
+		// Exit retry if request succeeds (200 status code, or 304 for a conditional request)
+		if err == nil && (resp.StatusCode == http.StatusOK || (cached != nil && resp.StatusCode == http.StatusNotModified)) {
+			c.log.Debug("request succeeded", "endpoint", ev.Endpoint, "attempt", i+1, "status", resp.StatusCode, "cost", costTime)
+			errRequest, errTransport = nil, nil // 清除之前重试留下的错误 | Clear errors left by previous attempts
 			break
 		}
 
-		// 记录重试错误
-		// Record retry error
+		// 记录本次尝试的错误
+		// Record the error of this attempt
 		statusCode := "nil"
 		if resp != nil {
 			statusCode = util.Int2String(resp.StatusCode)
 		}
-		reqErr := "nil"
+		errTransport = err
 		if err != nil {
-			reqErr = err.Error()
+			errRequest = fmt.Errorf("request failed (attempt %d/%d): status_code=%v, cost_time=%v, err=%w",
+				i+1, attempts, statusCode, costTime, err)
+		} else {
+			errRequest = fmt.Errorf("request failed (attempt %d/%d): status_code=%v, cost_time=%v",
+				i+1, attempts, statusCode, costTime)
 		}
-		errRequest = fmt.Errorf("request failed (retry %d): status_code=%v, err=%w, cost_time=%v",
-			i, statusCode, reqErr, costTime)
 
-		if c.cfg.IsDebug {
-			fmt.Printf("[Error] %s \n", errRequest.Error())
+		c.log.Warn("request attempt failed", "endpoint", ev.Endpoint, "attempt", i+1, "status", ev.Status, "cost", costTime, "err", errRequest)
+
+		// 调用方已取消则不再重试
+		// Stop retrying once the caller has canceled
+		if ctx.Err() != nil {
+			break
 		}
 
-		// 仅对 429(限流)/5xx(服务器错误) 进行重试
-		// Only retry for 429 (rate limit)/5xx (server error)
-		if resp != nil && (resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500) {
-			// 指数退避: (i+1)*基础重试间隔
-			// Exponential backoff: (i+1)*base retry interval
-			time.Sleep(time.Duration((i+1)*util.RETRY_SLEEP_BASE) * time.Millisecond)
+		// Key 池中的 Key 被拒绝(429/Key 无效的 HTML 403)时隔离该 Key, 立即换 Key 重试;
+		// 带 JSON 响应体的 403(如资料未公开)按普通失败处理, 不消耗其他 Key
+		// A pooled key that was rejected (429/bad-key HTML 403) is quarantined, retry right away with another key;
+		// a 403 with a JSON body (e.g. a private profile) is an ordinary failure and does not burn other keys
+		if key != nil && resp != nil && c.keys.report(key, resp) {
+			c.log.Warn("api key quarantined", "endpoint", ev.Endpoint, "attempt", i+1, "status", resp.StatusCode, "masked_key", maskKey(key.key))
+			resp.Body.Close()
 			continue
 		}
-		break
+
+		// 仅对策略允许的状态码/错误类别重试
+		// Only retry status codes/error classes allowed by the policy
+		retryable := (resp != nil && c.retry.RetryableStatusCode(resp.StatusCode)) ||
+			(err != nil && c.retry.RetryableError(err))
+		if !retryable || i == attempts-1 {
+			break
+		}
+
+		// 退避等待: 优先遵循 Retry-After, 超出重试总耗时上限则放弃
+		// Back off: Retry-After first, give up once the max elapsed time would be exceeded
+		var header http.Header
+		if resp != nil {
+			header = resp.Header
+		}
+		delay := c.retry.Delay(i+1, header)
+		if c.retry.MaxElapsed > 0 && time.Since(firstAttempt)+delay > c.retry.MaxElapsed {
+			errRequest = fmt.Errorf("%w, retry budget %v exhausted (next delay %v)", errRequest, c.retry.MaxElapsed, delay)
+			break
+		}
+		if resp != nil {
+			resp.Body.Close()
+		}
+		c.log.Debug("retrying request", "endpoint", ev.Endpoint, "attempt", i+1, "delay", delay)
+		if err := sleepCtx(ctx, delay); err != nil {
+			errRequest = fmt.Errorf("%w: %w", errRequest, err)
+			break
+		}
 	}
 
-	// 检查请求错误
-	// Check request error
+	// 检查请求错误(按状态码/错误类别返回类型化错误)
+	// Check request error (typed by status code/error class)
 	if errRequest != nil {
-		return nil, fmt.Errorf("%w: %v", errors.ErrRequestFailed, errRequest)
+		err := requestError(ev.Endpoint, resp, errTransport, errRequest)
+		if resp != nil {
+			resp.Body.Close()
+		}
+		return nil, err
 	}
 	defer resp.Body.Close()
 
-	// 校验响应状态码
-	// Validate response status code
-	if resp.StatusCode != http.StatusOK {
-		errMsg := fmt.Sprintf("request failed with status code %d", resp.StatusCode)
-		switch resp.StatusCode {
-		case http.StatusTooManyRequests:
-			return nil, errors.ErrAPIQuotaExceeded
-		case http.StatusBadRequest:
-			return nil, fmt.Errorf("%w: invalid request params", errors.ErrRequestFailed)
-		case http.StatusUnauthorized:
-			return nil, fmt.Errorf("%w: invalid api key", errors.ErrRequestFailed)
-		default:
-			return nil, fmt.Errorf("%w: %s", errors.ErrRequestFailed, errMsg)
-		}
+	// 304: 缓存内容仍然有效, 刷新有效期后返回
+	// 304: cached content is still valid, extend it and return
+	if resp.StatusCode == http.StatusNotModified {
+		c.cache.Revalidate(cacheKey, cached, cacheTTL)
+		ev.CacheHit = true
+		c.log.Debug("cache revalidated", "endpoint", ev.Endpoint, "method", method)
+		return cached.Body, nil
 	}
 
-	// 读取并解析 JSON 响应
-	// Read and parse JSON response
-	var result map[string]interface{}
-	bodyBytes, err := io.ReadAll(resp.Body)
+	// 流式读取响应体, 统计已读字节数 | Stream the body, counting the bytes read
+	if consume != nil {
+		counter := &countingReader{r: resp.Body}
+		err := consume(counter)
+		ev.Bytes = int(counter.n)
+		return nil, err
+	}
+
+	// 读取响应体(仅读取一次, 不做解析)
+	// Read response body (read once, not parsed here)
+	bodyBytes, err := readBody(resp)
 	if err != nil {
 		return nil, fmt.Errorf("read response body failed: %w", err)
 	}
-	if err := sonic.Unmarshal(bodyBytes, &result); err != nil {
-		return nil, fmt.Errorf("%w: %v", errors.ErrAPIResponse, err)
+
+	// 写入响应缓存(仅缓存合法 JSON)
+	// Store into the response cache (only valid JSON is cached)
+	if cacheKey != "" && sonic.Valid(bodyBytes) {
+		c.cache.Put(cacheKey, &cache.Entry{
+			Body:         bodyBytes,
+			ETag:         resp.Header.Get("ETag"),
+			LastModified: resp.Header.Get("Last-Modified"),
+		}, cacheTTL)
+	}
+
+	return bodyBytes, nil
+}
+
+// service 请求所属的 Steam 服务, 由改写前的官方地址判断
+// service is the Steam service a request goes to, told from the official URL before rebasing
+type service int
+
+const (
+	serviceOther service = iota // 其他地址 | Any other URL
+	serviceAPI                  // Web API(api.steampowered.com), 需要 Key 或 Access Token | Web API, needs a key or access token
+	serviceStore                // 商店(store.steampowered.com), 不使用 Key/Access Token | Store, takes no key/access token
+)
+
+// serviceOf 判断官方地址所属的服务 | Tell the service of an official URL
+func serviceOf(rawURL string) service {
+	switch {
+	case strings.HasPrefix(rawURL, util.STEAM_API_BASE_URL):
+		return serviceAPI
+	case strings.HasPrefix(rawURL, util.STEAM_STORE_BASE_URL):
+		return serviceStore
+	}
+	return serviceOther
+}
+
+// recordCircuit 上报本次尝试结果到熔断器
+// 5xx 和超时/连接错误计为失败, 其他响应(含 4xx)说明主机可用, 计为成功; 调用方取消或超出调用方截止时间不计入
+// recordCircuit reports an attempt to the circuit breaker
+// 5xx and timeout/connection errors count as failures, any other response (4xx included) proves the host is up;
+// caller cancellation or the caller's own deadline is not counted
+func (c *Client) recordCircuit(ctx context.Context, host string, resp *http.Response, err error) {
+	if c.breaker == nil || (err != nil && ctx.Err() != nil) {
+		return
+	}
+	switch {
+	case err != nil:
+		if config.ErrorClass(err) != "" {
+			c.breaker.failure(host)
+		}
+	case resp.StatusCode >= 500:
+		c.breaker.failure(host)
+	default:
+		c.breaker.success(host)
 	}
+}
 
-	if c.cfg.IsDebug {
-		fmt.Printf("[Info] End DoRequest \n")
+var (
+	statusErrorsMu sync.RWMutex
+	// statusErrors 接口名 -> 状态码 -> 类型化错误, 用于接口对状态码有特殊含义的情况
+	// statusErrors maps endpoint -> status code -> typed error for endpoints that give a status code a special meaning
+	statusErrors = map[string]map[int]*errors.SteamError{
+		// 好友列表未公开时 Steam 返回 401(Key 无效为 403) | Steam answers 401 for a private friend list (403 for a bad key)
+		"ISteamUser/GetFriendList": {http.StatusUnauthorized: errors.ErrPrivateFriendList},
+		// 应用不存在时 Steam 返回 404 {"response":{"result":42}} | Steam answers 404 {"response":{"result":42}} for unknown apps
+		"ISteamUserStats/GetNumberOfCurrentPlayers": {http.StatusNotFound: errors.ErrAppNotFound},
+		// 游戏没有统计数据时 Steam 返回 400 | Steam answers 400 when the app has no stats
+		"ISteamUserStats/GetUserStatsForGame": {http.StatusBadRequest: errors.ErrNoStats},
+		// 资料未公开时 Steam 返回 403 {"playerstats":{"error":"Profile is not public"}}, 游戏没有统计数据时返回 400
+		// Steam answers 403 {"playerstats":{"error":"Profile is not public"}} for a private profile and 400 when the app has no stats
+		"ISteamUserStats/GetPlayerAchievements": {
+			http.StatusForbidden:  errors.ErrPrivateProfile,
+			http.StatusBadRequest: errors.ErrNoStats,
+		},
+	}
+)
+
+// RegisterStatusError 为接口的状态码注册类型化错误, 优先于通用映射, 重复注册会覆盖
+// 参数:
+//   - endpoint: 接口名, 格式为 "Interface/Method" | Endpoint name in "Interface/Method" form
+//   - status: HTTP 状态码 | HTTP status code
+//   - err: 类型化错误 | Typed error
+func RegisterStatusError(endpoint string, status int, err *errors.SteamError) {
+	statusErrorsMu.Lock()
+	defer statusErrorsMu.Unlock()
+	if statusErrors[endpoint] == nil {
+		statusErrors[endpoint] = map[int]*errors.SteamError{}
+	}
+	statusErrors[endpoint][status] = err
+}
+
+// statusError 获取接口状态码对应的类型化错误(未注册返回 nil)
+// Key 无效的 HTML 403 页面不套用接口映射, 始终按 ErrUnauthorized 处理
+// statusError returns the typed error registered for the endpoint status (nil if none)
+// The bad-key HTML 403 page never uses the endpoint mapping and always ends up as ErrUnauthorized
+func statusError(endpoint string, resp *http.Response) *errors.SteamError {
+	if resp == nil || (resp.StatusCode == http.StatusForbidden && badKeyPage(resp)) {
+		return nil
+	}
+	statusErrorsMu.RLock()
+	defer statusErrorsMu.RUnlock()
+	return statusErrors[endpoint][resp.StatusCode]
+}
+
+// requestError 将最终失败映射为类型化错误并附加接口名
+// requestError maps the final failure to a typed error carrying the endpoint
+func requestError(endpoint string, resp *http.Response, errTransport, detail error) error {
+	typed := errors.ErrRequestFailed
+	registered := statusError(endpoint, resp)
+	switch {
+	case registered != nil:
+		typed = registered
+	case resp != nil && resp.StatusCode == http.StatusTooManyRequests:
+		typed = errors.ErrAPIQuotaExceeded
+	case resp != nil && resp.StatusCode == http.StatusBadRequest:
+		typed = errors.ErrBadRequest
+	case resp != nil && (resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden):
+		typed = errors.ErrUnauthorized
+	case resp != nil && resp.StatusCode >= 500:
+		typed = errors.ErrServerError
+	case errTransport != nil && config.ErrorClass(errTransport) == util.RETRY_ERROR_CLASS_TIMEOUT:
+		typed = errors.ErrTimeout
+	}
+	return fmt.Errorf("%w: %w", errors.WithEndpoint(typed, endpoint), detail)
+}
+
+// readBody 读取响应体, 已知长度时一次性分配, 避免 io.ReadAll 反复扩容
+// readBody reads the response body, allocating once when the length is known to avoid io.ReadAll regrowth
+func readBody(resp *http.Response) ([]byte, error) {
+	if resp.ContentLength > 0 {
+		buf := make([]byte, resp.ContentLength)
+		if _, err := io.ReadFull(resp.Body, buf); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// countingReader 统计读取字节数的 io.Reader | io.Reader counting the bytes read
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+// Read implements io.Reader
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// decodeJSON 解析 JSON 响应体
+// decodeJSON parses a JSON response body
+func decodeJSON(body []byte) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	if err := sonic.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrAPIResponse, err)
 	}
 	return result, nil
 }
 
+// cacheKeyOf 生成缓存键(整体哈希, Key/Token 不以明文保存)
+// API Key 与 Access Token 参与计算: 资料所有者的 Key 可见其私有数据, 不同 Key 的响应不能互相复用;
+// Key 池的 Key 在每次尝试时才写入, 计算时不在查询参数中, 因此池中各 Key 共享条目
+// cacheKeyOf builds the cache key (hashed as a whole, keys/tokens are never stored in plain text)
+// The API key and access token take part: a profile owner's key sees their private data, so responses must not be shared across keys;
+// pooled keys are only set per attempt and are absent from the query here, so all pooled keys share entries
+func cacheKeyOf(method string, u *url.URL) string {
+	return cache.Key(method, u.Scheme+"://"+u.Host+u.Path, u.Query().Encode())
+}
+
+// EndpointName 由请求地址解析 "Interface/Method" 形式的接口名, 用于匹配缓存 TTL、响应检查等
+// 路径中的数字 ID(如商店的 appreviews/{appid})不计入接口名
+// EndpointName derives "Interface/Method" from the request URL, used for cache TTLs, response inspection, etc.
+// A numeric ID in the path (e.g. the store's appreviews/{appid}) is left out of the name
+func EndpointName(u *url.URL) string {
+	segs := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(segs) >= 2 && !isNumeric(segs[1]) {
+		return segs[0] + "/" + segs[1]
+	}
+	return segs[0]
+}
+
+// isNumeric 判断路径片段是否为纯数字 | Report whether a path segment is all digits
+func isNumeric(seg string) bool {
+	if seg == "" {
+		return false
+	}
+	for _, r := range seg {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// Endpoints 获取各服务基础地址
+// Endpoints returns the configured base URL of every Steam service
+func (c *Client) Endpoints() config.Endpoints {
+	return c.cfg.Endpoints
+}
+
+// Observer 获取请求观察者(未配置时为空操作观察者)
+// Observer returns the request observer (a no-op observer if none is configured)
+func (c *Client) Observer() observe.Observer {
+	return c.observer
+}
+
+// Cache 获取响应缓存管理器(未启用时返回 nil)
+// Cache returns the response cache manager (nil if caching is disabled)
+func (c *Client) Cache() *cache.Manager {
+	return c.cache
+}
+
+// Stats 获取客户端运行统计(API Key 池使用情况、响应缓存命中率等)
+// Stats returns client runtime statistics (API key pool usage, response cache hit ratio, ...)
+func (c *Client) Stats() observe.Stats {
+	st := observe.Stats{Cache: c.CacheStats()}
+	if c.keys != nil {
+		st.Keys = c.keys.stats()
+	}
+	if c.breaker != nil {
+		st.Circuits = c.breaker.stats()
+	}
+	return st
+}
+
+// CacheStats 获取响应缓存统计(未启用时返回零值)
+// CacheStats returns response cache stats (zero value if caching is disabled)
+func (c *Client) CacheStats() cache.Stats {
+	if c.cache == nil {
+		return cache.Stats{}
+	}
+	return c.cache.Stats()
+}
+
+// applyParams 将认证、语言和国家参数写入请求参数
+// 优先级: 单次请求配置 > 接口入参 > 全局配置, 最终为空的参数不会发送; 商店请求不发送 Key 和 Access Token
+// applyParams writes auth, language and country params into the request params
+// Precedence: per-request option > endpoint argument > global config; params that end up empty are not sent;
+// store requests never carry the key or access token
+func (c *Client) applyParams(params url.Values, o *option.RequestOptions, svc service) {
+	if svc == serviceStore {
+		// 商店不使用凭证, 避免将其发往商店或配置的商店镜像 | The store takes no credentials, keep them away from it and any configured mirror
+		params.Del("key")
+		params.Del("access_token")
+	} else {
+		c.applyCredentials(params, o)
+	}
+
+	if o.Language != nil {
+		setAlias(params, languageParams, *o.Language)
+	}
+	if o.Country != nil {
+		setAlias(params, countryParams, *o.Country)
+	}
+}
+
+// applyCredentials 写入 API Key 与 Access Token | Write the API key and access token
+func (c *Client) applyCredentials(params url.Values, o *option.RequestOptions) {
+	// API Key 不作为接口入参, 仅来自请求配置或全局配置(配置 Key 池时由每次尝试单独设置)
+	// API key is never an endpoint argument, it comes from request options or global config (set per attempt when a key pool is configured)
+	key := c.cfg.APIKey
+	if c.keys != nil {
+		key = ""
+	}
+	if o.APIKey != nil {
+		key = *o.APIKey
+	}
+	setOrDel(params, "key", key)
+
+	token := params.Get("access_token")
+	if token == "" {
+		token = c.cfg.AccessToken
+	}
+	if o.AccessToken != nil {
+		token = *o.AccessToken
+	}
+	setOrDel(params, "access_token", token)
+}
+
+// Steam 各接口对语言/国家参数命名不一致, 首个为默认参数名
+// Steam endpoints name language/country params inconsistently, the first one is the default
+var (
+	languageParams = []string{"language", "l"}
+	countryParams  = []string{"country_code", "user_country", "cc"}
+)
+
+// setAlias 覆盖请求中已存在的同义参数, 均不存在时使用默认参数名
+// setAlias overwrites whichever alias the endpoint already uses, falling back to the default name
+func setAlias(params url.Values, aliases []string, value string) {
+	found := false
+	for _, name := range aliases {
+		if params.Has(name) {
+			setOrDel(params, name, value)
+			found = true
+		}
+	}
+	if !found {
+		setOrDel(params, aliases[0], value)
+	}
+}
+
+// setOrDel 值非空时设置参数, 否则删除参数
+// setOrDel sets the param when value is non-empty, otherwise removes it
+func setOrDel(params url.Values, name, value string) {
+	if value == "" {
+		params.Del(name)
+		return
+	}
+	params.Set(name, value)
+}
+
+// sleepCtx 可被 context 中断的休眠
+// sleepCtx sleeps for d or until ctx is done, whichever comes first
+func sleepCtx(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
 // Close 释放Client资源
 func (c *Client) Close() error {
 	// 关闭HTTP客户端连接池
@@ -0,0 +1,72 @@
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/GoFurry/gf-steam-sdk/internal/client"
+	"github.com/GoFurry/gf-steam-sdk/pkg/steamtest"
+	"github.com/GoFurry/gf-steam-sdk/pkg/util"
+	"github.com/bytedance/sonic"
+)
+
+// 对比 DoRequest(解码为 map)与 DoRequestRaw(只读取一次原始字节)在大响应上的开销
+// Compares DoRequest (decodes into a map) with DoRequestRaw (reads the raw bytes once) on a large response
+//
+//	go test -run '^$' -bench . -benchmem ./internal/client
+
+func BenchmarkDoRequest(b *testing.B) {
+	srv := steamtest.NewServer()
+	defer srv.Close()
+	body := largeOwnedGames(5000)
+	srv.SetFixture(ownedGames, body)
+
+	// 放开限速, 只测量请求路径 | Lift the rate limit so only the request path is measured
+	c, err := client.NewClient(srv.Config().WithRateLimit(1e9, 1e9))
+	if err != nil {
+		b.Fatalf("create client: %v", err)
+	}
+	defer c.Close()
+
+	ctx := context.Background()
+	reqURL := util.STEAM_API_BASE_URL + ownedGames + "/v1/"
+	params := url.Values{"steamid": {steamtest.SteamID}}
+	b.Run("map", func(b *testing.B) {
+		b.ReportAllocs()
+		b.SetBytes(int64(len(body)))
+		for i := 0; i < b.N; i++ {
+			if _, err := c.DoRequestCtx(ctx, "GET", reqURL, params); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("raw", func(b *testing.B) {
+		b.ReportAllocs()
+		b.SetBytes(int64(len(body)))
+		for i := 0; i < b.N; i++ {
+			if _, err := c.DoRequestRawCtx(ctx, "GET", reqURL, params); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+// largeOwnedGames 生成包含 n 个游戏的 GetOwnedGames 响应 | Build a GetOwnedGames response with n games
+func largeOwnedGames(n int) []byte {
+	games := make([]map[string]any, 0, n)
+	for i := 0; i < n; i++ {
+		games = append(games, map[string]any{
+			"appid":            10 + i*10,
+			"name":             fmt.Sprintf("Game Title Number %d", i),
+			"playtime_forever": i * 37,
+			"img_icon_url":     "0123456789abcdef0123456789abcdef01234567",
+		})
+	}
+	body, err := sonic.Marshal(map[string]any{"response": map[string]any{"game_count": n, "games": games}})
+	if err != nil {
+		panic(err)
+	}
+	return body
+}
@@ -0,0 +1,89 @@
+package client_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/GoFurry/gf-steam-sdk/pkg/steam"
+	"github.com/GoFurry/gf-steam-sdk/pkg/steamtest"
+	ue "github.com/GoFurry/gf-steam-sdk/pkg/util/errors"
+)
+
+func TestRequestErrorsCarryEndpoint(t *testing.T) {
+	tests := []struct {
+		name     string
+		endpoint string
+		failure  steamtest.Failure
+		call     func(sdk *steam.SteamSDK) error
+		want     error
+	}{
+		{
+			name:     "private achievements",
+			endpoint: playerAchievements,
+			failure:  steamtest.PrivateProfile(),
+			call: func(sdk *steam.SteamSDK) error {
+				_, err := sdk.Develop.GetPlayerAchievements(steamtest.SteamID, 620, "english")
+				return err
+			},
+			want: ue.ErrPrivateProfile,
+		},
+		{
+			name:     "no stats",
+			endpoint: playerAchievements,
+			failure: steamtest.Failure{
+				Status: http.StatusBadRequest,
+				Body:   []byte(`{"playerstats":{"error":"Requested app has no stats","success":false}}`),
+			},
+			call: func(sdk *steam.SteamSDK) error {
+				_, err := sdk.Develop.GetPlayerAchievements(steamtest.SteamID, 620, "english")
+				return err
+			},
+			want: ue.ErrNoStats,
+		},
+		{
+			name:     "private friend list",
+			endpoint: "ISteamUser/GetFriendList",
+			failure:  steamtest.PrivateProfile(),
+			call: func(sdk *steam.SteamSDK) error {
+				_, err := sdk.Develop.GetFriendList(steamtest.SteamID, nil)
+				return err
+			},
+			want: ue.ErrPrivateFriendList,
+		},
+		{
+			name:     "rate limited",
+			endpoint: ownedGames,
+			failure:  steamtest.RateLimited(0),
+			call: func(sdk *steam.SteamSDK) error {
+				_, err := sdk.Develop.GetOwnedGames(steamtest.SteamID, false)
+				return err
+			},
+			want: ue.ErrAPIQuotaExceeded,
+		},
+		{
+			name:     "server error",
+			endpoint: ownedGames,
+			failure:  steamtest.ServerError(),
+			call: func(sdk *steam.SteamSDK) error {
+				_, err := sdk.Develop.GetOwnedGames(steamtest.SteamID, false)
+				return err
+			},
+			want: ue.ErrServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sdk, srv := newSDK(t, nil)
+			srv.Fail(tt.endpoint, tt.failure, 0)
+
+			err := tt.call(sdk)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("want %v, got %v", tt.want, err)
+			}
+			if got := ue.GetEndpoint(err); got != tt.endpoint {
+				t.Fatalf("want endpoint %q, got %q", tt.endpoint, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package client
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/GoFurry/gf-steam-sdk/pkg/observe"
+	"github.com/GoFurry/gf-steam-sdk/pkg/util/errors"
+)
+
+// 熔断器状态 | Circuit states
+const (
+	circuitClosed   = "closed"    // 正常放行 | Requests pass through
+	circuitOpen     = "open"      // 快速失败 | Requests fail fast
+	circuitHalfOpen = "half_open" // 放行单个探测请求 | A single probe is in flight
+)
+
+// circuit 单个主机的熔断状态
+// circuit holds the breaker state of one host
+type circuit struct {
+	state     string
+	failures  int       // 连续失败次数 | Consecutive failures
+	openUntil time.Time // 熔断截止时间 | Open until
+}
+
+// breaker 按主机熔断器
+// 同一主机连续失败达到阈值后熔断, 冷却期内快速失败; 冷却结束后放行一个探测请求, 成功则恢复, 失败则重新熔断
+// breaker is a per-host circuit breaker
+// A host is opened after threshold consecutive failures and fails fast during the cooldown; afterwards a single
+// probe is let through, closing the circuit on success and re-opening it on failure
+type breaker struct {
+	mu        sync.Mutex
+	hosts     map[string]*circuit
+	threshold int
+	cooldown  time.Duration
+	now       func() time.Time
+}
+
+// newBreaker 创建按主机熔断器
+// 参数:
+//   - threshold: 连续失败阈值 | Consecutive failure threshold
+//   - cooldown: 熔断冷却时长 | Open state duration
+func newBreaker(threshold int, cooldown time.Duration) *breaker {
+	return &breaker{
+		hosts:     make(map[string]*circuit),
+		threshold: threshold,
+		cooldown:  cooldown,
+		now:       time.Now,
+	}
+}
+
+// allow 判断是否放行发往 host 的请求, 熔断中返回 ErrCircuitOpen
+// allow reports whether a request to host may be sent, returning ErrCircuitOpen while the circuit is open
+func (b *breaker) allow(host string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	c := b.hosts[host]
+	if c == nil {
+		return nil
+	}
+	switch c.state {
+	case circuitOpen:
+		now := b.now()
+		if now.Before(c.openUntil) {
+			return fmt.Errorf("%w: host=%s, retry in %v", errors.ErrCircuitOpen, host, c.openUntil.Sub(now).Round(time.Second))
+		}
+		// 冷却结束, 放行本次作为探测; 探测未上报结果(如被调用方取消)时, 再过一个冷却期允许新的探测
+		// Cooldown over, this request is the probe; if it never reports back (e.g. canceled by the caller), another probe is allowed after one more cooldown
+		c.state, c.openUntil = circuitHalfOpen, now.Add(b.cooldown)
+	case circuitHalfOpen:
+		now := b.now()
+		if now.Before(c.openUntil) {
+			return fmt.Errorf("%w: host=%s, probe in flight", errors.ErrCircuitOpen, host)
+		}
+		c.openUntil = now.Add(b.cooldown)
+	}
+	return nil
+}
+
+// success 记录一次成功, 关闭熔断 | Record a success and close the circuit
+func (b *breaker) success(host string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if c := b.hosts[host]; c != nil {
+		c.state, c.failures = circuitClosed, 0
+	}
+}
+
+// failure 记录一次失败, 达到阈值或探测失败时熔断
+// failure records a failure, opening the circuit at the threshold or when the probe fails
+func (b *breaker) failure(host string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	c := b.hosts[host]
+	if c == nil {
+		c = &circuit{state: circuitClosed}
+		b.hosts[host] = c
+	}
+	c.failures++
+	if c.state == circuitHalfOpen || c.failures >= b.threshold {
+		c.state = circuitOpen
+		c.openUntil = b.now().Add(b.cooldown)
+	}
+}
+
+// stats 获取各主机的熔断统计 | Breaker state of every host seen so far
+func (b *breaker) stats() []observe.CircuitStats {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	out := make([]observe.CircuitStats, 0, len(b.hosts))
+	for host, c := range b.hosts {
+		out = append(out, observe.CircuitStats{
+			Host:      host,
+			State:     c.state,
+			Failures:  c.failures,
+			OpenUntil: c.openUntil,
+		})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Host < out[j].Host })
+	return out
+}
@@ -0,0 +1,144 @@
+package client_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/GoFurry/gf-steam-sdk/pkg/config"
+	"github.com/GoFurry/gf-steam-sdk/pkg/steamtest"
+	ue "github.com/GoFurry/gf-steam-sdk/pkg/util/errors"
+)
+
+const breakerCooldown = 100 * time.Millisecond
+
+// withBreaker 连续失败 2 次熔断 | Open the circuit after 2 consecutive failures
+func withBreaker(cfg *config.SteamConfig) *config.SteamConfig {
+	return cfg.WithCircuitBreaker(2, breakerCooldown)
+}
+
+func TestBreakerDisabledByDefault(t *testing.T) {
+	if cb := config.NewDefaultConfig().CircuitBreaker; cb != nil {
+		t.Fatalf("default config enables the circuit breaker: %+v", cb)
+	}
+
+	sdk, srv := newSDK(t, nil)
+	srv.Fail(ownedGames, steamtest.ServerError(), 0)
+	for i := 0; i < 5; i++ {
+		_, err := sdk.Develop.GetOwnedGames(steamtest.SteamID, false)
+		if !errors.Is(err, ue.ErrServerError) {
+			t.Fatalf("call %d: want ErrServerError, got %v", i, err)
+		}
+	}
+	if circuits := sdk.Stats().Circuits; len(circuits) != 0 {
+		t.Fatalf("breaker state recorded while disabled: %+v", circuits)
+	}
+}
+
+func TestBreakerOpensAndFailsFast(t *testing.T) {
+	sdk, srv := newSDK(t, withBreaker)
+	srv.Fail(ownedGames, steamtest.ServerError(), 0)
+
+	// 第 2 次尝试失败后熔断, 第 3 次尝试直接失败 | The second failed attempt opens the circuit, the third fails fast
+	_, err := sdk.Develop.GetOwnedGames(steamtest.SteamID, false)
+	if !errors.Is(err, ue.ErrCircuitOpen) {
+		t.Fatalf("want ErrCircuitOpen, got %v", err)
+	}
+	if n := countRequests(srv, ownedGames); n != 2 {
+		t.Fatalf("want 2 requests before the circuit opened, got %d", n)
+	}
+
+	_, err = sdk.Develop.GetOwnedGames(steamtest.SteamID, false)
+	if !errors.Is(err, ue.ErrCircuitOpen) {
+		t.Fatalf("want ErrCircuitOpen while open, got %v", err)
+	}
+	if n := countRequests(srv, ownedGames); n != 2 {
+		t.Fatalf("open circuit still sent requests: %d", n)
+	}
+
+	circuits := sdk.Stats().Circuits
+	if len(circuits) != 1 || circuits[0].State != "open" {
+		t.Fatalf("want one open circuit, got %+v", circuits)
+	}
+}
+
+func TestBreakerClosesAfterSuccessfulProbe(t *testing.T) {
+	sdk, srv := newSDK(t, withBreaker)
+	srv.Fail(ownedGames, steamtest.ServerError(), 2)
+	if _, err := sdk.Develop.GetOwnedGames(steamtest.SteamID, false); !errors.Is(err, ue.ErrCircuitOpen) {
+		t.Fatalf("want ErrCircuitOpen, got %v", err)
+	}
+
+	time.Sleep(breakerCooldown + 20*time.Millisecond)
+	if _, err := sdk.Develop.GetOwnedGames(steamtest.SteamID, false); err != nil {
+		t.Fatalf("probe after cooldown failed: %v", err)
+	}
+	circuits := sdk.Stats().Circuits
+	if len(circuits) != 1 || circuits[0].State != "closed" || circuits[0].Failures != 0 {
+		t.Fatalf("want a closed circuit after the probe, got %+v", circuits)
+	}
+}
+
+func TestBreakerReopensAfterFailedProbe(t *testing.T) {
+	sdk, srv := newSDK(t, withBreaker)
+	srv.Fail(ownedGames, steamtest.ServerError(), 0)
+	if _, err := sdk.Develop.GetOwnedGames(steamtest.SteamID, false); !errors.Is(err, ue.ErrCircuitOpen) {
+		t.Fatalf("want ErrCircuitOpen, got %v", err)
+	}
+
+	time.Sleep(breakerCooldown + 20*time.Millisecond)
+	before := countRequests(srv, ownedGames)
+	if _, err := sdk.Develop.GetOwnedGames(steamtest.SteamID, false); !errors.Is(err, ue.ErrCircuitOpen) {
+		t.Fatalf("want ErrCircuitOpen after the failed probe, got %v", err)
+	}
+	// 只放行一个探测请求, 探测失败立即重新熔断 | Only one probe goes out and its failure reopens the circuit
+	if n := countRequests(srv, ownedGames) - before; n != 1 {
+		t.Fatalf("want exactly 1 probe request, got %d", n)
+	}
+	if circuits := sdk.Stats().Circuits; len(circuits) != 1 || circuits[0].State != "open" {
+		t.Fatalf("want the circuit reopened, got %+v", circuits)
+	}
+}
+
+func TestBreakerIgnoresClientErrors(t *testing.T) {
+	sdk, srv := newSDK(t, withBreaker)
+	srv.Fail(ownedGames, steamtest.Forbidden(), 0)
+	for i := 0; i < 5; i++ {
+		if _, err := sdk.Develop.GetOwnedGames(steamtest.SteamID, false); !errors.Is(err, ue.ErrUnauthorized) {
+			t.Fatalf("call %d: want ErrUnauthorized, got %v", i, err)
+		}
+	}
+	if circuits := sdk.Stats().Circuits; len(circuits) != 0 {
+		t.Fatalf("4xx responses touched the breaker: %+v", circuits)
+	}
+}
+
+func TestBreakerConcurrentUse(t *testing.T) {
+	sdk, srv := newSDK(t, withBreaker)
+	srv.Fail(ownedGames, steamtest.ServerError(), 0)
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 10; i++ {
+				_, err := sdk.Develop.GetOwnedGames(steamtest.SteamID, false)
+				if !errors.Is(err, ue.ErrCircuitOpen) && !errors.Is(err, ue.ErrServerError) {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if circuits := sdk.Stats().Circuits; len(circuits) != 1 || circuits[0].State != "open" {
+		t.Fatalf("want one open circuit, got %+v", circuits)
+	}
+	// 熔断后大部分请求被快速拒绝 | Once open, most calls are rejected without reaching the server
+	if n := countRequests(srv, ownedGames); n >= 8*10 {
+		t.Fatalf("breaker did not shed load: %d requests", n)
+	}
+}
@@ -0,0 +1,217 @@
+package client_test
+
+import (
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/GoFurry/gf-steam-sdk/pkg/cache"
+	"github.com/GoFurry/gf-steam-sdk/pkg/config"
+	"github.com/GoFurry/gf-steam-sdk/pkg/option"
+	"github.com/GoFurry/gf-steam-sdk/pkg/steamtest"
+	"github.com/GoFurry/gf-steam-sdk/pkg/util"
+)
+
+// withCache 启用默认内存缓存并为 ownedGames 开启缓存, ttl <=0 时使用默认 TTL
+// withCache enables the default in-memory cache and opts ownedGames in, with the default TTL when ttl <=0
+func withCache(ttl time.Duration) func(*config.SteamConfig) *config.SteamConfig {
+	return func(cfg *config.SteamConfig) *config.SteamConfig {
+		if ttl <= 0 {
+			ttl = util.DEFAULT_CACHE_TTL
+		}
+		return cfg.WithCache(config.NewDefaultCacheConfig().WithTTL(ownedGames, ttl))
+	}
+}
+
+// withETag 以指定 ETag 返回 ownedGames 的固定响应 | Serve the ownedGames fixture with an ETag
+func withETag(srv *steamtest.Server, etag string, times int) {
+	srv.Fail(ownedGames, steamtest.Failure{
+		Status: http.StatusOK,
+		Header: http.Header{"Etag": {etag}},
+		Body:   srv.Fixture(ownedGames),
+	}, times)
+}
+
+func TestCacheServesFreshEntries(t *testing.T) {
+	sdk, srv := newSDK(t, withCache(0))
+	for i := 0; i < 3; i++ {
+		if _, err := sdk.Develop.GetOwnedGames(steamtest.SteamID, false); err != nil {
+			t.Fatalf("call %d: %v", i, err)
+		}
+	}
+	if n := countRequests(srv, ownedGames); n != 1 {
+		t.Fatalf("want 1 request, got %d", n)
+	}
+	st := sdk.CacheStats()
+	if st.Hits != 2 || st.Misses != 1 || st.Stores != 1 {
+		t.Fatalf("unexpected cache stats: %+v", st)
+	}
+}
+
+func TestCacheOptInPerEndpoint(t *testing.T) {
+	sdk, srv := newSDK(t, func(cfg *config.SteamConfig) *config.SteamConfig {
+		return cfg.WithCache(nil)
+	})
+	for i := 0; i < 2; i++ {
+		if _, err := sdk.Develop.GetOwnedGames(steamtest.SteamID, false); err != nil {
+			t.Fatalf("owned games %d: %v", i, err)
+		}
+		if _, err := sdk.Develop.GetNewsForApp(steamtest.AppID, nil); err != nil {
+			t.Fatalf("news %d: %v", i, err)
+		}
+	}
+	// 按玩家的接口默认不缓存, 公开接口默认缓存 | Per-player endpoints are not cached by default, public ones are
+	if n := countRequests(srv, ownedGames); n != 2 {
+		t.Fatalf("want owned games uncached, got %d requests", n)
+	}
+	if n := countRequests(srv, "ISteamNews/GetNewsForApp"); n != 1 {
+		t.Fatalf("want news cached, got %d requests", n)
+	}
+}
+
+// countingStore 统计写入次数的自定义存储 | Custom store counting its writes
+type countingStore struct {
+	mu      sync.Mutex
+	entries map[string]*cache.Entry
+	sets    int
+}
+
+func (s *countingStore) Get(key string) (*cache.Entry, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, ok := s.entries[key]
+	return e, ok
+}
+
+func (s *countingStore) Set(key string, e *cache.Entry) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries[key] = e
+	s.sets++
+}
+
+func (s *countingStore) Delete(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.entries, key)
+}
+
+func (s *countingStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.entries)
+}
+
+func TestCacheCustomStore(t *testing.T) {
+	store := &countingStore{entries: map[string]*cache.Entry{}}
+	sdk, srv := newSDK(t, func(cfg *config.SteamConfig) *config.SteamConfig {
+		cc := config.NewDefaultCacheConfig().WithTTL(ownedGames, time.Minute)
+		cc.Backend, cc.Store = "unused", store
+		return cfg.WithCache(cc)
+	})
+	for i := 0; i < 2; i++ {
+		if _, err := sdk.Develop.GetOwnedGames(steamtest.SteamID, false); err != nil {
+			t.Fatalf("call %d: %v", i, err)
+		}
+	}
+	if n := countRequests(srv, ownedGames); n != 1 || store.sets != 1 {
+		t.Fatalf("want one request stored in the custom store, got %d requests and %d sets", n, store.sets)
+	}
+	if st := sdk.Stats().Cache; st.Hits != 1 || st.Entries != 1 {
+		t.Fatalf("unexpected cache stats: %+v", st)
+	}
+}
+
+func TestCacheKeyedByRequestKey(t *testing.T) {
+	sdk, srv := newSDK(t, withCache(0))
+	for _, key := range []string{"TENANT-A", "TENANT-B", "TENANT-A"} {
+		if _, err := sdk.Develop.GetOwnedGames(steamtest.SteamID, false, option.WithAPIKey(key)); err != nil {
+			t.Fatalf("key %s: %v", key, err)
+		}
+	}
+	// 不同租户的 Key 不共享缓存条目 | Different tenant keys never share an entry
+	if n := countRequests(srv, ownedGames); n != 2 {
+		t.Fatalf("want one request per key, got %d", n)
+	}
+}
+
+func TestCacheSharedAcrossPooledKeys(t *testing.T) {
+	sdk, srv := newSDK(t, func(cfg *config.SteamConfig) *config.SteamConfig {
+		return withCache(0)(withPool(cfg))
+	})
+	for i := 0; i < len(poolKeys); i++ {
+		if _, err := sdk.Develop.GetOwnedGames(steamtest.SteamID, false); err != nil {
+			t.Fatalf("call %d: %v", i, err)
+		}
+	}
+	if n := countRequests(srv, ownedGames); n != 1 {
+		t.Fatalf("want pooled keys to share one entry, got %d requests", n)
+	}
+}
+
+func TestCacheRevalidatesWithETag(t *testing.T) {
+	const ttl = 20 * time.Millisecond
+	sdk, srv := newSDK(t, withCache(ttl))
+	withETag(srv, `"v1"`, 1)
+	if _, err := sdk.Develop.GetOwnedGames(steamtest.SteamID, false); err != nil {
+		t.Fatalf("first call: %v", err)
+	}
+
+	time.Sleep(2 * ttl)
+	srv.Fail(ownedGames, steamtest.Failure{Status: http.StatusNotModified}, 1)
+	games, err := sdk.Develop.GetOwnedGames(steamtest.SteamID, false)
+	if err != nil {
+		t.Fatalf("revalidation: %v", err)
+	}
+	if len(games) == 0 {
+		t.Fatal("want the cached body after a 304, got no games")
+	}
+
+	reqs := srv.Requests()
+	if len(reqs) != 2 {
+		t.Fatalf("want 2 requests, got %d", len(reqs))
+	}
+	if got := reqs[1].Header.Get("If-None-Match"); got != `"v1"` {
+		t.Fatalf("want If-None-Match %q, got %q", `"v1"`, got)
+	}
+	if st := sdk.CacheStats(); st.Revalidated != 1 {
+		t.Fatalf("want 1 revalidation, got %+v", st)
+	}
+
+	// 304 续期后条目重新变为新鲜 | A 304 renews the entry
+	if _, err = sdk.Develop.GetOwnedGames(steamtest.SteamID, false); err != nil {
+		t.Fatalf("third call: %v", err)
+	}
+	if n := countRequests(srv, ownedGames); n != 2 {
+		t.Fatalf("renewed entry not served from cache: %d requests", n)
+	}
+}
+
+func TestCacheConcurrentRevalidation(t *testing.T) {
+	sdk, srv := newSDK(t, withCache(time.Nanosecond))
+	withETag(srv, `"v1"`, 1)
+	if _, err := sdk.Develop.GetOwnedGames(steamtest.SteamID, false); err != nil {
+		t.Fatalf("first call: %v", err)
+	}
+	srv.Fail(ownedGames, steamtest.Failure{Status: http.StatusNotModified}, 0)
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 10; i++ {
+				if _, err := sdk.Develop.GetOwnedGames(steamtest.SteamID, false); err != nil {
+					t.Errorf("call failed: %v", err)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if st := sdk.CacheStats(); st.Revalidated == 0 {
+		t.Fatalf("want revalidations, got %+v", st)
+	}
+}
@@ -0,0 +1,48 @@
+package client_test
+
+import (
+	"testing"
+
+	"github.com/GoFurry/gf-steam-sdk/pkg/config"
+	"github.com/GoFurry/gf-steam-sdk/pkg/steam"
+	"github.com/GoFurry/gf-steam-sdk/pkg/steamtest"
+)
+
+// ownedGames 测试使用的 Web API 接口 | Web API endpoint used by the tests
+const ownedGames = "IPlayerService/GetOwnedGames"
+
+var poolKeys = []string{"POOLKEY-AAAAAAAA", "POOLKEY-BBBBBBBB", "POOLKEY-CCCCCCCC"}
+
+// newSDK 启动模拟服务器并按 configure 调整配置后创建 SDK, 测试结束时自动关闭
+// newSDK starts a fake server and builds an SDK from its config adjusted by configure, both closed when the test ends
+func newSDK(t *testing.T, configure func(*config.SteamConfig) *config.SteamConfig) (*steam.SteamSDK, *steamtest.Server) {
+	t.Helper()
+	srv := steamtest.NewServer()
+	t.Cleanup(srv.Close)
+	cfg := srv.Config()
+	if configure != nil {
+		cfg = configure(cfg)
+	}
+	sdk, err := srv.NewSDK(cfg)
+	if err != nil {
+		t.Fatalf("create sdk: %v", err)
+	}
+	t.Cleanup(func() { _ = sdk.Close() })
+	return sdk, srv
+}
+
+// countRequests 统计模拟服务器收到的指定接口请求数 | Count the requests the fake server received for an endpoint
+func countRequests(srv *steamtest.Server, endpoint string) int {
+	n := 0
+	for _, r := range srv.Requests() {
+		if r.Endpoint == endpoint {
+			n++
+		}
+	}
+	return n
+}
+
+// withPool 使用 poolKeys 轮询 | Round-robin over poolKeys
+func withPool(cfg *config.SteamConfig) *config.SteamConfig {
+	return cfg.WithAPIKeyPool(poolKeys, "round_robin")
+}
@@ -0,0 +1,249 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/GoFurry/gf-steam-sdk/pkg/observe"
+	"github.com/GoFurry/gf-steam-sdk/pkg/util"
+	"github.com/GoFurry/gf-steam-sdk/pkg/util/errors"
+	"golang.org/x/time/rate"
+)
+
+// steamDayLocation Steam 每日配额按太平洋时间零点重置
+// tzdata 不可用时退化为固定 UTC-8(夏令时期间会提前一小时重置)
+// steamDayLocation is the time zone of Steam's daily quota boundary (Pacific time midnight)
+// Falls back to a fixed UTC-8 when tzdata is unavailable (resets one hour early during DST)
+var steamDayLocation = func() *time.Location {
+	if loc, err := time.LoadLocation("America/Los_Angeles"); err == nil {
+		return loc
+	}
+	return time.FixedZone("PST", -8*60*60)
+}()
+
+// apiKey 单个 API Key 的状态
+// apiKey holds the state of one API key
+type apiKey struct {
+	key              string
+	limiter          *rate.Limiter // 独立令牌桶 | Per-key token bucket
+	day              string        // 当前计数所属日期(Steam 时区) | Day of the current counter (Steam time zone)
+	usedToday        uint64        // 当日调用次数 | Calls today
+	total            uint64        // 累计调用次数 | Calls in total
+	failures         uint64        // Key 被拒绝次数 | Times the key was rejected
+	quarantinedUntil time.Time     // 隔离截止时间 | Quarantined until
+}
+
+// keyPool API Key 池
+// 按策略轮换 Key, 每个 Key 独立限流并按 Steam 日界统计配额, 被 Steam 拒绝(429/Key 无效的 403)的 Key 自动隔离
+// keyPool rotates API keys by strategy, each key has its own limiter and daily quota counter,
+// keys rejected by Steam (429/bad-key 403) are quarantined automatically
+type keyPool struct {
+	mu         sync.Mutex
+	keys       []*apiKey
+	strategy   string
+	next       int           // 轮询游标 | Round-robin cursor
+	dailyLimit uint64        // 每个 Key 每日上限(0 表示不限) | Daily limit per key (0 means unlimited)
+	quarantine time.Duration // 隔离时长 | Quarantine duration
+	now        func() time.Time
+}
+
+// newKeyPool 创建 API Key 池
+// 参数:
+//   - keys: API Key 列表 | API keys
+//   - strategy: 选择策略 round_robin/random/least_used | Selection strategy round_robin/random/least_used
+//   - qps/burst: 每个 Key 的限流 | Per-key rate limit
+//   - dailyLimit: 每个 Key 每日上限(<=0 表示不限) | Daily limit per key (<=0 means unlimited)
+//   - quarantine: 隔离时长 | Quarantine duration
+func newKeyPool(keys []string, strategy string, qps float64, burst int, dailyLimit int, quarantine time.Duration) *keyPool {
+	p := &keyPool{
+		strategy:   strategy,
+		quarantine: quarantine,
+		now:        time.Now,
+	}
+	if dailyLimit > 0 {
+		p.dailyLimit = uint64(dailyLimit)
+	}
+	for _, k := range keys {
+		p.keys = append(p.keys, &apiKey{
+			key:     k,
+			limiter: rate.NewLimiter(rate.Limit(qps), burst),
+		})
+	}
+	return p
+}
+
+// acquire 选取一个可用 Key 并等待其令牌
+// 选取时即占用当日配额, 避免并发请求超发; 等待令牌失败时归还配额
+// 所有 Key 均被隔离或超出当日配额时返回 ErrAPIQuotaExceeded
+// acquire picks an available key and waits for its token
+// The daily quota is reserved when picking so concurrent requests cannot overspend it, and given back if the wait fails
+// Returns ErrAPIQuotaExceeded when every key is quarantined or over its daily quota
+func (p *keyPool) acquire(ctx context.Context) (*apiKey, error) {
+	k, day := p.pick()
+	if k == nil {
+		return nil, fmt.Errorf("%w: no api key available (all quarantined or over daily quota)", errors.ErrAPIQuotaExceeded)
+	}
+	if err := k.limiter.Wait(ctx); err != nil {
+		p.release(k, day)
+		return nil, fmt.Errorf("%w: request rate limit exceeded: %w", errors.ErrRequestFailed, err)
+	}
+	return k, nil
+}
+
+// release 归还 pick 占用的调用计数(已跨过 Steam 日界时当日计数已重置, 只归还累计计数)
+// release gives back the call counted by pick (after a Steam day boundary the daily counter was already reset,
+// so only the total is given back)
+func (p *keyPool) release(k *apiKey, day string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if k.day == day && k.usedToday > 0 {
+		k.usedToday--
+	}
+	if k.total > 0 {
+		k.total--
+	}
+}
+
+// pick 按策略选取可用 Key 并计数, 同时返回计数所属的 Steam 日 | Pick an available key by strategy, count the call and return its Steam day
+func (p *keyPool) pick() (*apiKey, string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.now()
+	day := now.In(steamDayLocation).Format(util.TIME_FORMAT_DAY)
+	candidates := make([]int, 0, len(p.keys))
+	for i, k := range p.keys {
+		if k.day != day {
+			k.day, k.usedToday = day, 0 // 跨过 Steam 日界, 重置当日计数 | New Steam day, reset the daily counter
+		}
+		if now.Before(k.quarantinedUntil) {
+			continue
+		}
+		if p.dailyLimit > 0 && k.usedToday >= p.dailyLimit {
+			continue
+		}
+		candidates = append(candidates, i)
+	}
+	if len(candidates) == 0 {
+		return nil, ""
+	}
+
+	var idx int
+	switch p.strategy {
+	case "random":
+		idx = candidates[rand.Intn(len(candidates))]
+	case "least_used":
+		idx = candidates[0]
+		for _, i := range candidates[1:] {
+			if p.keys[i].usedToday < p.keys[idx].usedToday {
+				idx = i
+			}
+		}
+	default: // round_robin
+		idx = candidates[0]
+		for _, i := range candidates {
+			if i >= p.next {
+				idx = i
+				break
+			}
+		}
+		p.next = idx + 1
+	}
+
+	k := p.keys[idx]
+	k.usedToday++
+	k.total++
+	return k, day
+}
+
+// report 上报 Key 的响应, Key 被拒绝时隔离该 Key 并返回 true
+// report records the response of a key, quarantining it and returning true when the key was rejected
+func (p *keyPool) report(k *apiKey, resp *http.Response) bool {
+	if !keyRejected(resp) {
+		return false
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	k.failures++
+	k.quarantinedUntil = p.now().Add(p.quarantine)
+	return true
+}
+
+// stats 获取每个 Key 的使用统计 | Usage of every key
+func (p *keyPool) stats() []observe.KeyStats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.now()
+	day := now.In(steamDayLocation).Format(util.TIME_FORMAT_DAY)
+	out := make([]observe.KeyStats, 0, len(p.keys))
+	for _, k := range p.keys {
+		used := k.usedToday
+		if k.day != day {
+			used = 0
+		}
+		remaining := int64(-1)
+		if p.dailyLimit > 0 {
+			remaining = int64(p.dailyLimit) - int64(used)
+		}
+		out = append(out, observe.KeyStats{
+			Key:              maskKey(k.key),
+			UsedToday:        used,
+			RemainingToday:   remaining,
+			Total:            k.total,
+			Failures:         k.failures,
+			Quarantined:      now.Before(k.quarantinedUntil),
+			QuarantinedUntil: k.quarantinedUntil,
+		})
+	}
+	return out
+}
+
+// keyRejectPeek 判断 403 是否为 Key 无效页面时读取的响应体字节数 | Body bytes read to tell a bad-key 403 page
+const keyRejectPeek = 512
+
+// keyRejected 响应是否表示 Key 本身被拒绝: 429, 或要求核对 key= 参数的 HTML 403 页面
+// 带 JSON 响应体的 403 针对的是请求内容(如资料未公开的 GetPlayerAchievements), 与 Key 无关
+// keyRejected reports whether the response rejects the key itself: a 429, or the HTML 403 page asking to verify the key= param
+// A 403 with a JSON body is about the request (e.g. GetPlayerAchievements for a private profile), not the key
+func keyRejected(resp *http.Response) bool {
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusForbidden:
+		return badKeyPage(resp)
+	}
+	return false
+}
+
+// badKeyPage 响应体是否为 Steam 要求核对 key= 参数的 HTML 错误页
+// badKeyPage reports whether the body is Steam's HTML error page asking to verify the key= param
+func badKeyPage(resp *http.Response) bool {
+	head := bytes.ToLower(peekBody(resp, keyRejectPeek))
+	return bytes.Contains(head, []byte("<html")) && bytes.Contains(head, []byte("key="))
+}
+
+// peekBody 读取响应体开头最多 n 字节, resp.Body 仍可从头完整读取
+// peekBody reads up to n bytes from the start of the body, leaving resp.Body readable from the beginning
+func peekBody(resp *http.Response, n int64) []byte {
+	head, _ := io.ReadAll(io.LimitReader(resp.Body, n))
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(head), resp.Body), resp.Body}
+	return head
+}
+
+// maskKey 脱敏 API Key, 仅保留首尾各 4 位 | Mask an API key, keeping 4 chars at each end
+func maskKey(key string) string {
+	if len(key) <= 8 {
+		return "****"
+	}
+	return key[:4] + "****" + key[len(key)-4:]
+}
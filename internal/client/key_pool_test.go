@@ -0,0 +1,242 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/GoFurry/gf-steam-sdk/pkg/config"
+	"github.com/GoFurry/gf-steam-sdk/pkg/option"
+	"github.com/GoFurry/gf-steam-sdk/pkg/steamtest"
+	ue "github.com/GoFurry/gf-steam-sdk/pkg/util/errors"
+)
+
+const playerAchievements = "ISteamUserStats/GetPlayerAchievements"
+
+// keysUsed 按顺序返回指定接口请求携带的 Key | Keys sent with the requests of an endpoint, in order
+func keysUsed(srv *steamtest.Server, endpoint string) []string {
+	var keys []string
+	for _, r := range srv.Requests() {
+		if r.Endpoint == endpoint {
+			keys = append(keys, r.Query.Get("key"))
+		}
+	}
+	return keys
+}
+
+func TestKeyPoolRoundRobin(t *testing.T) {
+	sdk, srv := newSDK(t, withPool)
+	for i := 0; i < 2*len(poolKeys); i++ {
+		if _, err := sdk.Develop.GetOwnedGames(steamtest.SteamID, false); err != nil {
+			t.Fatalf("call %d: %v", i, err)
+		}
+	}
+
+	used := keysUsed(srv, ownedGames)
+	for i, key := range used {
+		if want := poolKeys[i%len(poolKeys)]; key != want {
+			t.Fatalf("request %d used %q, want %q", i, key, want)
+		}
+	}
+	for _, st := range sdk.Stats().Keys {
+		if st.UsedToday != 2 || st.Quarantined {
+			t.Fatalf("unexpected key stats: %+v", st)
+		}
+	}
+}
+
+func TestKeyPoolQuarantinesRejectedKeys(t *testing.T) {
+	for name, failure := range map[string]steamtest.Failure{
+		"rate limited": steamtest.RateLimited(0),
+		"bad key page": steamtest.Forbidden(),
+	} {
+		t.Run(name, func(t *testing.T) {
+			sdk, srv := newSDK(t, withPool)
+			srv.Fail(ownedGames, failure, 1)
+
+			// 被拒绝的 Key 隔离后立即换 Key 重试 | The rejected key is quarantined and the call retries right away with the next key
+			if _, err := sdk.Develop.GetOwnedGames(steamtest.SteamID, false); err != nil {
+				t.Fatalf("want the retry on the next key to succeed, got %v", err)
+			}
+			for i := 0; i < 4; i++ {
+				if _, err := sdk.Develop.GetOwnedGames(steamtest.SteamID, false); err != nil {
+					t.Fatalf("call %d: %v", i, err)
+				}
+			}
+			used := keysUsed(srv, ownedGames)
+			if used[0] != poolKeys[0] {
+				t.Fatalf("first request used %q, want %q", used[0], poolKeys[0])
+			}
+			for i, key := range used[1:] {
+				if key == poolKeys[0] {
+					t.Fatalf("request %d used the quarantined key", i+1)
+				}
+			}
+
+			st := sdk.Stats().Keys[0]
+			if !st.Quarantined || st.Failures != 1 {
+				t.Fatalf("want the first key quarantined with 1 failure, got %+v", st)
+			}
+		})
+	}
+}
+
+func TestKeyPoolKeepsKeysOnJSONForbidden(t *testing.T) {
+	sdk, srv := newSDK(t, withPool)
+	srv.Fail(playerAchievements, steamtest.PrivateProfile(), 0)
+
+	// 资料未公开的 403 针对的是请求, 不隔离 Key 也不换 Key 重试 | A private-profile 403 is about the request: no quarantine, no retry
+	for i := 0; i < 2*len(poolKeys); i++ {
+		_, err := sdk.Develop.GetPlayerAchievements(steamtest.SteamID, 620, "english")
+		if !errors.Is(err, ue.ErrPrivateProfile) {
+			t.Fatalf("call %d: want ErrPrivateProfile, got %v", i, err)
+		}
+	}
+	if n := countRequests(srv, playerAchievements); n != 2*len(poolKeys) {
+		t.Fatalf("want one request per call, got %d", n)
+	}
+	for _, st := range sdk.Stats().Keys {
+		if st.Quarantined || st.Failures != 0 {
+			t.Fatalf("key quarantined by a private profile: %+v", st)
+		}
+	}
+}
+
+func TestKeyPoolExhausted(t *testing.T) {
+	sdk, srv := newSDK(t, func(cfg *config.SteamConfig) *config.SteamConfig {
+		return cfg.WithAPIKeyPool(poolKeys[:2], "round_robin").WithRetryTimes(2)
+	})
+	srv.Fail(ownedGames, steamtest.RateLimited(0), 0)
+
+	_, err := sdk.Develop.GetOwnedGames(steamtest.SteamID, false)
+	if !errors.Is(err, ue.ErrAPIQuotaExceeded) {
+		t.Fatalf("want ErrAPIQuotaExceeded, got %v", err)
+	}
+	if n := countRequests(srv, ownedGames); n != 2 {
+		t.Fatalf("want one request per key, got %d", n)
+	}
+
+	// 全部隔离后不再发出请求 | Nothing is sent while every key is quarantined
+	if _, err = sdk.Develop.GetOwnedGames(steamtest.SteamID, false); !errors.Is(err, ue.ErrAPIQuotaExceeded) {
+		t.Fatalf("want ErrAPIQuotaExceeded, got %v", err)
+	}
+	if n := countRequests(srv, ownedGames); n != 2 {
+		t.Fatalf("requests sent with every key quarantined: %d", n)
+	}
+}
+
+func TestKeyPoolDailyLimit(t *testing.T) {
+	sdk, srv := newSDK(t, func(cfg *config.SteamConfig) *config.SteamConfig {
+		return cfg.WithAPIKeyPool(poolKeys[:2], "least_used").WithAPIKeyQuota(1, time.Minute)
+	})
+	for i := 0; i < 2; i++ {
+		if _, err := sdk.Develop.GetOwnedGames(steamtest.SteamID, false); err != nil {
+			t.Fatalf("call %d: %v", i, err)
+		}
+	}
+	if _, err := sdk.Develop.GetOwnedGames(steamtest.SteamID, false); !errors.Is(err, ue.ErrAPIQuotaExceeded) {
+		t.Fatalf("want ErrAPIQuotaExceeded over the daily limit, got %v", err)
+	}
+	if n := countRequests(srv, ownedGames); n != 2 {
+		t.Fatalf("want 2 requests, got %d", n)
+	}
+	for _, st := range sdk.Stats().Keys {
+		if st.UsedToday != 1 || st.RemainingToday != 0 {
+			t.Fatalf("unexpected key stats: %+v", st)
+		}
+	}
+}
+
+func TestKeyPoolWaitFailureKeepsQuota(t *testing.T) {
+	sdk, srv := newSDK(t, func(cfg *config.SteamConfig) *config.SteamConfig {
+		return cfg.WithAPIKeyPool(poolKeys[:1], "round_robin").WithAPIKeyQuota(2, time.Minute).WithRateLimit(0.001, 1)
+	})
+	if _, err := sdk.Develop.GetOwnedGames(steamtest.SteamID, false); err != nil {
+		t.Fatalf("first call: %v", err)
+	}
+
+	// 令牌已用尽, 截止时间内等不到下一个令牌 | The token is spent and the next one cannot arrive before the deadline
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := sdk.Develop.GetOwnedGamesCtx(ctx, steamtest.SteamID, false); !errors.Is(err, ue.ErrRequestFailed) {
+		t.Fatalf("want ErrRequestFailed from the limiter, got %v", err)
+	}
+	if n := countRequests(srv, ownedGames); n != 1 {
+		t.Fatalf("want 1 request, got %d", n)
+	}
+	if st := sdk.Stats().Keys[0]; st.UsedToday != 1 || st.Total != 1 || st.RemainingToday != 1 {
+		t.Fatalf("failed wait was counted against the key: %+v", st)
+	}
+}
+
+func TestKeyPoolBypassedByRequestKey(t *testing.T) {
+	sdk, srv := newSDK(t, withPool)
+	if _, err := sdk.Develop.GetOwnedGames(steamtest.SteamID, false, option.WithAPIKey("TENANT-KEY")); err != nil {
+		t.Fatalf("owned games: %v", err)
+	}
+	if used := keysUsed(srv, ownedGames); len(used) != 1 || used[0] != "TENANT-KEY" {
+		t.Fatalf("want the per-request key, got %v", used)
+	}
+	for _, st := range sdk.Stats().Keys {
+		if st.Total != 0 {
+			t.Fatalf("pool used by a per-request key: %+v", st)
+		}
+	}
+}
+
+func TestKeyPoolConcurrentUse(t *testing.T) {
+	sdk, srv := newSDK(t, withPool)
+	srv.Fail(ownedGames, steamtest.RateLimited(0), 1)
+
+	const goroutines, calls = 8, 10
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < calls; i++ {
+				if _, err := sdk.Develop.GetOwnedGames(steamtest.SteamID, false); err != nil {
+					t.Errorf("call failed: %v", err)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	var total uint64
+	quarantined := 0
+	for _, st := range sdk.Stats().Keys {
+		total += st.Total
+		if st.Quarantined {
+			quarantined++
+		}
+	}
+	if n := countRequests(srv, ownedGames); total != uint64(n) || n != goroutines*calls+1 {
+		t.Fatalf("pool counted %d calls, server saw %d, want %d", total, n, goroutines*calls+1)
+	}
+	if quarantined != 1 {
+		t.Fatalf("want exactly 1 quarantined key, got %d", quarantined)
+	}
+}
+
+func TestKeyPoolSkipsStoreRequests(t *testing.T) {
+	sdk, srv := newSDK(t, withPool)
+	if _, err := sdk.Store.GetAppDetails(620, nil); err != nil {
+		t.Fatalf("app details: %v", err)
+	}
+
+	// 商店请求不携带凭证 | Store requests carry no credentials
+	for _, r := range srv.Requests() {
+		if r.Query.Has("key") || r.Query.Has("access_token") {
+			t.Fatalf("store request carried credentials: %v", r.Query)
+		}
+	}
+	for _, st := range sdk.Stats().Keys {
+		if st.Total != 0 {
+			t.Fatalf("pool used by a store request: %+v", st)
+		}
+	}
+}
@@ -7,8 +7,9 @@ package crawler
 
 import (
 	"context"
-	"fmt"
+	"log/slog"
 	"math/rand"
+	"net/http"
 	"net/url"
 	"strconv"
 	"time"
@@ -25,14 +26,29 @@ type AntiCrawl struct {
 	cfg     *config.SteamConfig // 爬虫配置 | Crawler configuration
 	limiter *rate.Limiter       // 速率限制器 | Rate limiter
 	random  *rand.Rand          // 随机数生成器 | Random number generator
+	retry   *config.RetryPolicy // 重试策略(与 API 请求共用) | Retry policy (shared with API requests)
+	log     *slog.Logger        // 结构化日志(已脱敏) | Structured logger (redacting)
 }
 
+// colly.Context 中保存请求状态的键 | Keys of the request state inside colly.Context
+const (
+	attemptsKey      = "gf_attempts"        // 已发出的尝试次数 | Attempts sent so far
+	retryStartKey    = "gf_retry_start"     // 首次尝试时间 | Time of the first attempt
+	rateLimitWaitKey = "gf_rate_limit_wait" // 累计限流等待 | Accumulated rate-limit wait
+)
+
 // NewAntiCrawl creates anti-crawl strategy instance 创建反爬策略实例
 func NewAntiCrawl(cfg *config.SteamConfig) *AntiCrawl {
+	retry := cfg.RetryPolicy
+	if retry == nil {
+		retry = config.NewDefaultRetryPolicy()
+	}
 	return &AntiCrawl{
 		cfg:     cfg,
 		limiter: rate.NewLimiter(rate.Limit(cfg.CrawlerQPS), cfg.CrawlerBurst),
 		random:  rand.New(rand.NewSource(time.Now().UnixNano())),
+		retry:   retry,
+		log:     cfg.Log(),
 	}
 }
 
@@ -51,9 +67,18 @@ func (a *AntiCrawl) Apply(c *colly.Collector) {
 	c.OnRequest(func(r *colly.Request) {
 		// 速率限制校验
 		// Rate limit check
-		if err := a.limiter.Wait(context.Background()); err != nil {
+		waitStart := time.Now()
+		err := a.limiter.Wait(ContextOf(r))
+		r.Ctx.Put(rateLimitWaitKey, RateLimitWaitOf(r.Ctx)+time.Since(waitStart))
+		if err != nil {
 			r.Abort() // 触发限流则终止请求 | Abort request if rate limited
 		}
+		// 记录尝试次数和首次尝试时间, 用于重试次数与总耗时上限
+		// Count the attempt and remember the first attempt time for the retry limits
+		r.Ctx.Put(attemptsKey, AttemptsOf(r.Ctx)+1)
+		if r.Ctx.GetAny(retryStartKey) == nil {
+			r.Ctx.Put(retryStartKey, time.Now())
+		}
 		// 设置随机 Referer
 		// Set random Referer
 		r.Headers.Set("Referer", a.getRandomReferer())
@@ -62,16 +87,77 @@ func (a *AntiCrawl) Apply(c *colly.Collector) {
 		//r.Headers.Set("Accept-Language", a.getRandomLang())
 	})
 
-	// 错误处理钩子: 5xx 错误自动重试
-	// Error hook: auto retry on 5xx errors
+	// 错误处理钩子: 按重试策略重试(调用方 ctx 已取消时不再重试)
+	// Error hook: retry following the retry policy (skipped once the caller ctx is canceled)
 	c.OnError(func(r *colly.Response, err error) {
-		if r != nil && r.StatusCode >= 500 && r.StatusCode < 600 {
-			r.Request.Retry()
+		if r == nil || r.Request == nil || r.Ctx == nil {
+			return
+		}
+		host := r.Request.URL.Host
+		a.log.Warn("crawler request failed", "endpoint", host, "attempt", AttemptsOf(r.Ctx), "status", r.StatusCode, "err", err)
+		if delay, ok := a.retryDelay(r, err); ok {
+			a.log.Debug("retrying crawler request", "endpoint", host, "attempt", AttemptsOf(r.Ctx), "delay", delay)
+			if sleepCtx(ContextOf(r.Request), delay) == nil {
+				r.Request.Retry()
+			}
 		}
 	})
 
-	if a.cfg.IsDebug {
-		fmt.Printf("[Info] Apply Anti-crawl Rules \n")
+	a.log.Debug("anti-crawl rules applied", "concurrency", a.cfg.CrawlerConcurrency, "qps", a.cfg.CrawlerQPS, "burst", a.cfg.CrawlerBurst)
+}
+
+// retryDelay 判断失败的请求是否需要重试, 需要时返回退避时长
+// 遵循与 API 请求相同的重试策略: 可重试状态码/错误类别、最大尝试次数、Retry-After 和重试总耗时上限
+// retryDelay decides whether a failed request is retried, returning the backoff delay if so
+// Follows the same retry policy as API requests: retryable status codes/error classes, max attempts, Retry-After and max elapsed time
+func (a *AntiCrawl) retryDelay(r *colly.Response, err error) (time.Duration, bool) {
+	if ContextOf(r.Request).Err() != nil {
+		return 0, false
+	}
+	retryable := (r.StatusCode != 0 && a.retry.RetryableStatusCode(r.StatusCode)) ||
+		(r.StatusCode == 0 && a.retry.RetryableError(err))
+	if !retryable {
+		return 0, false
+	}
+
+	attempt := AttemptsOf(r.Ctx) // 含本次失败的尝试 | Including the attempt that just failed
+	if attempt >= a.retry.Attempts(a.cfg.RetryTimes) {
+		return 0, false
+	}
+
+	var header http.Header
+	if r.Headers != nil {
+		header = *r.Headers
+	}
+	delay := a.retry.Delay(attempt, header)
+	if start, ok := r.Ctx.GetAny(retryStartKey).(time.Time); ok && a.retry.MaxElapsed > 0 &&
+		time.Since(start)+delay > a.retry.MaxElapsed {
+		return 0, false
+	}
+	return delay, true
+}
+
+// AttemptsOf 获取请求已发出的尝试次数 | Attempts sent so far for the request
+func AttemptsOf(ctx *colly.Context) int {
+	n, _ := ctx.GetAny(attemptsKey).(int)
+	return n
+}
+
+// RateLimitWaitOf 获取请求累计的限流等待 | Accumulated rate-limit wait of the request
+func RateLimitWaitOf(ctx *colly.Context) time.Duration {
+	d, _ := ctx.GetAny(rateLimitWaitKey).(time.Duration)
+	return d
+}
+
+// sleepCtx 可被 context 中断的休眠 | Sleep for d or until ctx is done
+func sleepCtx(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
 	}
 }
 
@@ -103,7 +189,7 @@ func (a *AntiCrawl) getRandomReferer() string {
 		{
 			weight: 40,
 			genFunc: func() string {
-				return "https://store.steampowered.com/" + a.randomQueryParams()
+				return a.cfg.Endpoints.StoreURL() + a.randomQueryParams()
 			},
 		},
 		// Google 搜索
@@ -131,7 +217,7 @@ func (a *AntiCrawl) getRandomReferer() string {
 					"groups/" + a.randomString(8) + "/",
 					"id/" + a.randomString(12) + "/",
 				}
-				return "https://steamcommunity.com/" + communityPaths[a.random.Intn(len(communityPaths))] + a.randomQueryParams()
+				return a.cfg.Endpoints.CommunityURL(communityPaths[a.random.Intn(len(communityPaths))]) + a.randomQueryParams()
 			},
 		},
 		// Steam 分类页
@@ -147,7 +233,7 @@ func (a *AntiCrawl) getRandomReferer() string {
 					"tags/" + a.randomString(6),
 					"sale/random",
 				}
-				return "https://store.steampowered.com/" + categories[a.random.Intn(len(categories))] + "/" + a.randomQueryParams()
+				return a.cfg.Endpoints.StoreURL(categories[a.random.Intn(len(categories))], "/") + a.randomQueryParams()
 			},
 		},
 		// 空 Referer
@@ -162,11 +248,11 @@ func (a *AntiCrawl) getRandomReferer() string {
 			weight: 5,
 			genFunc: func() string {
 				subDomains := []string{
-					"help.steampowered.com/",
-					"steamcommunity.com/market/",
-					"store.steampowered.com/cart/",
+					"https://help.steampowered.com/",
+					a.cfg.Endpoints.CommunityURL("market/"),
+					a.cfg.Endpoints.StoreURL("cart/"),
 				}
-				return "https://" + subDomains[a.random.Intn(len(subDomains))] + a.randomQueryParams()
+				return subDomains[a.random.Intn(len(subDomains))] + a.randomQueryParams()
 			},
 		},
 	}
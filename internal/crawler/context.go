@@ -0,0 +1,87 @@
+// Package crawler 实现 Steam 爬虫核心能力
+// 包含反爬策略、代理轮换、HTML 解析和存储等功能
+// Package crawler implements core capabilities of Steam crawler
+// Includes anti-crawl strategy, proxy rotation, HTML parsing and storage
+
+package crawler
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+
+	"github.com/gocolly/colly"
+)
+
+const (
+	contextKey    = "gf_context"      // colly.Context 中保存调用方 ctx 的键 | Key of caller ctx inside colly.Context
+	contextHeader = "X-Gf-Context-Id" // 关联 HTTP 请求与调用方 ctx 的内部请求头 | Internal header linking HTTP request to caller ctx
+)
+
+// ContextTransport 将调用方 context 传递到 Colly 发出的 HTTP 请求
+// Colly 构建的 http.Request 不携带 context, 通过内部请求头关联到已绑定的 ctx, 发送前移除该请求头
+// ContextTransport carries caller contexts into HTTP requests issued by Colly
+// Colly builds http.Request without a context, so requests are linked to a bound ctx via an internal header that is stripped before sending
+type ContextTransport struct {
+	base http.RoundTripper // 底层 Transport | Underlying transport
+	ctxs sync.Map          // 请求ID -> ctx | Request ID -> ctx
+	seq  atomic.Uint64     // 请求ID序列 | Request ID sequence
+}
+
+// NewContextTransport 创建 ContextTransport 实例
+// 参数:
+//   - base: 底层 Transport(nil 则使用 http.DefaultTransport) | Underlying transport (http.DefaultTransport if nil)
+func NewContextTransport(base http.RoundTripper) *ContextTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &ContextTransport{base: base}
+}
+
+// Bind 绑定调用方 ctx, 返回用于 Colly 请求的 Context/请求头以及释放函数
+// Bind binds the caller ctx and returns the colly Context/headers to use for the request plus a release func
+func (t *ContextTransport) Bind(ctx context.Context, userAgent string) (*colly.Context, http.Header, func()) {
+	id := strconv.FormatUint(t.seq.Add(1), 10)
+	t.ctxs.Store(id, ctx)
+
+	collyCtx := colly.NewContext()
+	collyCtx.Put(contextKey, ctx)
+
+	hdr := http.Header{}
+	hdr.Set("User-Agent", userAgent)
+	hdr.Set(contextHeader, id)
+
+	return collyCtx, hdr, func() { t.ctxs.Delete(id) }
+}
+
+// RoundTrip 实现 http.RoundTripper, 按内部请求头恢复调用方 ctx
+// RoundTrip implements http.RoundTripper, restoring the caller ctx from the internal header
+func (t *ContextTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	id := req.Header.Get(contextHeader)
+	if id == "" {
+		return t.base.RoundTrip(req)
+	}
+
+	ctx := req.Context()
+	if v, ok := t.ctxs.Load(id); ok {
+		ctx = v.(context.Context)
+	}
+	// 克隆请求, 不修改调用方持有的请求头(重试时仍需使用)
+	// Clone the request so headers owned by the caller stay intact for retries
+	out := req.Clone(ctx)
+	out.Header.Del(contextHeader)
+	return t.base.RoundTrip(out)
+}
+
+// ContextOf 获取 Colly 请求绑定的调用方 ctx, 未绑定时返回 context.Background()
+// ContextOf returns the caller ctx bound to a Colly request, or context.Background() if none
+func ContextOf(r *colly.Request) context.Context {
+	if r != nil && r.Ctx != nil {
+		if ctx, ok := r.Ctx.GetAny(contextKey).(context.Context); ok {
+			return ctx
+		}
+	}
+	return context.Background()
+}
@@ -6,7 +6,6 @@
 package crawler
 
 import (
-	"fmt"
 	"math/rand"
 	"net/http"
 	"net/url"
@@ -45,9 +44,7 @@ func NewProxyRotator(cfg *config.SteamConfig) *ProxyRotator {
 		pool = []string{cfg.ProxyURL}
 	}
 
-	if cfg.IsDebug {
-		fmt.Printf("[Info] Init NewProxyRotator \n")
-	}
+	cfg.Log().Debug("proxy rotator initialized", "proxies", len(pool), "strategy", cfg.ProxyStrategy)
 
 	return &ProxyRotator{
 		cfg:        cfg,
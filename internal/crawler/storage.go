@@ -6,7 +6,7 @@
 package crawler
 
 import (
-	"fmt"
+	"log/slog"
 	"os"
 	"path/filepath"
 	"time"
@@ -25,14 +25,15 @@ type Storage struct {
 // NewStorage 创建存储管理器实例
 // 参数:
 //   - baseDir: 基础存储目录 | Base storage directory
+//   - logger: 日志(nil 则不输出) | Logger (silent if nil)
 //
 // 返回值:
 //   - *Storage: 存储管理器实例 | Storage manager instance
-func NewStorage(baseDir string) *Storage {
+func NewStorage(baseDir string, logger *slog.Logger) *Storage {
 	// 确保基础目录存在
 	// Ensure base directory exists (create if not)
-	if err := os.MkdirAll(baseDir, 0755); err != nil {
-		fmt.Print("[Storage] 创建基础目录失败", err)
+	if err := os.MkdirAll(baseDir, 0755); err != nil && logger != nil {
+		logger.Error("create storage base dir failed", "dir", baseDir, "err", err)
 	}
 	return &Storage{
 		baseDir: baseDir,
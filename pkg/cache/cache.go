@@ -0,0 +1,64 @@
+// Package cache 定义 Steam API 响应缓存的公开类型
+// 包含缓存条目、可自定义的缓存存储接口(通过 config.CacheConfig.Store 注入)及命中率统计
+// Package cache defines the public types of the Steam API response cache
+// Includes the cache entry, the pluggable cache store interface (injected via config.CacheConfig.Store) and hit-ratio stats
+
+package cache
+
+import "time"
+
+// Entry 缓存条目
+// 保存响应体及 ETag/Last-Modified 校验信息, 过期后仍可用于条件请求
+// Entry is a cached response
+// Holds the body plus ETag/Last-Modified validators, which stay usable for conditional requests after expiry
+type Entry struct {
+	Body         []byte    `json:"body"`                    // 响应体 | Response body
+	ETag         string    `json:"etag,omitempty"`          // ETag 校验值 | ETag validator
+	LastModified string    `json:"last_modified,omitempty"` // Last-Modified 校验值 | Last-Modified validator
+	ExpiresAt    time.Time `json:"expires_at"`              // 过期时间 | Expiry time
+}
+
+// Fresh 条目在指定时间是否仍有效
+// Fresh reports whether the entry is still valid at now
+func (e *Entry) Fresh(now time.Time) bool {
+	return now.Before(e.ExpiresAt)
+}
+
+// HasValidators 条目是否携带条件请求校验信息
+// HasValidators reports whether the entry carries validators for conditional requests
+func (e *Entry) HasValidators() bool {
+	return e.ETag != "" || e.LastModified != ""
+}
+
+// Cache 缓存存储接口, 实现需保证并发安全; 写入后的条目视为只读, 调用方不得修改 Get 返回的条目
+// 实现可额外提供 Evictions() uint64, 其返回值计入 Stats.Evictions
+// Cache is the cache storage interface, implementations must be safe for concurrent use;
+// stored entries are read-only, callers must not modify an entry returned by Get
+// Implementations may also provide Evictions() uint64, which is reported as Stats.Evictions
+type Cache interface {
+	Get(key string) (*Entry, bool) // 获取条目(含已过期条目) | Get entry (expired entries included)
+	Set(key string, e *Entry)      // 写入条目 | Store entry
+	Delete(key string)             // 删除条目 | Delete entry
+	Len() int                      // 条目数量 | Number of entries
+}
+
+// Stats 缓存统计信息
+// Stats holds cache statistics
+type Stats struct {
+	Hits        uint64 `json:"hits"`        // 命中次数 | Fresh hits
+	Misses      uint64 `json:"misses"`      // 未命中次数(含过期) | Misses (expired included)
+	Revalidated uint64 `json:"revalidated"` // 条件请求返回 304 的次数 | Conditional requests answered with 304
+	Stores      uint64 `json:"stores"`      // 写入次数 | Stores
+	Evictions   uint64 `json:"evictions"`   // 淘汰次数 | Evictions
+	Entries     int    `json:"entries"`     // 当前条目数 | Current entries
+}
+
+// HitRatio 命中率(Hits / (Hits + Misses)), 无请求时返回 0
+// HitRatio returns Hits / (Hits + Misses), or 0 when there was no lookup
+func (s Stats) HitRatio() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(total)
+}
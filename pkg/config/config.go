@@ -6,6 +6,7 @@
 package config
 
 import (
+	"log/slog"
 	"net/http"
 	"net/url"
 	"os"
@@ -13,8 +14,12 @@ import (
 	"strings"
 	"time"
 
+	"github.com/GoFurry/gf-steam-sdk/pkg/cache"
+	"github.com/GoFurry/gf-steam-sdk/pkg/observe"
 	"github.com/GoFurry/gf-steam-sdk/pkg/util"
 	"github.com/GoFurry/gf-steam-sdk/pkg/util/errors"
+	steamLog "github.com/GoFurry/gf-steam-sdk/pkg/util/log"
+	"github.com/GoFurry/gf-steam-sdk/pkg/vcr"
 )
 
 // SteamConfig Steam 客户端核心配置结构体
@@ -23,20 +28,32 @@ import (
 // Integrates API basic config, proxy config, rate limit and crawler-specific config, supports environment variable injection
 type SteamConfig struct {
 	// 基本配置 | Basic configuration
-	APIKey         string            `json:"api_key" env:"STEAM_API_KEY"`                   // Steam API 密钥
-	AccessToken    string            `json:"access_token" env:"STEAM_ACCESS_TOKEN"`         // Steam Access Token
-	ProxyURL       string            `json:"proxy_url" env:"STEAM_PROXY_URL"`               // 代理地址(中国区必填)
-	ProxyUser      string            `json:"proxy_user" env:"STEAM_PROXY_USER"`             // 代理用户名
-	ProxyPass      string            `json:"proxy_pass" env:"STEAM_PROXY_PASS"`             // 代理密码
-	ProxyPool      []string          `json:"proxy_pool" env:"STEAM_PROXY_POOL"`             // 代理IP池
-	ProxyStrategy  string            `json:"proxy_strategy" env:"STEAM_PROXY_STRATEGY"`     // 代理选择策略
-	Timeout        time.Duration     `json:"timeout" env:"STEAM_TIMEOUT"`                   // 请求超时时间(秒)
-	RetryTimes     int               `json:"retry_times" env:"STEAM_RETRY_TIMES"`           // 重试次数
-	RateLimitQPS   float64           `json:"rate_limit_qps" env:"STEAM_RATE_LIMIT_QPS"`     // 限速QPS
-	RateLimitBurst int               `json:"rate_limit_burst" env:"STEAM_RATE_LIMIT_BURST"` // 突发QPS上限
-	Headers        map[string]string `json:"headers"`                                       // 请求头
-	IsDebug        bool              `json:"is_debug"`                                      // 调试模式
-	Transport      *http.Transport   `json:"-"`                                             // 构建的 Transport | Built Transport
+	APIKey           string                `json:"api_key" env:"STEAM_API_KEY"`                   // Steam API 密钥
+	AccessToken      string                `json:"access_token" env:"STEAM_ACCESS_TOKEN"`         // Steam Access Token
+	APIKeyPool       []string              `json:"api_key_pool" env:"STEAM_API_KEY_POOL"`         // API Key 池(优先于 APIKey) | API key pool (takes precedence over APIKey)
+	APIKeyStrategy   string                `json:"api_key_strategy" env:"STEAM_API_KEY_STRATEGY"` // Key 选择策略 | Key selection strategy
+	APIKeyDailyLimit int                   `json:"api_key_daily_limit"`                           // 单 Key 每日上限(<=0 不限) | Daily limit per key (<=0 unlimited)
+	APIKeyQuarantine time.Duration         `json:"api_key_quarantine"`                            // Key 被拒绝(429/Key 无效的 403)后的隔离时长 | Quarantine after a key is rejected (429/bad-key 403)
+	ProxyURL         string                `json:"proxy_url" env:"STEAM_PROXY_URL"`               // 代理地址(中国区必填)
+	ProxyUser        string                `json:"proxy_user" env:"STEAM_PROXY_USER"`             // 代理用户名
+	ProxyPass        string                `json:"proxy_pass" env:"STEAM_PROXY_PASS"`             // 代理密码
+	ProxyPool        []string              `json:"proxy_pool" env:"STEAM_PROXY_POOL"`             // 代理IP池
+	ProxyStrategy    string                `json:"proxy_strategy" env:"STEAM_PROXY_STRATEGY"`     // 代理选择策略
+	Timeout          time.Duration         `json:"timeout" env:"STEAM_TIMEOUT"`                   // 请求超时时间(秒)
+	RetryTimes       int                   `json:"retry_times" env:"STEAM_RETRY_TIMES"`           // 重试次数
+	RetryPolicy      *RetryPolicy          `json:"retry_policy"`                                  // 重试策略 | Retry policy
+	CircuitBreaker   *CircuitBreakerConfig `json:"circuit_breaker"`                               // 按主机熔断(nil 不启用) | Per-host circuit breaker (disabled if nil)
+	RateLimitQPS     float64               `json:"rate_limit_qps" env:"STEAM_RATE_LIMIT_QPS"`     // 限速QPS
+	RateLimitBurst   int                   `json:"rate_limit_burst" env:"STEAM_RATE_LIMIT_BURST"` // 突发QPS上限
+	Headers          map[string]string     `json:"headers"`                                       // 请求头
+	Endpoints        Endpoints             `json:"endpoints"`                                     // 各服务基础地址 | Base URL of every Steam service
+	IsDebug          bool                  `json:"is_debug"`                                      // 调试模式
+	Logger           *slog.Logger          `json:"-"`                                             // 结构化日志(nil 时调试模式输出到标准输出) | Structured logger (stdout in debug mode if nil)
+	Transport        *http.Transport       `json:"-"`                                             // 构建的 Transport | Built Transport
+	Middlewares      []Middleware          `json:"-"`                                             // RoundTripper 中间件链 | RoundTripper middleware chain
+	Observer         observe.Observer      `json:"-"`                                             // 请求观察者(指标/追踪, nil 不启用) | Request observer (metrics/tracing, disabled if nil)
+	Cache            *CacheConfig          `json:"cache"`                                         // 响应缓存(nil 不启用) | Response cache (disabled if nil)
+	VCR              *VCRConfig            `json:"vcr"`                                           // 录制/回放(nil 不启用) | Record/replay (disabled if nil)
 
 	// 爬虫配置 | Crawler configuration
 	CrawlerUserAgent   string        `json:"crawler_user_agent" env:"STEAM_CRAWLER_UA"`           // 爬虫user-agent
@@ -50,6 +67,107 @@ type SteamConfig struct {
 	CrawlerStorageDir  string        `json:"crawler_storage_dir" env:"STEAM_CRAWLER_STORAGE_DIR"` // HTML存储基础目录
 }
 
+// CacheConfig 响应缓存配置
+// 缓存按接口启用: 仅缓存 TTLs 中配置的 "Interface/Method"(如 "ICommunityService/GetApps")、"Interface"(如 "ISteamNews") 或 "A2S/Rules";
+// DefaultTTL 默认为 0, 设置为正数后未配置的 GET 接口(含按玩家/带凭证的接口)也会被缓存; TTL <=0 表示不缓存
+// CacheConfig configures the response cache
+// Caching is opt-in per endpoint: only the "Interface/Method" (e.g. "ICommunityService/GetApps"), "Interface" (e.g. "ISteamNews")
+// or "A2S/Rules" names in TTLs are cached; DefaultTTL is 0 by default, a positive value also caches every other GET endpoint
+// (per-player and authenticated ones included); a TTL <=0 disables caching
+type CacheConfig struct {
+	Backend    string                   `json:"backend"`     // 存储后端 memory/disk | Storage backend memory/disk
+	MaxEntries int                      `json:"max_entries"` // 内存缓存条目上限 | In-memory cache capacity
+	Dir        string                   `json:"dir"`         // 磁盘缓存目录 | Disk cache directory
+	DefaultTTL time.Duration            `json:"default_ttl"` // 未配置接口的 TTL(默认 0 不缓存) | TTL of unlisted endpoints (0 by default, not cached)
+	TTLs       map[string]time.Duration `json:"ttls"`        // 按接口/方法的 TTL | Per interface/method TTLs
+	Store      cache.Cache              `json:"-"`           // 自定义存储(非 nil 时忽略 Backend) | Custom store (Backend is ignored if set)
+}
+
+// NewDefaultCacheConfig 创建默认缓存配置(内存 LRU, 仅缓存 DefaultCacheTTLs 中的公开接口)
+// NewDefaultCacheConfig creates the default cache config (in-memory LRU caching only the public endpoints in DefaultCacheTTLs)
+//
+// 返回值:
+//   - *CacheConfig: 缓存配置实例 | Cache config instance
+func NewDefaultCacheConfig() *CacheConfig {
+	return &CacheConfig{
+		Backend:    util.CACHE_BACKEND_MEMORY,
+		MaxEntries: util.DEFAULT_CACHE_MAX_ENTRIES,
+		Dir:        util.DEFAULT_CACHE_DIR,
+		TTLs:       DefaultCacheTTLs(),
+	}
+}
+
+// DefaultCacheTTLs 默认缓存的接口及 TTL
+// 仅包含与玩家无关的公开数据(应用列表、新闻、成就定义、商店详情等), 按玩家或需要登录凭证的接口不在其中
+// DefaultCacheTTLs returns the endpoints cached by default and their TTLs
+// Only public, player-independent data is listed (app lists, news, achievement schemas, store details, ...);
+// per-player and credential-bound endpoints are left out
+//
+// 返回值:
+//   - map[string]time.Duration: 接口名 -> TTL(每次返回新的 map) | Endpoint -> TTL (a new map on every call)
+func DefaultCacheTTLs() map[string]time.Duration {
+	ttls := make(map[string]time.Duration, len(defaultCachedEndpoints))
+	for _, name := range defaultCachedEndpoints {
+		ttls[name] = util.DEFAULT_CACHE_TTL
+	}
+	return ttls
+}
+
+// defaultCachedEndpoints 默认缓存的公开接口 | Public endpoints cached by default
+var defaultCachedEndpoints = []string{
+	"ISteamApps/GetAppList",
+	"ISteamNews/GetNewsForApp",
+	"ISteamUserStats/GetSchemaForGame",
+	"ISteamUserStats/GetGlobalAchievementPercentagesForApp",
+	"ICommunityService/GetApps",
+	"IStoreBrowseService/GetItems",
+	"IStoreBrowseService/GetDLCForApps",
+	"api/appdetails",
+	"api/packagedetails",
+	"A2S/Rules",
+}
+
+// WithTTL 设置指定接口/方法的 TTL
+// 参数:
+//   - name: "Interface/Method"、"Interface" 或 "A2S/Rules" | "Interface/Method", "Interface" or "A2S/Rules"
+//   - ttl: 缓存时长(<=0 表示不缓存) | Cache duration (<=0 disables caching)
+//
+// 返回值:
+//   - *CacheConfig: 缓存配置实例(支持链式调用) | Cache config instance (chain call supported)
+func (c *CacheConfig) WithTTL(name string, ttl time.Duration) *CacheConfig {
+	if c.TTLs == nil {
+		c.TTLs = map[string]time.Duration{}
+	}
+	c.TTLs[name] = ttl
+	return c
+}
+
+// VCRConfig 录制/回放配置
+// 录制模式将 API 与爬虫的请求/响应对写入磁带目录(key/access_token/Cookie 已脱敏), 回放模式从磁带返回响应, 未录制的请求直接失败
+// VCRConfig configures record/replay
+// Record mode writes API and crawler request/response pairs into the cassette directory (key/access_token/cookies scrubbed),
+// replay mode answers from the cassette and fails unmatched requests
+type VCRConfig struct {
+	Mode      string         `json:"mode" env:"STEAM_VCR_MODE"` // 模式 off/record/replay | Mode off/record/replay
+	Dir       string         `json:"dir" env:"STEAM_VCR_DIR"`   // 磁带目录 | Cassette directory
+	Scrubbers []vcr.Scrubber `json:"-"`                         // 写入前的额外脱敏 | Extra scrubbing before writing
+}
+
+// Middleware HTTP RoundTripper 中间件
+// 接收下一层 RoundTripper 并返回包装后的 RoundTripper, 可用于鉴权注入、日志、指标、缓存、故障注入和请求签名等
+// Middleware wraps the next http.RoundTripper
+// Used for auth injection, logging, metrics, caching, fault injection, request signing, etc.
+type Middleware func(next http.RoundTripper) http.RoundTripper
+
+// RoundTripperFunc 将普通函数适配为 http.RoundTripper, 便于编写中间件
+// RoundTripperFunc adapts an ordinary function to http.RoundTripper, handy for writing middlewares
+type RoundTripperFunc func(*http.Request) (*http.Response, error)
+
+// RoundTrip 实现 http.RoundTripper
+func (f RoundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
 // NewDefaultConfig 创建默认配置实例
 // 优先从环境变量读取配置, 未配置则使用 util 包中定义的默认值
 // 返回值:
@@ -153,6 +271,32 @@ func NewDefaultConfig() *SteamConfig {
 		}
 	}
 
+	// 解析API Key池(环境变量逗号分隔)
+	// Parse API key pool (env separated by commas)
+	keyPool := []string{}
+	if envKeys := os.Getenv("STEAM_API_KEY_POOL"); envKeys != "" {
+		for _, k := range strings.Split(envKeys, ",") {
+			k = strings.TrimSpace(k)
+			if k != "" {
+				keyPool = append(keyPool, k)
+			}
+		}
+	}
+	keyStrategy := os.Getenv("STEAM_API_KEY_STRATEGY")
+	if !validKeyStrategy(keyStrategy) {
+		keyStrategy = util.DEFAULT_KEY_STRATEGY
+	}
+
+	// 解析录制/回放(未设置或 off 时不启用)
+	// Parse record/replay (disabled if unset or off)
+	var vcrConfig *VCRConfig
+	if envMode := os.Getenv("STEAM_VCR_MODE"); envMode != "" && envMode != util.VCR_MODE_OFF {
+		vcrConfig = &VCRConfig{Mode: envMode, Dir: util.DEFAULT_VCR_DIR}
+		if envDir := os.Getenv("STEAM_VCR_DIR"); envDir != "" {
+			vcrConfig.Dir = envDir
+		}
+	}
+
 	// 解析代理策略(默认轮询)
 	// Parse proxy strategy (default round-robin)
 	proxyStrategy := os.Getenv("STEAM_PROXY_STRATEGY")
@@ -164,18 +308,25 @@ func NewDefaultConfig() *SteamConfig {
 	// Build config instance
 	cfg := &SteamConfig{
 		// 基础配置 | Basic config
-		APIKey:         os.Getenv("STEAM_API_KEY"),
-		AccessToken:    os.Getenv("STEAM_ACCESS_TOKEN"),
-		ProxyURL:       os.Getenv("STEAM_PROXY_URL"),
-		ProxyUser:      proxyUser,
-		ProxyPass:      proxyPass,
-		ProxyPool:      proxyPool,
-		ProxyStrategy:  proxyStrategy,
-		Timeout:        time.Duration(timeoutSec) * time.Second,
-		RetryTimes:     retryTimes,
-		RateLimitQPS:   rateLimitQPS,
-		RateLimitBurst: rateLimitBurst,
-		IsDebug:        false,
+		APIKey:           os.Getenv("STEAM_API_KEY"),
+		AccessToken:      os.Getenv("STEAM_ACCESS_TOKEN"),
+		APIKeyPool:       keyPool,
+		APIKeyStrategy:   keyStrategy,
+		APIKeyDailyLimit: util.DEFAULT_KEY_DAILY_LIMIT,
+		APIKeyQuarantine: util.DEFAULT_KEY_QUARANTINE,
+		ProxyURL:         os.Getenv("STEAM_PROXY_URL"),
+		ProxyUser:        proxyUser,
+		ProxyPass:        proxyPass,
+		ProxyPool:        proxyPool,
+		ProxyStrategy:    proxyStrategy,
+		Timeout:          time.Duration(timeoutSec) * time.Second,
+		RetryTimes:       retryTimes,
+		RetryPolicy:      NewDefaultRetryPolicy(),
+		RateLimitQPS:     rateLimitQPS,
+		RateLimitBurst:   rateLimitBurst,
+		Endpoints:        NewDefaultEndpoints(),
+		VCR:              vcrConfig,
+		IsDebug:          false,
 
 		// 爬虫配置 | Crawler config
 		CrawlerUserAgent:   crawlerUA,
@@ -199,6 +350,7 @@ func NewDefaultConfig() *SteamConfig {
 // ============================ 基础链式配置 ============================
 
 // Debug 调试模式
+// 未设置 Logger 时, SDK 日志以 Debug 级别输出到标准输出(已脱敏)
 // 返回值:
 //   - *SteamConfig: 配置实例(支持链式调用) | Config instance (chain call supported)
 func (c *SteamConfig) Debug() *SteamConfig {
@@ -206,6 +358,18 @@ func (c *SteamConfig) Debug() *SteamConfig {
 	return c
 }
 
+// WithLogger 设置结构化日志
+// SDK 内部日志均通过该 Logger 输出(分级、结构化, 带 endpoint/attempt 等字段), key/access_token/代理密码自动脱敏
+// 参数:
+//   - logger: slog Logger(nil 则恢复默认: 调试模式输出到标准输出, 否则不输出) | slog logger (nil restores the default: stdout in debug mode, silent otherwise)
+//
+// 返回值:
+//   - *SteamConfig: 配置实例(支持链式调用) | Config instance (chain call supported)
+func (c *SteamConfig) WithLogger(logger *slog.Logger) *SteamConfig {
+	c.Logger = logger
+	return c
+}
+
 // WithAPIKey 自定义API Key
 // 参数:
 //   - apiKey: Steam API 密钥 | Steam API key
@@ -217,6 +381,47 @@ func (c *SteamConfig) WithAPIKey(apiKey string) *SteamConfig {
 	return c
 }
 
+// WithAPIKeyPool 设置 API Key 池, 请求时按策略轮换 Key
+// 每个 Key 独立使用 RateLimitQPS/RateLimitBurst 限流, 并按 Steam 日界(太平洋时间零点)统计每日配额
+// 被拒绝(429/Key 无效的 HTML 403)的 Key 会被自动隔离; 设置后优先于 APIKey
+// 参数:
+//   - keys: API Key 列表(自动清理空值和空格) | API keys (blank entries removed)
+//   - strategy: 选择策略 round_robin/random/least_used, 非法值默认 round_robin | Selection strategy, defaults to round_robin if invalid
+//
+// 返回值:
+//   - *SteamConfig: 配置实例 | Config instance
+func (c *SteamConfig) WithAPIKeyPool(keys []string, strategy string) *SteamConfig {
+	cleanKeys := []string{}
+	for _, k := range keys {
+		k = strings.TrimSpace(k)
+		if k != "" {
+			cleanKeys = append(cleanKeys, k)
+		}
+	}
+	c.APIKeyPool = cleanKeys
+	if validKeyStrategy(strategy) {
+		c.APIKeyStrategy = strategy
+	} else {
+		c.APIKeyStrategy = util.DEFAULT_KEY_STRATEGY
+	}
+	return c
+}
+
+// WithAPIKeyQuota 自定义 API Key 池的每日上限和隔离时长
+// 参数:
+//   - dailyLimit: 单 Key 每日上限(<=0 表示不限) | Daily limit per key (<=0 means unlimited)
+//   - quarantine: Key 被拒绝后的隔离时长(仅接受 >0 的值) | Quarantine after a key is rejected (only >0 accepted)
+//
+// 返回值:
+//   - *SteamConfig: 配置实例 | Config instance
+func (c *SteamConfig) WithAPIKeyQuota(dailyLimit int, quarantine time.Duration) *SteamConfig {
+	c.APIKeyDailyLimit = dailyLimit
+	if quarantine > 0 {
+		c.APIKeyQuarantine = quarantine
+	}
+	return c
+}
+
 // WithAccessToken 自定义 Access Token
 // 参数:
 //   - accessToken: Access Token
@@ -265,6 +470,42 @@ func (c *SteamConfig) WithRetryTimes(retryTimes int) *SteamConfig {
 	return c
 }
 
+// WithRetryPolicy 自定义重试策略
+// MaxAttempts 未设置时仍使用 RetryTimes+1 作为总尝试次数
+// 参数:
+//   - policy: 重试策略(nil 则使用默认重试策略) | Retry policy (default retry policy if nil)
+//
+// 返回值:
+//   - *SteamConfig: 配置实例 | Config instance
+func (c *SteamConfig) WithRetryPolicy(policy *RetryPolicy) *SteamConfig {
+	if policy == nil {
+		policy = NewDefaultRetryPolicy()
+	}
+	c.RetryPolicy = policy
+	return c
+}
+
+// WithCircuitBreaker 启用按主机熔断(默认不启用)
+// 参数:
+//   - failureThreshold: 连续失败阈值(<=0 表示关闭熔断) | Consecutive failure threshold (<=0 disables the breaker)
+//   - cooldown: 熔断冷却时长(<=0 使用 util.DEFAULT_BREAKER_COOLDOWN) | Open state duration (util.DEFAULT_BREAKER_COOLDOWN if <=0)
+//
+// 返回值:
+//   - *SteamConfig: 配置实例 | Config instance
+func (c *SteamConfig) WithCircuitBreaker(failureThreshold int, cooldown time.Duration) *SteamConfig {
+	if failureThreshold <= 0 {
+		c.CircuitBreaker = nil
+		return c
+	}
+	cb := NewDefaultCircuitBreakerConfig()
+	cb.FailureThreshold = failureThreshold
+	if cooldown > 0 {
+		cb.Cooldown = cooldown
+	}
+	c.CircuitBreaker = cb
+	return c
+}
+
 // WithHeaders 自定义请求头
 // 参数:
 //   - headers: 请求头键值对 | Request header key-value pairs
@@ -276,6 +517,18 @@ func (c *SteamConfig) WithHeaders(headers map[string]string) *SteamConfig {
 	return c
 }
 
+// WithEndpoints 自定义各服务基础地址(反向代理、区域镜像、本地模拟服务器等)
+// 仅覆盖非空字段, 作用于 API 请求、爬虫请求以及图标/封面/素材等地址拼接
+// 参数:
+//   - endpoints: 基础地址, 如 Endpoints{API: "https://steam-proxy.internal/api/"} | Base URLs
+//
+// 返回值:
+//   - *SteamConfig: 配置实例 | Config instance
+func (c *SteamConfig) WithEndpoints(endpoints Endpoints) *SteamConfig {
+	c.Endpoints = c.Endpoints.merge(endpoints)
+	return c
+}
+
 // WithProxyAuth 设置代理认证信息
 // 修改后自动重建 Transport
 // 参数:
@@ -338,6 +591,76 @@ func (c *SteamConfig) WithProxyStrategy(strategy string) *SteamConfig {
 	return c
 }
 
+// WithCache 启用响应缓存
+// 参数:
+//   - cache: 缓存配置(nil 则使用默认缓存配置) | Cache config (default cache config if nil)
+//
+// 返回值:
+//   - *SteamConfig: 配置实例 | Config instance
+func (c *SteamConfig) WithCache(cache *CacheConfig) *SteamConfig {
+	if cache == nil {
+		cache = NewDefaultCacheConfig()
+	}
+	c.Cache = cache
+	return c
+}
+
+// WithVCR 设置录制/回放, 同时作用于 API 请求和爬虫请求
+// 录制层位于中间件链内侧, 中间件注入的参数同样会被录制和匹配; 匹配时忽略 key/access_token 的取值
+// 参数:
+//   - mode: util.VCR_MODE_RECORD/util.VCR_MODE_REPLAY(空或 util.VCR_MODE_OFF 关闭) | Mode (empty or util.VCR_MODE_OFF disables)
+//   - dir: 磁带目录(为空使用 util.DEFAULT_VCR_DIR) | Cassette directory (util.DEFAULT_VCR_DIR if empty)
+//   - scrubbers: 写入前的额外脱敏 | Extra scrubbing before writing
+//
+// 返回值:
+//   - *SteamConfig: 配置实例 | Config instance
+func (c *SteamConfig) WithVCR(mode, dir string, scrubbers ...vcr.Scrubber) *SteamConfig {
+	if mode == "" || mode == util.VCR_MODE_OFF {
+		c.VCR = nil
+		return c
+	}
+	if dir == "" {
+		dir = util.DEFAULT_VCR_DIR
+	}
+	c.VCR = &VCRConfig{Mode: mode, Dir: dir, Scrubbers: scrubbers}
+	return c
+}
+
+// WithMiddleware 追加 RoundTripper 中间件
+// 按注册顺序执行: 先注册的位于最外层, 最先看到请求、最后看到响应
+// 同时作用于 API 请求和爬虫请求
+// 参数:
+//   - middlewares: 中间件列表(nil 将被忽略) | Middlewares (nil entries are ignored)
+//
+// 返回值:
+//   - *SteamConfig: 配置实例 | Config instance
+func (c *SteamConfig) WithMiddleware(middlewares ...Middleware) *SteamConfig {
+	for _, m := range middlewares {
+		if m != nil {
+			c.Middlewares = append(c.Middlewares, m)
+		}
+	}
+	return c
+}
+
+// WithObserver 设置请求观察者, 每次 API 请求、爬虫访问和 A2S 查询前后调用
+// 多次调用会组合为一个观察者(observe.Multi)
+// 参数:
+//   - observers: 观察者列表(如 promobserve.New、otelobserve.New) | Observers (e.g. promobserve.New, otelobserve.New)
+//
+// 返回值:
+//   - *SteamConfig: 配置实例 | Config instance
+func (c *SteamConfig) WithObserver(observers ...observe.Observer) *SteamConfig {
+	if c.Observer != nil {
+		observers = append([]observe.Observer{c.Observer}, observers...)
+	}
+	c.Observer = observe.Multi(observers...)
+	if _, ok := c.Observer.(observe.Nop); ok {
+		c.Observer = nil
+	}
+	return c
+}
+
 // ============================ 爬虫链式配置 ============================
 
 // WithCrawlerUA 自定义爬虫UA
@@ -447,13 +770,11 @@ func (c *SteamConfig) WithCrawlerStorageDir(dir string) *SteamConfig {
 // ============================ 工具方法 ============================
 
 // Validate 校验配置合法性
-// 检查API Key、超时时间、爬虫相关配置的合法性
+// 检查超时时间、爬虫相关配置的合法性
+// API Key 允许为空(可通过 option.WithAPIKey 按请求传入); 最终既无 Key 也无 Access Token 的 Web API 请求会以 ErrMissingAPIKey 失败
 // 返回值:
 //   - error: 配置非法时返回错误, 合法则返回nil | Error if config invalid, nil if valid
 func (c *SteamConfig) Validate() error {
-	if c.APIKey == "" {
-		return errors.ErrMissingAPIKey
-	}
 	if c.Timeout <= 0 {
 		return errors.New("timeout must be greater than 0")
 	}
@@ -472,9 +793,91 @@ func (c *SteamConfig) Validate() error {
 	if c.CrawlerBurst < 0 {
 		return errors.New("crawler burst must be >= 0")
 	}
+	if c.CircuitBreaker != nil && c.CircuitBreaker.Cooldown <= 0 {
+		return errors.New("circuit breaker cooldown must be greater than 0")
+	}
+	if err := c.Endpoints.validate(); err != nil {
+		return err
+	}
+	if c.VCR != nil && !vcr.ValidMode(c.VCR.Mode) {
+		return errors.New("vcr mode must be off, record or replay")
+	}
+	if c.vcrEnabled() {
+		// 磁带目录不可用时直接报错, 不会退回真实网络 | An unusable cassette dir is an error, never a fallback to the live network
+		if err := vcr.Check(c.VCR.Mode, c.VCR.Dir); err != nil {
+			return err
+		}
+	}
+	if c.Cache != nil && c.Cache.Store == nil {
+		switch c.Cache.Backend {
+		case "", util.CACHE_BACKEND_MEMORY, util.CACHE_BACKEND_DISK:
+		default:
+			return errors.New("cache backend must be memory or disk")
+		}
+	}
 	return nil
 }
 
+// WrapTransport 使用已注册的中间件包装 RoundTripper
+// 先注册的中间件位于最外层; 启用录制/回放时录制层位于最内侧, 回放时不再访问 base;
+// 录制/回放无法启用(如磁带目录不可读)时返回的 RoundTripper 令每个请求失败, 不会退回真实网络
+// 参数:
+//   - base: 底层 RoundTripper(nil 则使用 http.DefaultTransport) | Underlying RoundTripper (http.DefaultTransport if nil)
+//
+// 返回值:
+//   - http.RoundTripper: 包装后的 RoundTripper | Wrapped RoundTripper
+func (c *SteamConfig) WrapTransport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	rt := base
+	if c.vcrEnabled() {
+		recorder, err := vcr.New(c.VCR.Mode, c.VCR.Dir, base, c.VCR.Scrubbers...)
+		if err != nil {
+			rt = failingTransport{err: err}
+		} else {
+			rt = recorder
+		}
+	}
+	for i := len(c.Middlewares) - 1; i >= 0; i-- {
+		rt = c.Middlewares[i](rt)
+	}
+	return rt
+}
+
+// Log 获取 SDK 内部使用的 Logger(已包装脱敏)
+// 返回值:
+//   - *slog.Logger: 带脱敏的 Logger | Redacting logger
+func (c *SteamConfig) Log() *slog.Logger {
+	if c == nil {
+		return steamLog.NewSDKLogger(nil, false)
+	}
+	return steamLog.NewSDKLogger(c.Logger, c.IsDebug)
+}
+
+// vcrEnabled 是否启用录制/回放 | Whether record/replay is enabled
+func (c *SteamConfig) vcrEnabled() bool {
+	return c.VCR != nil && c.VCR.Mode != "" && c.VCR.Mode != util.VCR_MODE_OFF
+}
+
+// failingTransport 令每个请求以固定错误失败的 RoundTripper | RoundTripper failing every request with a fixed error
+type failingTransport struct {
+	err error
+}
+
+// RoundTrip 实现 http.RoundTripper
+func (t failingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		_ = req.Body.Close()
+	}
+	return nil, t.err
+}
+
+// validKeyStrategy 是否为支持的 API Key 选择策略 | Whether the API key strategy is supported
+func validKeyStrategy(strategy string) bool {
+	return strategy == "round_robin" || strategy == "random" || strategy == "least_used"
+}
+
 // buildTransport 构建 HTTP Transport
 // 根据代理配置自动构建带/不带代理的 Transport 实例
 func (c *SteamConfig) buildTransport() {
@@ -0,0 +1,157 @@
+package config
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/GoFurry/gf-steam-sdk/pkg/util"
+)
+
+// Endpoints Steam 各服务的基础地址
+// 可指向企业反向代理、区域镜像或本地模拟服务器; 字段为空时使用官方地址, 地址末尾自动补全 "/"
+// Endpoints holds the base URL of every Steam service
+// Point them at a corporate reverse proxy, a regional mirror or a local fake; empty fields use the official
+// address and a trailing "/" is added automatically
+type Endpoints struct {
+	API       string `json:"api"`        // Web API(api.steampowered.com) | Web API
+	Store     string `json:"store"`      // 商店(store.steampowered.com) | Store
+	Community string `json:"community"`  // 社区(steamcommunity.com) | Community
+	CDN       string `json:"cdn"`        // 游戏封面 CDN(cdn.akamai.steamstatic.com) | Game capsule CDN
+	Media     string `json:"media"`      // 游戏图标(media.steampowered.com) | Game icons
+	SharedCDN string `json:"shared_cdn"` // 社区道具素材(shared.fastly.steamstatic.com) | Community item assets
+	StoreCDN  string `json:"store_cdn"`  // 商店静态资源(store.fastly.steamstatic.com) | Store static assets
+}
+
+// NewDefaultEndpoints 创建官方地址配置
+// 返回值:
+//   - Endpoints: 官方基础地址 | Official base URLs
+func NewDefaultEndpoints() Endpoints {
+	return Endpoints{
+		API:       util.STEAM_API_BASE_URL,
+		Store:     util.STEAM_STORE_BASE_URL,
+		Community: util.STEAM_COMMUNITY_BASE_URL,
+		CDN:       util.STEAM_CDN_BASE_URL,
+		Media:     util.STEAM_MEDIA_BASE_URL,
+		SharedCDN: util.STEAM_SHARED_CDN_BASE_URL,
+		StoreCDN:  util.STEAM_STORE_CDN_BASE_URL,
+	}
+}
+
+// merge 用 o 中的非空字段覆盖 e, 并规范化末尾 "/"
+// merge overrides e with the non-empty fields of o and normalizes the trailing "/"
+func (e Endpoints) merge(o Endpoints) Endpoints {
+	pick := func(cur, next string) string {
+		if next = strings.TrimSpace(next); next == "" {
+			return cur
+		}
+		return strings.TrimRight(next, "/") + "/"
+	}
+	e.API = pick(e.API, o.API)
+	e.Store = pick(e.Store, o.Store)
+	e.Community = pick(e.Community, o.Community)
+	e.CDN = pick(e.CDN, o.CDN)
+	e.Media = pick(e.Media, o.Media)
+	e.SharedCDN = pick(e.SharedCDN, o.SharedCDN)
+	e.StoreCDN = pick(e.StoreCDN, o.StoreCDN)
+	return e
+}
+
+// resolved 未设置的字段使用官方地址 | Fill unset fields with the official addresses
+func (e Endpoints) resolved() Endpoints {
+	return NewDefaultEndpoints().merge(e)
+}
+
+// validate 校验已设置的地址为 http/https 绝对地址 | Check that every set URL is an absolute http/https URL
+func (e Endpoints) validate() error {
+	for name, v := range map[string]string{
+		"api": e.API, "store": e.Store, "community": e.Community, "cdn": e.CDN,
+		"media": e.Media, "shared_cdn": e.SharedCDN, "store_cdn": e.StoreCDN,
+	} {
+		if v == "" {
+			continue
+		}
+		u, err := url.Parse(v)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return errors.New("endpoint " + name + " must be an absolute http(s) url")
+		}
+	}
+	return nil
+}
+
+// Rebase 将官方地址替换为配置的基础地址, 其他地址原样返回
+// 参数:
+//   - rawURL: 完整地址(如 https://api.steampowered.com/IPlayerService/GetOwnedGames/v1/) | Full URL
+//
+// 返回值:
+//   - string: 替换后的地址 | Rebased URL
+func (e Endpoints) Rebase(rawURL string) string {
+	r := e.resolved()
+	pairs := [...]struct{ from, to string }{
+		{util.STEAM_API_BASE_URL, r.API},
+		{util.STEAM_STORE_BASE_URL, r.Store},
+		{util.STEAM_COMMUNITY_BASE_URL, r.Community},
+		{util.STEAM_CDN_BASE_URL, r.CDN},
+		{util.STEAM_MEDIA_BASE_URL, r.Media},
+		{util.STEAM_SHARED_CDN_BASE_URL, r.SharedCDN},
+		{util.STEAM_STORE_CDN_BASE_URL, r.StoreCDN},
+	}
+	for _, p := range pairs {
+		if strings.HasPrefix(rawURL, p.from) {
+			return p.to + strings.TrimPrefix(rawURL, p.from)
+		}
+	}
+	return rawURL
+}
+
+// APIURL 拼接 Web API 地址 | Join a Web API URL
+//   - path: 接口路径, 如 "IPlayerService/GetOwnedGames/v1/" | Endpoint path
+func (e Endpoints) APIURL(path string) string {
+	return e.resolved().API + strings.TrimPrefix(path, "/")
+}
+
+// StoreURL 拼接商店地址 | Join a store URL
+//   - parts: 路径片段, 按顺序拼接 | Path fragments joined in order
+func (e Endpoints) StoreURL(parts ...string) string {
+	return e.resolved().Store + strings.TrimPrefix(strings.Join(parts, ""), "/")
+}
+
+// CommunityURL 拼接社区地址 | Join a community URL
+//   - parts: 路径片段, 按顺序拼接 | Path fragments joined in order
+func (e Endpoints) CommunityURL(parts ...string) string {
+	return e.resolved().Community + strings.TrimPrefix(strings.Join(parts, ""), "/")
+}
+
+// IconURL 游戏图标地址 | Game icon URL
+//   - appID: 游戏ID | App ID
+//   - hash: 图标哈希 | Icon hash
+func (e Endpoints) IconURL(appID uint64, hash string) string {
+	return e.resolved().Media + "steamcommunity/public/images/apps/" + strconv.FormatUint(appID, 10) + "/" + hash + ".jpg"
+}
+
+// CapsuleURL 游戏封面地址 | Game capsule URL
+//   - appID: 游戏ID | App ID
+func (e Endpoints) CapsuleURL(appID uint64) string {
+	return e.resolved().CDN + "steam/apps/" + strconv.FormatUint(appID, 10) + "/header.jpg"
+}
+
+// StoreItemAssetURL 商店商品素材地址 | Store item asset URL
+//   - format: 素材路径模板, 如 "steam/apps/620/${FILENAME}?t=1" | Asset URL format
+//   - file: 素材文件名 | Asset file name
+func (e Endpoints) StoreItemAssetURL(format, file string) string {
+	return e.resolved().SharedCDN + "store_item_assets/" + strings.Replace(format, "${FILENAME}", file, 1)
+}
+
+// CommunityAssetURL 社区道具素材地址 | Community item asset URL
+//   - appID: 道具所属应用ID | App ID owning the item
+//   - file: 素材文件名 | Asset file name
+func (e Endpoints) CommunityAssetURL(appID int64, file string) string {
+	return e.resolved().SharedCDN + "community_assets/images/items/" + strconv.FormatInt(appID, 10) + "/" + file
+}
+
+// ReactionIconURL 互动类型图标地址 | Reaction icon URL
+//   - reactionID: 互动类型ID | Reaction ID
+func (e Endpoints) ReactionIconURL(reactionID int64) string {
+	return e.resolved().StoreCDN + "public/images/loyalty/reactions/still/" + strconv.FormatInt(reactionID, 10) + ".png"
+}
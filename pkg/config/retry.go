@@ -0,0 +1,173 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"slices"
+	"syscall"
+	"time"
+
+	"github.com/GoFurry/gf-steam-sdk/pkg/util"
+)
+
+// BackoffFunc 退避函数, 返回第 attempt 次重试(从 1 开始)前的等待时长
+// BackoffFunc returns the delay before the attempt-th retry (starting at 1)
+type BackoffFunc func(attempt int) time.Duration
+
+// RetryPolicy 重试策略
+// 同时作用于 API 请求和爬虫请求; 调用方 ctx 取消后不再重试
+// RetryPolicy controls retries of both API and crawler requests; nothing is retried once the caller ctx is done
+type RetryPolicy struct {
+	MaxAttempts       int           `json:"max_attempts"`        // 总尝试次数(含首次, <=0 时为 RetryTimes+1) | Total attempts including the first (RetryTimes+1 if <=0)
+	Backoff           BackoffFunc   `json:"-"`                   // 退避函数(nil 使用带抖动的指数退避) | Backoff function (jittered exponential if nil)
+	RetryableStatus   []int         `json:"retryable_status"`    // 可重试的状态码 | Retryable status codes
+	RetryableErrors   []string      `json:"retryable_errors"`    // 可重试的错误类别 timeout/network | Retryable error classes timeout/network
+	MaxElapsed        time.Duration `json:"max_elapsed"`         // 重试总耗时上限(<=0 不限) | Max total time spent retrying (<=0 unlimited)
+	RespectRetryAfter bool          `json:"respect_retry_after"` // 是否遵循 Retry-After 响应头 | Whether to honor the Retry-After header
+}
+
+// CircuitBreakerConfig 按主机熔断配置
+// 同一主机连续失败(5xx/超时/连接错误)达到阈值后熔断, 冷却期内直接返回 ErrCircuitOpen, 冷却结束后放行一个探测请求
+// CircuitBreakerConfig configures the per-host circuit breaker
+// After FailureThreshold consecutive failures (5xx/timeout/connection errors) on a host, requests fail fast with
+// ErrCircuitOpen until Cooldown elapses, then a single probe request is let through
+type CircuitBreakerConfig struct {
+	FailureThreshold int           `json:"failure_threshold"` // 连续失败阈值 | Consecutive failure threshold
+	Cooldown         time.Duration `json:"cooldown"`          // 熔断冷却时长 | Open state duration
+}
+
+// NewDefaultRetryPolicy 创建默认重试策略
+// 尝试次数沿用 RetryTimes, 对 429/5xx 和超时/连接错误重试, 带抖动的指数退避, 遵循 Retry-After
+// 返回值:
+//   - *RetryPolicy: 重试策略实例 | Retry policy instance
+func NewDefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		Backoff: ExponentialBackoff(util.RETRY_SLEEP_BASE*time.Millisecond, util.DEFAULT_RETRY_MAX_BACKOFF),
+		RetryableStatus: []int{
+			http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+		RetryableErrors:   []string{util.RETRY_ERROR_CLASS_TIMEOUT, util.RETRY_ERROR_CLASS_NETWORK},
+		MaxElapsed:        util.DEFAULT_RETRY_MAX_ELAPSED,
+		RespectRetryAfter: true,
+	}
+}
+
+// NewDefaultCircuitBreakerConfig 创建默认熔断配置
+// 返回值:
+//   - *CircuitBreakerConfig: 熔断配置实例 | Circuit breaker config instance
+func NewDefaultCircuitBreakerConfig() *CircuitBreakerConfig {
+	return &CircuitBreakerConfig{
+		FailureThreshold: util.DEFAULT_BREAKER_THRESHOLD,
+		Cooldown:         util.DEFAULT_BREAKER_COOLDOWN,
+	}
+}
+
+// ExponentialBackoff 带抖动的指数退避
+// 第 n 次重试的上限为 base*2^(n-1)(不超过 max), 实际等待在 [上限/2, 上限] 之间随机, 避免多个客户端同时重试
+// ExponentialBackoff is an exponential backoff with jitter
+// The n-th retry is capped at base*2^(n-1) (at most max), the actual delay is random in [cap/2, cap] so clients do not retry in lockstep
+// 参数:
+//   - base: 首次重试的退避上限 | Cap of the first retry
+//   - max: 单次退避上限 | Cap of any single delay
+//
+// 返回值:
+//   - BackoffFunc: 退避函数 | Backoff function
+func ExponentialBackoff(base, max time.Duration) BackoffFunc {
+	return func(attempt int) time.Duration {
+		if attempt < 1 {
+			attempt = 1
+		}
+		d := max
+		if shift := attempt - 1; shift < 32 && base<<shift > 0 && base<<shift < max {
+			d = base << shift
+		}
+		half := d / 2
+		if half <= 0 {
+			return d
+		}
+		return half + time.Duration(rand.Int63n(int64(half)+1))
+	}
+}
+
+// Attempts 获取总尝试次数
+// 参数:
+//   - retryTimes: 旧配置 RetryTimes(MaxAttempts 未设置时使用) | Legacy RetryTimes (used when MaxAttempts is unset)
+//
+// 返回值:
+//   - int: 总尝试次数(至少为 1) | Total attempts (at least 1)
+func (p *RetryPolicy) Attempts(retryTimes int) int {
+	if p != nil && p.MaxAttempts > 0 {
+		return p.MaxAttempts
+	}
+	if retryTimes < 0 {
+		retryTimes = 0
+	}
+	return retryTimes + 1
+}
+
+// RetryableStatusCode 状态码是否可重试 | Whether the status code is retryable
+func (p *RetryPolicy) RetryableStatusCode(code int) bool {
+	return p != nil && slices.Contains(p.RetryableStatus, code)
+}
+
+// RetryableError 传输层错误是否可重试(调用方取消的请求不重试)
+// RetryableError reports whether a transport error is retryable (requests canceled by the caller never are)
+func (p *RetryPolicy) RetryableError(err error) bool {
+	class := ErrorClass(err)
+	return p != nil && class != "" && slices.Contains(p.RetryableErrors, class)
+}
+
+// Delay 计算第 attempt 次重试前的等待时长
+// 遵循 Retry-After 时优先使用响应头, 否则使用退避函数
+// 参数:
+//   - attempt: 第几次重试(从 1 开始) | Retry number (starting at 1)
+//   - header: 上一次响应的响应头(可为 nil) | Headers of the previous response (may be nil)
+//
+// 返回值:
+//   - time.Duration: 等待时长 | Delay
+func (p *RetryPolicy) Delay(attempt int, header http.Header) time.Duration {
+	if p == nil {
+		return time.Duration(attempt*util.RETRY_SLEEP_BASE) * time.Millisecond
+	}
+	if p.RespectRetryAfter && header != nil {
+		if d, ok := util.ParseRetryAfter(header.Get("Retry-After"), time.Now()); ok {
+			return d
+		}
+	}
+	if p.Backoff == nil {
+		return ExponentialBackoff(util.RETRY_SLEEP_BASE*time.Millisecond, util.DEFAULT_RETRY_MAX_BACKOFF)(attempt)
+	}
+	return p.Backoff(attempt)
+}
+
+// ErrorClass 获取传输层错误的类别
+// 参数:
+//   - err: 传输层错误 | Transport error
+//
+// 返回值:
+//   - string: timeout/network, 无法归类或调用方取消时返回空 | timeout/network, empty if unclassified or canceled by the caller
+func ErrorClass(err error) string {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return ""
+	}
+	var netErr net.Error
+	if errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout()) {
+		return util.RETRY_ERROR_CLASS_TIMEOUT
+	}
+	var opErr *net.OpError
+	var dnsErr *net.DNSError
+	if errors.As(err, &opErr) || errors.As(err, &dnsErr) ||
+		errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) {
+		return util.RETRY_ERROR_CLASS_NETWORK
+	}
+	return ""
+}
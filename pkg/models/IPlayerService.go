@@ -41,3 +41,96 @@ type OwnedGame struct {
 	PlaytimeDeckForever    int    `json:"playtime_deck"`        // SteamDeck端时长
 	HasDLC                 bool   `json:"has_dlc"`              // 是否有DLC
 }
+
+// SteamRecentlyPlayedGamesResponse IPlayerService/GetRecentlyPlayedGames
+type SteamRecentlyPlayedGamesResponse struct {
+	Response struct {
+		TotalCount int `json:"total_count"` // 近2周游玩的游戏总数
+		Games      []struct {
+			AppID                  uint64 `json:"appid"`                    // 游戏唯一ID
+			Name                   string `json:"name"`                     // 游戏名称
+			Playtime2Weeks         int    `json:"playtime_2weeks"`          // 近2周游玩时长(分钟)
+			PlaytimeForever        int    `json:"playtime_forever"`         // 总游玩时长(分钟)
+			ImgIconURL             string `json:"img_icon_url"`             // 游戏图标哈希(小图标)
+			PlaytimeWindowsForever int    `json:"playtime_windows_forever"` // Windows端总时长
+			PlaytimeMacForever     int    `json:"playtime_mac_forever"`     // Mac端总时长
+			PlaytimeLinuxForever   int    `json:"playtime_linux_forever"`   // Linux端总时长
+			PlaytimeDeckForever    int    `json:"playtime_deck_forever"`    // SteamDeck端总时长
+		} `json:"games"`
+	} `json:"response"`
+}
+
+// RecentGame 玩家近期游玩游戏精简模型
+type RecentGame struct {
+	AppID           uint64 `json:"app_id"`           // 游戏ID
+	Name            string `json:"name"`             // 游戏名称
+	Playtime2Weeks  int    `json:"playtime_2weeks"`  // 近2周游玩时长(分钟)
+	PlaytimeForever int    `json:"playtime_forever"` // 总游玩时长(分钟)
+	IconURL         string `json:"icon_url"`         // 游戏图标完整URL
+	CapsuleURL      string `json:"capsule_url"`      // 游戏封面完整URL
+}
+
+// SteamLevelResponse IPlayerService/GetSteamLevel
+type SteamLevelResponse struct {
+	Response struct {
+		PlayerLevel int `json:"player_level"` // Steam 等级
+	} `json:"response"`
+}
+
+// SteamBadgesResponse IPlayerService/GetBadges
+type SteamBadgesResponse struct {
+	Response struct {
+		Badges []struct {
+			BadgeID         int    `json:"badgeid"`         // 徽章ID(游戏徽章为 1)
+			AppID           uint64 `json:"appid"`           // 游戏徽章所属游戏ID
+			Level           int    `json:"level"`           // 徽章等级
+			CompletionTime  int64  `json:"completion_time"` // 获得时间戳
+			XP              int    `json:"xp"`              // 徽章经验值
+			Scarcity        int    `json:"scarcity"`        // 获得该徽章的玩家数
+			CommunityItemID string `json:"communityitemid"` // 社区物品ID
+			BorderColor     int    `json:"border_color"`    // 边框颜色: 1=闪亮徽章
+		} `json:"badges"`
+		PlayerXP                   int `json:"player_xp"`                      // 玩家总经验值
+		PlayerLevel                int `json:"player_level"`                   // Steam 等级
+		PlayerXPNeededToLevelUp    int `json:"player_xp_needed_to_level_up"`   // 升级所需经验值
+		PlayerXPNeededCurrentLevel int `json:"player_xp_needed_current_level"` // 当前等级起点经验值
+	} `json:"response"`
+}
+
+// PlayerBadges 玩家徽章与等级精简模型
+type PlayerBadges struct {
+	Level                int     `json:"level"`                   // Steam 等级
+	XP                   int     `json:"xp"`                      // 总经验值
+	XPNeededToLevelUp    int     `json:"xp_needed_to_level_up"`   // 升级所需经验值
+	XPNeededCurrentLevel int     `json:"xp_needed_current_level"` // 当前等级起点经验值
+	Badges               []Badge `json:"badges"`                  // 徽章列表
+}
+
+// Badge 徽章信息
+type Badge struct {
+	BadgeID           int    `json:"badge_id"`            // 徽章ID(游戏徽章为 1)
+	AppID             uint64 `json:"app_id"`              // 游戏徽章所属游戏ID(社区徽章为 0)
+	Level             int    `json:"level"`               // 徽章等级
+	XP                int    `json:"xp"`                  // 徽章经验值
+	Scarcity          int    `json:"scarcity"`            // 获得该徽章的玩家数
+	Foil              bool   `json:"foil"`                // 是否闪亮徽章
+	CompletionTime    int64  `json:"completion_time"`     // 获得时间戳
+	CompletionTimeStr string `json:"completion_time_str"` // 获得时间格式化字符串
+	CommunityItemID   string `json:"community_item_id"`   // 社区物品ID
+}
+
+// SteamCommunityBadgeProgressResponse IPlayerService/GetCommunityBadgeProgress
+type SteamCommunityBadgeProgressResponse struct {
+	Response struct {
+		Quests []struct {
+			QuestID   int  `json:"questid"`   // 任务ID
+			Completed bool `json:"completed"` // 是否完成
+		} `json:"quests"`
+	} `json:"response"`
+}
+
+// BadgeQuest 社区徽章任务进度
+type BadgeQuest struct {
+	QuestID   int  `json:"quest_id"`  // 任务ID
+	Completed bool `json:"completed"` // 是否完成
+}
@@ -0,0 +1,60 @@
+package models
+
+// AppListItem ISteamApps/GetAppList 中的单个应用(流式解析, 原始与精简模型相同)
+type AppListItem struct {
+	AppID uint64 `json:"appid"` // 应用ID
+	Name  string `json:"name"`  // 应用名称
+}
+
+// SteamUpToDateCheckResponse ISteamApps/UpToDateCheck
+type SteamUpToDateCheckResponse struct {
+	Response struct {
+		Success           bool   `json:"success"`             // 请求是否成功
+		UpToDate          bool   `json:"up_to_date"`          // 是否为最新版本
+		VersionIsListable bool   `json:"version_is_listable"` // 该版本是否仍可出现在服务器列表
+		RequiredVersion   int    `json:"required_version"`    // 要求的最低版本
+		Message           string `json:"message"`             // 提示信息
+		Error             string `json:"error"`               // 错误信息(success=false 时)
+	} `json:"response"`
+}
+
+// UpToDateCheck 服务器版本检查精简模型
+type UpToDateCheck struct {
+	UpToDate          bool   `json:"up_to_date"`          // 是否为最新版本
+	VersionIsListable bool   `json:"version_is_listable"` // 该版本是否仍可出现在服务器列表
+	RequiredVersion   int    `json:"required_version"`    // 要求的最低版本
+	Message           string `json:"message"`             // 提示信息
+}
+
+// SteamServersAtAddressResponse ISteamApps/GetServersAtAddress
+type SteamServersAtAddressResponse struct {
+	Response struct {
+		Success bool   `json:"success"` // 请求是否成功
+		Message string `json:"message"` // 错误信息(success=false 时)
+		Servers []struct {
+			Addr     string `json:"addr"`     // 查询地址(ip:查询端口)
+			GMSIndex int    `json:"gmsindex"` // 主服务器索引
+			SteamID  string `json:"steamid"`  // 服务器SteamID
+			AppID    uint64 `json:"appid"`    // 游戏ID
+			GameDir  string `json:"gamedir"`  // 游戏目录(如 csgo)
+			Region   int    `json:"region"`   // 地区码
+			Secure   bool   `json:"secure"`   // 是否启用VAC
+			LAN      bool   `json:"lan"`      // 是否局域网服务器
+			GamePort int    `json:"gameport"` // 游戏端口
+			SpecPort int    `json:"specport"` // 观战端口
+		} `json:"servers"`
+	} `json:"response"`
+}
+
+// ServerAtAddress 指定 IP 上的游戏服务器精简模型
+type ServerAtAddress struct {
+	Addr     string `json:"addr"`      // 查询地址(ip:查询端口), 可直接用于 ServerService.GetServerDetailList
+	SteamID  string `json:"steam_id"`  // 服务器SteamID
+	AppID    uint64 `json:"app_id"`    // 游戏ID
+	GameDir  string `json:"game_dir"`  // 游戏目录(如 csgo)
+	Region   int    `json:"region"`    // 地区码
+	Secure   bool   `json:"secure"`    // 是否启用VAC
+	LAN      bool   `json:"lan"`       // 是否局域网服务器
+	GamePort int    `json:"game_port"` // 游戏端口
+	SpecPort int    `json:"spec_port"` // 观战端口
+}
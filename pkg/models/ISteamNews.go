@@ -0,0 +1,40 @@
+package models
+
+// SteamNewsResponse ISteamNews/GetNewsForApp
+type SteamNewsResponse struct {
+	AppNews struct {
+		AppID     uint64 `json:"appid"` // 游戏ID
+		NewsItems []struct {
+			GID           string   `json:"gid"`             // 新闻唯一ID
+			Title         string   `json:"title"`           // 标题
+			URL           string   `json:"url"`             // 新闻地址
+			IsExternalURL bool     `json:"is_external_url"` // 是否外部地址
+			Author        string   `json:"author"`          // 作者
+			Contents      string   `json:"contents"`        // 正文(社区公告为 BBCode, 外部源为 HTML)
+			FeedLabel     string   `json:"feedlabel"`       // 来源名称
+			Date          int64    `json:"date"`            // 发布时间戳
+			FeedName      string   `json:"feedname"`        // 来源标识
+			FeedType      int      `json:"feed_type"`       // 来源类型: 1=社区公告(BBCode), 0=外部源(HTML)
+			AppID         uint64   `json:"appid"`           // 游戏ID
+			Tags          []string `json:"tags"`            // 标签(如 patchnotes)
+		} `json:"newsitems"`
+		Count int `json:"count"` // 新闻总数
+	} `json:"appnews"`
+}
+
+// NewsItem 游戏新闻精简模型
+type NewsItem struct {
+	GID           string   `json:"gid"`             // 新闻唯一ID
+	AppID         uint64   `json:"app_id"`          // 游戏ID
+	Title         string   `json:"title"`           // 标题
+	URL           string   `json:"url"`             // 新闻地址
+	IsExternalURL bool     `json:"is_external_url"` // 是否外部地址
+	Author        string   `json:"author"`          // 作者
+	FeedLabel     string   `json:"feed_label"`      // 来源名称
+	FeedName      string   `json:"feed_name"`       // 来源标识
+	Date          int64    `json:"date"`            // 发布时间戳
+	DateStr       string   `json:"date_str"`        // 发布时间格式化字符串
+	Tags          []string `json:"tags"`            // 标签
+	ContentsHTML  string   `json:"contents_html"`   // 渲染并过滤后的安全 HTML 正文
+	Excerpt       string   `json:"excerpt"`         // 纯文本摘要
+}
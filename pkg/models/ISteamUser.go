@@ -41,3 +41,72 @@ type Player struct {
 	RealName     string `json:"real_name"`     // 真实姓名
 	CountryCode  string `json:"country_code"`  // 国家码
 }
+
+// SteamFriendListResponse ISteamUser/GetFriendList
+type SteamFriendListResponse struct {
+	FriendsList struct {
+		Friends []struct {
+			SteamID      string `json:"steamid"`      // 好友 SteamID
+			Relationship string `json:"relationship"` // 关系类型(friend)
+			FriendSince  int64  `json:"friend_since"` // 成为好友的时间戳
+		} `json:"friends"`
+	} `json:"friendslist"`
+}
+
+// Friend 好友精简模型
+type Friend struct {
+	SteamID      string `json:"steam_id"`     // 好友 SteamID
+	Relationship string `json:"relationship"` // 关系类型
+	FriendSince  string `json:"friend_since"` // 成为好友的时间
+}
+
+// SteamPlayerBansResponse ISteamUser/GetPlayerBans
+type SteamPlayerBansResponse struct {
+	Players []struct {
+		SteamID          string `json:"SteamId"`          // Steam 账号唯一 ID
+		CommunityBanned  bool   `json:"CommunityBanned"`  // 是否被社区封禁
+		VACBanned        bool   `json:"VACBanned"`        // 是否有 VAC 封禁记录
+		NumberOfVACBans  int    `json:"NumberOfVACBans"`  // VAC 封禁次数
+		DaysSinceLastBan int    `json:"DaysSinceLastBan"` // 距最近一次封禁的天数
+		NumberOfGameBans int    `json:"NumberOfGameBans"` // 游戏封禁次数
+		EconomyBan       string `json:"EconomyBan"`       // 交易封禁状态: none/probation/banned
+	} `json:"players"`
+}
+
+// PlayerBan 封禁记录精简模型
+type PlayerBan struct {
+	SteamID          string `json:"steam_id"`            // Steam唯一ID
+	VACBanned        bool   `json:"vac_banned"`          // 是否有 VAC 封禁
+	GameBanned       bool   `json:"game_banned"`         // 是否有游戏封禁
+	CommunityBanned  bool   `json:"community_banned"`    // 是否被社区封禁
+	EconomyBanned    bool   `json:"economy_banned"`      // 是否被交易封禁(不含观察期)
+	EconomyBan       string `json:"economy_ban"`         // 交易封禁状态: none/probation/banned
+	NumberOfVACBans  int    `json:"number_of_vac_bans"`  // VAC 封禁次数
+	NumberOfGameBans int    `json:"number_of_game_bans"` // 游戏封禁次数
+	DaysSinceLastBan int    `json:"days_since_last_ban"` // 距最近一次封禁的天数(无封禁为 0)
+}
+
+// SteamVanityURLResponse ISteamUser/ResolveVanityURL
+type SteamVanityURLResponse struct {
+	Response struct {
+		SteamID string `json:"steamid"` // 解析出的 SteamID
+		Success int    `json:"success"` // 1=成功, 42=未匹配
+		Message string `json:"message"` // 失败原因
+	} `json:"response"`
+}
+
+// SteamUserGroupListResponse ISteamUser/GetUserGroupList
+type SteamUserGroupListResponse struct {
+	Response struct {
+		Success bool `json:"success"` // 是否成功(资料未公开时为 false)
+		Groups  []struct {
+			GID string `json:"gid"` // 群组账号 ID
+		} `json:"groups"`
+	} `json:"response"`
+}
+
+// UserGroup 用户所属群组精简模型
+type UserGroup struct {
+	GroupID string `json:"group_id"` // 群组账号 ID
+	SteamID string `json:"steam_id"` // 群组 64 位 SteamID
+}
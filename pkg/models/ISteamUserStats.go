@@ -1,5 +1,10 @@
 package models
 
+import (
+	"strconv"
+	"strings"
+)
+
 // SteamPlayerAchievementsResponse ISteamUserStats/GetPlayerAchievements
 type SteamPlayerAchievementsResponse struct {
 	PlayerStats struct {
@@ -28,3 +33,145 @@ type PlayerAchievement struct {
 	UnlockTimeStr   string `json:"unlock_time_str"`  // 解锁时间格式化字符串
 	Description     string `json:"description"`      // 成就描述
 }
+
+// SteamGameSchemaResponse ISteamUserStats/GetSchemaForGame
+type SteamGameSchemaResponse struct {
+	Game struct {
+		GameName           string `json:"gameName"`    // 游戏名称
+		GameVersion        string `json:"gameVersion"` // 统计数据版本
+		AvailableGameStats struct {
+			Achievements []struct {
+				Name         string `json:"name"`         // 成就唯一标识
+				DefaultValue int    `json:"defaultvalue"` // 默认值
+				DisplayName  string `json:"displayName"`  // 成就名称
+				Hidden       int    `json:"hidden"`       // 是否隐藏: 1=隐藏, 0=公开
+				Description  string `json:"description"`  // 成就描述(隐藏成就可能为空)
+				Icon         string `json:"icon"`         // 已解锁图标地址
+				IconGray     string `json:"icongray"`     // 未解锁图标地址
+			} `json:"achievements"`
+			Stats []struct {
+				Name         string  `json:"name"`         // 统计项唯一标识
+				DefaultValue float64 `json:"defaultvalue"` // 默认值
+				DisplayName  string  `json:"displayName"`  // 统计项名称
+			} `json:"stats"`
+		} `json:"availableGameStats"`
+	} `json:"game"`
+}
+
+// GameSchema 游戏成就与统计项定义精简模型
+type GameSchema struct {
+	AppID        uint64              `json:"app_id"`       // 游戏ID
+	GameName     string              `json:"game_name"`    // 游戏名称
+	GameVersion  string              `json:"game_version"` // 统计数据版本
+	Achievements []SchemaAchievement `json:"achievements"` // 成就定义
+	Stats        []SchemaStat        `json:"stats"`        // 统计项定义
+}
+
+// SchemaAchievement 成就定义
+type SchemaAchievement struct {
+	APIName     string `json:"api_name"`      // 成就唯一标识
+	DisplayName string `json:"display_name"`  // 成就名称
+	Description string `json:"description"`   // 成就描述
+	Hidden      bool   `json:"hidden"`        // 是否隐藏
+	IconURL     string `json:"icon_url"`      // 已解锁图标地址
+	IconGrayURL string `json:"icon_gray_url"` // 未解锁图标地址
+}
+
+// SchemaStat 统计项定义
+type SchemaStat struct {
+	Name         string  `json:"name"`          // 统计项唯一标识
+	DisplayName  string  `json:"display_name"`  // 统计项名称
+	DefaultValue float64 `json:"default_value"` // 默认值
+}
+
+// SteamUserStatsResponse ISteamUserStats/GetUserStatsForGame
+type SteamUserStatsResponse struct {
+	PlayerStats struct {
+		SteamID      string `json:"steamID"`  // 玩家SteamID
+		GameName     string `json:"gameName"` // 游戏名称
+		Achievements []struct {
+			Name     string `json:"name"`     // 成就唯一标识
+			Achieved int    `json:"achieved"` // 是否完成: 1=完成
+		} `json:"achievements"`
+		Stats []struct {
+			Name  string  `json:"name"`  // 统计项唯一标识
+			Value float64 `json:"value"` // 统计值
+		} `json:"stats"`
+	} `json:"playerstats"`
+}
+
+// UserGameStats 玩家单游戏统计精简模型
+type UserGameStats struct {
+	SteamID      string             `json:"steam_id"`     // 玩家SteamID
+	GameName     string             `json:"game_name"`    // 游戏名称
+	AppID        uint64             `json:"app_id"`       // 游戏ID
+	Stats        map[string]float64 `json:"stats"`        // 统计值(统计项唯一标识 -> 值)
+	Achievements []string           `json:"achievements"` // 已完成成就的唯一标识
+}
+
+// SteamGlobalAchievementPercentagesResponse ISteamUserStats/GetGlobalAchievementPercentagesForApp
+type SteamGlobalAchievementPercentagesResponse struct {
+	AchievementPercentages struct {
+		Achievements []struct {
+			Name    string    `json:"name"`    // 成就唯一标识
+			Percent FlexFloat `json:"percent"` // 全球完成率(%), Steam 可能以字符串返回
+		} `json:"achievements"`
+	} `json:"achievementpercentages"`
+}
+
+// GlobalAchievementPercent 成就全球完成率精简模型
+type GlobalAchievementPercent struct {
+	APIName string  `json:"api_name"` // 成就唯一标识
+	Percent float64 `json:"percent"`  // 全球完成率(%)
+}
+
+// SteamCurrentPlayersResponse ISteamUserStats/GetNumberOfCurrentPlayers
+type SteamCurrentPlayersResponse struct {
+	Response struct {
+		PlayerCount int `json:"player_count"` // 当前在线人数
+		Result      int `json:"result"`       // 结果码: 1=成功
+	} `json:"response"`
+}
+
+// AchievementReport 玩家单游戏成就报告(解锁状态 + 图标描述 + 全球稀有度)
+type AchievementReport struct {
+	SteamID        string                  `json:"steam_id"`        // 玩家SteamID
+	GameName       string                  `json:"game_name"`       // 游戏名称
+	AppID          uint64                  `json:"app_id"`          // 游戏ID
+	Total          int                     `json:"total"`           // 成就总数
+	Unlocked       int                     `json:"unlocked"`        // 已完成数
+	CompletionRate float64                 `json:"completion_rate"` // 完成度(%)
+	Achievements   []AchievementReportItem `json:"achievements"`    // 成就明细(按成就定义顺序)
+}
+
+// AchievementReportItem 成就报告明细
+type AchievementReportItem struct {
+	APIName       string  `json:"api_name"`        // 成就唯一标识
+	Name          string  `json:"name"`            // 成就名称
+	Description   string  `json:"description"`     // 成就描述
+	Hidden        bool    `json:"hidden"`          // 是否隐藏
+	Achieved      bool    `json:"achieved"`        // 是否完成
+	UnlockTime    int64   `json:"unlock_time"`     // 解锁时间戳
+	UnlockTimeStr string  `json:"unlock_time_str"` // 解锁时间格式化字符串
+	IconURL       string  `json:"icon_url"`        // 当前状态图标地址(完成为彩色, 否则为灰色)
+	IconGrayURL   string  `json:"icon_gray_url"`   // 未解锁图标地址
+	GlobalPercent float64 `json:"global_percent"`  // 全球完成率(%)
+}
+
+// FlexFloat 兼容数字与数字字符串的浮点数, 如 12.5 与 "12.5"
+type FlexFloat float64
+
+// UnmarshalJSON 解析数字或数字字符串
+func (f *FlexFloat) UnmarshalJSON(data []byte) error {
+	s := strings.Trim(string(data), `"`)
+	if s == "" || s == "null" {
+		*f = 0
+		return nil
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return err
+	}
+	*f = FlexFloat(v)
+	return nil
+}
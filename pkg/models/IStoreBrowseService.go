@@ -0,0 +1,163 @@
+package models
+
+// SteamStoreBrowseItemsResponse IStoreBrowseService/GetItems
+type SteamStoreBrowseItemsResponse struct {
+	Response struct {
+		StoreItems []SteamStoreBrowseItem `json:"store_items"` // 商店商品
+	} `json:"response"`
+}
+
+// SteamStoreBrowseItem 商店商品(StoreItem), 子结构是否返回由 data_request 决定
+type SteamStoreBrowseItem struct {
+	ItemType     int    `json:"item_type"`      // 商品类型(0 应用)
+	ID           uint64 `json:"id"`             // 商品ID
+	Success      int    `json:"success"`        // 1 表示成功
+	Visible      bool   `json:"visible"`        // 当前地区是否可见
+	Name         string `json:"name"`           // 名称
+	StoreURLPath string `json:"store_url_path"` // 商店页路径
+	AppID        uint64 `json:"appid"`          // 应用ID
+	Type         int    `json:"type"`           // 应用类型(0 游戏, 1 软件, 4 DLC ...)
+	IsFree       bool   `json:"is_free"`        // 是否免费
+	TagIDs       []int  `json:"tagids"`         // 全部标签ID
+	Tags         []struct {
+		TagID  int `json:"tagid"`  // 标签ID
+		Weight int `json:"weight"` // 权重
+	} `json:"tags"` // 按权重排序的标签(include_tag_count)
+	BasicInfo *SteamStoreBrowseBasicInfo `json:"basic_info"` // 基础信息(include_basic_info)
+	Release   *SteamStoreBrowseRelease   `json:"release"`    // 发行信息(include_release)
+	Platforms *SteamStoreBrowsePlatforms `json:"platforms"`  // 平台(include_platforms)
+	Reviews   *struct {
+		SummaryFiltered *SteamStoreBrowseReviewSummary `json:"summary_filtered"` // 过滤后的评测汇总
+	} `json:"reviews"` // 评测(include_reviews)
+	Assets  *SteamStoreBrowseAssets  `json:"assets"`  // 素材(include_assets)
+	Ratings []SteamStoreBrowseRating `json:"ratings"` // 年龄分级(include_ratings)
+}
+
+// SteamStoreBrowseBasicInfo 商店商品基础信息
+type SteamStoreBrowseBasicInfo struct {
+	ShortDescription string `json:"short_description"` // 简短描述
+	Publishers       []struct {
+		Name string `json:"name"` // 名称
+	} `json:"publishers"` // 发行商
+	Developers []struct {
+		Name string `json:"name"` // 名称
+	} `json:"developers"` // 开发商
+	Franchises []struct {
+		Name string `json:"name"` // 名称
+	} `json:"franchises"` // 系列
+	CapsuleHeadline string `json:"capsule_headline"` // 封面标语
+}
+
+// SteamStoreBrowseRelease 商店商品发行信息
+type SteamStoreBrowseRelease struct {
+	SteamReleaseDate    int64 `json:"steam_release_date"`    // Steam 发行时间(Unix时间戳)
+	OriginalReleaseDate int64 `json:"original_release_date"` // 原始发行时间(Unix时间戳)
+	IsComingSoon        bool  `json:"is_coming_soon"`        // 是否即将推出
+	IsEarlyAccess       bool  `json:"is_early_access"`       // 是否抢先体验
+}
+
+// SteamStoreBrowsePlatforms 商店商品平台信息
+type SteamStoreBrowsePlatforms struct {
+	Windows                 bool `json:"windows"`                    // Windows
+	Mac                     bool `json:"mac"`                        // macOS
+	SteamOSLinux            bool `json:"steamos_linux"`              // SteamOS/Linux
+	SteamDeckCompatCategory int  `json:"steam_deck_compat_category"` // Steam Deck 兼容性(0 未知, 1 不支持, 2 可游玩, 3 已验证)
+}
+
+// SteamStoreBrowseReviewSummary 商店商品评测汇总
+type SteamStoreBrowseReviewSummary struct {
+	ReviewCount      int    `json:"review_count"`       // 评测总数
+	PercentPositive  int    `json:"percent_positive"`   // 好评百分比
+	ReviewScore      int    `json:"review_score"`       // 评分等级(1-9)
+	ReviewScoreLabel string `json:"review_score_label"` // 评分描述(如 Very Positive)
+}
+
+// SteamStoreBrowseAssets 商店商品素材, 文件名需按 asset_url_format 拼接
+type SteamStoreBrowseAssets struct {
+	AssetURLFormat string `json:"asset_url_format"` // 素材路径模板(如 steam/apps/620/${FILENAME}?t=1)
+	MainCapsule    string `json:"main_capsule"`     // 主封面文件名
+	SmallCapsule   string `json:"small_capsule"`    // 小封面文件名
+	Header         string `json:"header"`           // 头图文件名
+	HeroCapsule    string `json:"hero_capsule"`     // 主视觉封面文件名
+	LibraryCapsule string `json:"library_capsule"`  // 库封面文件名
+	LibraryHero    string `json:"library_hero"`     // 库主视觉文件名
+	CommunityIcon  string `json:"community_icon"`   // 社区图标哈希
+}
+
+// SteamStoreBrowseRating 商店商品年龄分级
+type SteamStoreBrowseRating struct {
+	RatingType  string   `json:"rating_type"`  // 分级机构(如 esrb, pegi)
+	Rating      string   `json:"rating"`       // 分级(如 e10, 12)
+	Descriptors []string `json:"descriptors"`  // 内容描述
+	RequiredAge int      `json:"required_age"` // 最低年龄
+}
+
+// StoreBrowseApp 商店商品精简模型(多批次合并后每个应用一条)
+type StoreBrowseApp struct {
+	AppID            uint64              `json:"appid"`             // 应用ID
+	Name             string              `json:"name"`              // 名称
+	Type             int                 `json:"type"`              // 应用类型(0 游戏, 1 软件, 4 DLC ...)
+	Visible          bool                `json:"visible"`           // 当前地区是否可见
+	IsFree           bool                `json:"is_free"`           // 是否免费
+	ShortDescription string              `json:"short_description"` // 简短描述
+	Developers       []string            `json:"developers"`        // 开发商
+	Publishers       []string            `json:"publishers"`        // 发行商
+	Franchises       []string            `json:"franchises"`        // 系列
+	ReleaseDate      int64               `json:"release_date"`      // Steam 发行时间(Unix时间戳)
+	ReleaseDateStr   string              `json:"release_date_str"`  // Steam 发行时间格式化字符串
+	ComingSoon       bool                `json:"coming_soon"`       // 是否即将推出
+	EarlyAccess      bool                `json:"early_access"`      // 是否抢先体验
+	Platforms        []string            `json:"platforms"`         // 支持平台(windows/mac/linux)
+	SteamDeckCompat  int                 `json:"steam_deck_compat"` // Steam Deck 兼容性(0 未知, 1 不支持, 2 可游玩, 3 已验证)
+	TagIDs           []int               `json:"tagids"`            // 标签ID(按权重排序)
+	ReviewCount      int                 `json:"review_count"`      // 评测总数
+	PercentPositive  int                 `json:"percent_positive"`  // 好评百分比
+	ReviewScoreDesc  string              `json:"review_score_desc"` // 评分描述
+	Ratings          []StoreBrowseRating `json:"ratings"`           // 年龄分级
+	CapsuleURL       string              `json:"capsule_url"`       // 封面完整URL
+	MainCapsuleURL   string              `json:"main_capsule_url"`  // 主封面完整URL(include_assets)
+	Icon             string              `json:"icon"`              // 图标地址(include_assets)
+}
+
+// StoreBrowseRating 年龄分级精简模型
+type StoreBrowseRating struct {
+	Type        string `json:"type"`         // 分级机构
+	Rating      string `json:"rating"`       // 分级
+	RequiredAge int    `json:"required_age"` // 最低年龄
+}
+
+// SteamDLCForAppsResponse IStoreBrowseService/GetDLCForApps
+type SteamDLCForAppsResponse struct {
+	Response struct {
+		DLCData []SteamDLCData `json:"dlc_data"` // DLC 列表
+	} `json:"response"`
+}
+
+// SteamDLCData 单个 DLC, 金额单位为货币的 1/100
+type SteamDLCData struct {
+	AppID       uint64    `json:"appid"`        // DLC 应用ID
+	ParentAppID uint64    `json:"parentappid"`  // 所属应用ID
+	ReleaseDate int64     `json:"release_date"` // 发行时间(Unix时间戳)
+	ComingSoon  bool      `json:"coming_soon"`  // 是否即将推出
+	Price       FlexInt64 `json:"price"`        // 现价
+	Discount    int       `json:"discount"`     // 折扣百分比
+	Free        bool      `json:"free"`         // 是否免费
+}
+
+// AppDLC 应用的 DLC 精简模型(多批次合并后每个应用一条)
+type AppDLC struct {
+	AppID uint64    `json:"appid"` // 应用ID
+	DLC   []DLCItem `json:"dlc"`   // DLC 列表
+}
+
+// DLCItem DLC 精简模型
+type DLCItem struct {
+	AppID           uint64 `json:"appid"`            // DLC 应用ID
+	ReleaseDate     int64  `json:"release_date"`     // 发行时间(Unix时间戳)
+	ReleaseDateStr  string `json:"release_date_str"` // 发行时间格式化字符串
+	ComingSoon      bool   `json:"coming_soon"`      // 是否即将推出
+	Free            bool   `json:"free"`             // 是否免费
+	Price           int64  `json:"price"`            // 现价(1/100 货币单位)
+	DiscountPercent int    `json:"discount_percent"` // 折扣百分比
+	CapsuleURL      string `json:"capsule_url"`      // 封面完整URL
+}
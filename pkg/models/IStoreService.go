@@ -0,0 +1,25 @@
+package models
+
+// SteamStoreAppListResponse IStoreService/GetAppList
+type SteamStoreAppListResponse struct {
+	Response struct {
+		Apps            []StoreApp `json:"apps"`              // 当前页的应用
+		HaveMoreResults bool       `json:"have_more_results"` // 是否还有下一页
+		LastAppID       uint64     `json:"last_appid"`        // 当前页最后一个应用ID, 作为下一页的 last_appid
+	} `json:"response"`
+}
+
+// StoreApp IStoreService/GetAppList 中的单个应用(原始与精简模型相同)
+type StoreApp struct {
+	AppID             uint64 `json:"appid"`               // 应用ID
+	Name              string `json:"name"`                // 应用名称
+	LastModified      int64  `json:"last_modified"`       // 最后修改时间(Unix时间戳)
+	PriceChangeNumber int64  `json:"price_change_number"` // 价格变更序号
+}
+
+// StoreAppPage IStoreService/GetAppList 单页精简模型
+type StoreAppPage struct {
+	Apps            []StoreApp `json:"apps"`              // 当前页的应用
+	HaveMoreResults bool       `json:"have_more_results"` // 是否还有下一页
+	LastAppID       uint64     `json:"last_appid"`        // 下一页的起点
+}
@@ -0,0 +1,102 @@
+package models
+
+// SteamWishlistResponse IWishlistService/GetWishlist
+type SteamWishlistResponse struct {
+	Response struct {
+		Items []SteamWishlistItem `json:"items"` // 愿望单条目
+	} `json:"response"`
+}
+
+// SteamWishlistItem 愿望单条目原始数据
+type SteamWishlistItem struct {
+	AppID     uint64 `json:"appid"`      // 应用ID
+	Priority  int    `json:"priority"`   // 排序优先级(0 表示未排序)
+	DateAdded int64  `json:"date_added"` // 加入时间(Unix时间戳)
+}
+
+// WishlistItem 愿望单条目精简模型
+type WishlistItem struct {
+	AppID        uint64 `json:"appid"`          // 应用ID
+	Priority     int    `json:"priority"`       // 排序优先级(0 表示未排序)
+	DateAdded    int64  `json:"date_added"`     // 加入时间(Unix时间戳)
+	DateAddedStr string `json:"date_added_str"` // 加入时间格式化字符串
+	CapsuleURL   string `json:"capsule_url"`    // 封面完整URL
+}
+
+// SteamWishlistItemCountResponse IWishlistService/GetWishlistItemCount
+type SteamWishlistItemCountResponse struct {
+	Response struct {
+		Count int `json:"count"` // 愿望单条目数
+	} `json:"response"`
+}
+
+// SteamWishlistSortedFilteredResponse IWishlistService/GetWishlistSortedFiltered
+type SteamWishlistSortedFilteredResponse struct {
+	Response struct {
+		Items []SteamWishlistSortedItem `json:"items"` // 当前页条目
+	} `json:"response"`
+}
+
+// SteamWishlistSortedItem 排序筛选后的愿望单条目, 附带商店数据
+type SteamWishlistSortedItem struct {
+	AppID     uint64                  `json:"appid"`      // 应用ID
+	Priority  int                     `json:"priority"`   // 排序优先级
+	DateAdded int64                   `json:"date_added"` // 加入时间(Unix时间戳)
+	StoreItem *SteamWishlistStoreItem `json:"store_item"` // 商店数据(data_request 决定返回字段)
+}
+
+// SteamWishlistStoreItem 愿望单条目的商店数据(StoreItem)
+type SteamWishlistStoreItem struct {
+	ItemType           int                          `json:"item_type"`            // 商品类型(0 应用)
+	ID                 uint64                       `json:"id"`                   // 商品ID
+	Success            int                          `json:"success"`              // 1 表示成功
+	Visible            bool                         `json:"visible"`              // 当前地区是否可见
+	Name               string                       `json:"name"`                 // 名称
+	StoreURLPath       string                       `json:"store_url_path"`       // 商店页路径
+	AppID              uint64                       `json:"appid"`                // 应用ID
+	Type               int                          `json:"type"`                 // 应用类型(0 游戏, 1 软件, 4 DLC ...)
+	IsFree             bool                         `json:"is_free"`              // 是否免费
+	Release            *SteamWishlistRelease        `json:"release"`              // 发行信息
+	BestPurchaseOption *SteamWishlistPurchaseOption `json:"best_purchase_option"` // 最优购买选项
+}
+
+// SteamWishlistRelease 商店数据中的发行信息
+type SteamWishlistRelease struct {
+	SteamReleaseDate  int64  `json:"steam_release_date"`          // Steam 发行时间(Unix时间戳)
+	IsComingSoon      bool   `json:"is_coming_soon"`              // 是否即将推出
+	IsPreload         bool   `json:"is_preload"`                  // 是否可预载
+	CustomReleaseDate string `json:"custom_release_date_message"` // 自定义发行日期文本
+}
+
+// SteamWishlistPurchaseOption 商店数据中的购买选项, 金额单位为货币的 1/100(以字符串返回)
+type SteamWishlistPurchaseOption struct {
+	PackageID              uint64    `json:"packageid"`                // 礼包ID
+	PurchaseOptionName     string    `json:"purchase_option_name"`     // 购买选项名称
+	FinalPriceInCents      FlexInt64 `json:"final_price_in_cents"`     // 现价
+	OriginalPriceInCents   FlexInt64 `json:"original_price_in_cents"`  // 原价(未打折时缺失)
+	FormattedFinalPrice    string    `json:"formatted_final_price"`    // 格式化现价
+	FormattedOriginalPrice string    `json:"formatted_original_price"` // 格式化原价
+	DiscountPct            int       `json:"discount_pct"`             // 折扣百分比
+	ActiveDiscounts        []struct {
+		DiscountAmount      FlexInt64 `json:"discount_amount"`      // 折扣金额
+		DiscountDescription string    `json:"discount_description"` // 折扣描述
+		DiscountEndDate     int64     `json:"discount_end_date"`    // 折扣结束时间(Unix时间戳)
+	} `json:"active_discounts"` // 生效中的折扣
+}
+
+// WishlistEntry 排序筛选后的愿望单条目精简模型
+type WishlistEntry struct {
+	AppID              uint64      `json:"appid"`                 // 应用ID
+	Name               string      `json:"name"`                  // 名称
+	Priority           int         `json:"priority"`              // 排序优先级
+	DateAdded          int64       `json:"date_added"`            // 加入时间(Unix时间戳)
+	DateAddedStr       string      `json:"date_added_str"`        // 加入时间格式化字符串
+	IsFree             bool        `json:"is_free"`               // 是否免费
+	ComingSoon         bool        `json:"coming_soon"`           // 是否即将推出
+	ReleaseDate        int64       `json:"release_date"`          // Steam 发行时间(Unix时间戳)
+	ReleaseDateStr     string      `json:"release_date_str"`      // Steam 发行时间格式化字符串
+	Price              *StorePrice `json:"price"`                 // 价格(免费或无购买选项时为 nil)
+	DiscountEndDate    int64       `json:"discount_end_date"`     // 折扣结束时间(Unix时间戳)
+	DiscountEndDateStr string      `json:"discount_end_date_str"` // 折扣结束时间格式化字符串
+	CapsuleURL         string      `json:"capsule_url"`           // 封面完整URL
+}
@@ -0,0 +1,597 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ============================ store/api/appdetails ============================
+
+// SteamAppDetailsResponse store/api/appdetails, 以 AppID 字符串为键
+type SteamAppDetailsResponse map[string]SteamAppDetailsEntry
+
+// SteamAppDetailsEntry 单个应用的详情结果
+type SteamAppDetailsEntry struct {
+	Success bool                `json:"success"` // 是否成功(应用不存在或地区不可用时为 false)
+	Data    SteamAppDetailsData `json:"data"`    // 应用详情(按 filters 过滤后可能为空)
+}
+
+// UnmarshalJSON 兼容 data 为空数组 [] 的情况(filters 过滤后无数据时商店返回 [])
+func (e *SteamAppDetailsEntry) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Success bool            `json:"success"`
+		Data    json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	e.Success = raw.Success
+	if isEmptyArray(raw.Data) {
+		return nil
+	}
+	return json.Unmarshal(raw.Data, &e.Data)
+}
+
+// SteamAppDetailsData 应用详情原始数据
+type SteamAppDetailsData struct {
+	Type                string                 `json:"type"`                 // 类型(game/dlc/demo/music/...)
+	Name                string                 `json:"name"`                 // 名称
+	SteamAppID          uint64                 `json:"steam_appid"`          // 应用ID
+	RequiredAge         FlexInt                `json:"required_age"`         // 年龄限制(可能为数字或字符串)
+	IsFree              bool                   `json:"is_free"`              // 是否免费
+	ControllerSupport   string                 `json:"controller_support"`   // 手柄支持(full/partial)
+	DLC                 []uint64               `json:"dlc"`                  // DLC 应用ID列表
+	DetailedDescription string                 `json:"detailed_description"` // 详细描述(HTML)
+	AboutTheGame        string                 `json:"about_the_game"`       // 关于此游戏(HTML)
+	ShortDescription    string                 `json:"short_description"`    // 简短描述
+	SupportedLanguages  string                 `json:"supported_languages"`  // 支持语言(HTML)
+	HeaderImage         string                 `json:"header_image"`         // 头图地址
+	CapsuleImage        string                 `json:"capsule_image"`        // 胶囊图地址
+	CapsuleImageV5      string                 `json:"capsule_imagev5"`      // 小胶囊图地址
+	Website             string                 `json:"website"`              // 官网地址
+	PCRequirements      StoreRequirements      `json:"pc_requirements"`      // Windows 配置要求
+	MacRequirements     StoreRequirements      `json:"mac_requirements"`     // macOS 配置要求
+	LinuxRequirements   StoreRequirements      `json:"linux_requirements"`   // Linux 配置要求
+	LegalNotice         string                 `json:"legal_notice"`         // 法律声明
+	Developers          []string               `json:"developers"`           // 开发商
+	Publishers          []string               `json:"publishers"`           // 发行商
+	PriceOverview       *StorePriceOverview    `json:"price_overview"`       // 价格(免费或未发售时为 nil)
+	Packages            []uint64               `json:"packages"`             // 礼包ID列表
+	PackageGroups       []StorePackageGroup    `json:"package_groups"`       // 购买选项分组
+	Platforms           StorePlatforms         `json:"platforms"`            // 支持平台
+	Metacritic          *StoreMetacritic       `json:"metacritic"`           // Metacritic 评分
+	Categories          []StoreCategory        `json:"categories"`           // 分类
+	Genres              []StoreGenre           `json:"genres"`               // 类型
+	Screenshots         []StoreScreenshot      `json:"screenshots"`          // 截图
+	Recommendations     *StoreTotal            `json:"recommendations"`      // 推荐数
+	Achievements        *StoreTotal            `json:"achievements"`         // 成就数
+	ReleaseDate         StoreReleaseDate       `json:"release_date"`         // 发行日期
+	SupportInfo         StoreSupportInfo       `json:"support_info"`         // 支持信息
+	Background          string                 `json:"background"`           // 背景图地址
+	ContentDescriptors  StoreContentDescriptor `json:"content_descriptors"`  // 内容描述
+}
+
+// StorePriceOverview 应用价格, 金额单位为货币的 1/100
+type StorePriceOverview struct {
+	Currency         string `json:"currency"`          // 货币代码(ISO 4217)
+	Initial          int64  `json:"initial"`           // 原价(1/100 货币单位)
+	Final            int64  `json:"final"`             // 现价(1/100 货币单位)
+	DiscountPercent  int    `json:"discount_percent"`  // 折扣百分比
+	InitialFormatted string `json:"initial_formatted"` // 格式化原价(无折扣时为空)
+	FinalFormatted   string `json:"final_formatted"`   // 格式化现价
+}
+
+// StoreRequirements 配置要求(HTML), 商店对无要求的平台返回空数组 []
+type StoreRequirements struct {
+	Minimum     string `json:"minimum"`     // 最低配置
+	Recommended string `json:"recommended"` // 推荐配置
+}
+
+// UnmarshalJSON 兼容空数组 []
+func (r *StoreRequirements) UnmarshalJSON(data []byte) error {
+	if isEmptyArray(data) {
+		*r = StoreRequirements{}
+		return nil
+	}
+	type plain StoreRequirements
+	return json.Unmarshal(data, (*plain)(r))
+}
+
+// StorePackageGroup 购买选项分组
+type StorePackageGroup struct {
+	Name          string            `json:"name"`           // 分组名(default/subscriptions)
+	Title         string            `json:"title"`          // 标题
+	Description   string            `json:"description"`    // 描述
+	SelectionText string            `json:"selection_text"` // 选择提示
+	Subs          []StorePackageSub `json:"subs"`           // 礼包
+}
+
+// StorePackageSub 分组中的礼包
+type StorePackageSub struct {
+	PackageID                uint64 `json:"packageid"`                    // 礼包ID
+	PercentSavingsText       string `json:"percent_savings_text"`         // 折扣文本
+	PercentSavings           int    `json:"percent_savings"`              // 折扣百分比
+	OptionText               string `json:"option_text"`                  // 选项文本(含价格)
+	OptionDescription        string `json:"option_description"`           // 选项描述
+	IsFreeLicense            bool   `json:"is_free_license"`              // 是否免费许可
+	PriceInCentsWithDiscount int64  `json:"price_in_cents_with_discount"` // 折后价格(1/100 货币单位)
+}
+
+// StorePlatforms 支持平台
+type StorePlatforms struct {
+	Windows bool `json:"windows"` // Windows
+	Mac     bool `json:"mac"`     // macOS
+	Linux   bool `json:"linux"`   // Linux
+}
+
+// StoreMetacritic Metacritic 评分
+type StoreMetacritic struct {
+	Score int    `json:"score"` // 评分
+	URL   string `json:"url"`   // 评分页地址
+}
+
+// StoreCategory 商店分类
+type StoreCategory struct {
+	ID          int    `json:"id"`          // 分类ID
+	Description string `json:"description"` // 分类名称
+}
+
+// StoreGenre 商店类型(ID 为字符串)
+type StoreGenre struct {
+	ID          string `json:"id"`          // 类型ID
+	Description string `json:"description"` // 类型名称
+}
+
+// StoreScreenshot 截图
+type StoreScreenshot struct {
+	ID            int    `json:"id"`             // 截图ID
+	PathThumbnail string `json:"path_thumbnail"` // 缩略图地址
+	PathFull      string `json:"path_full"`      // 原图地址
+}
+
+// StoreTotal 数量统计
+type StoreTotal struct {
+	Total int `json:"total"` // 总数
+}
+
+// StoreReleaseDate 发行日期
+type StoreReleaseDate struct {
+	ComingSoon bool   `json:"coming_soon"` // 是否即将推出
+	Date       string `json:"date"`        // 发行日期(本地化文本)
+}
+
+// StoreSupportInfo 支持信息
+type StoreSupportInfo struct {
+	URL   string `json:"url"`   // 支持页地址
+	Email string `json:"email"` // 支持邮箱
+}
+
+// StoreContentDescriptor 内容描述
+type StoreContentDescriptor struct {
+	IDs   []int  `json:"ids"`   // 内容描述ID
+	Notes string `json:"notes"` // 说明
+}
+
+// AppDetails 应用详情精简模型
+type AppDetails struct {
+	AppID            uint64      `json:"appid"`             // 应用ID
+	Type             string      `json:"type"`              // 类型
+	Name             string      `json:"name"`              // 名称
+	IsFree           bool        `json:"is_free"`           // 是否免费
+	RequiredAge      int         `json:"required_age"`      // 年龄限制
+	ShortDescription string      `json:"short_description"` // 简短描述
+	HeaderImage      string      `json:"header_image"`      // 头图地址
+	Website          string      `json:"website"`           // 官网地址
+	Developers       []string    `json:"developers"`        // 开发商
+	Publishers       []string    `json:"publishers"`        // 发行商
+	Price            *StorePrice `json:"price"`             // 价格(免费或未发售时为 nil)
+	Platforms        []string    `json:"platforms"`         // 支持平台(windows/mac/linux)
+	Categories       []string    `json:"categories"`        // 分类名称
+	Genres           []string    `json:"genres"`            // 类型名称
+	ReleaseDate      string      `json:"release_date"`      // 发行日期
+	ComingSoon       bool        `json:"coming_soon"`       // 是否即将推出
+	MetacriticScore  int         `json:"metacritic_score"`  // Metacritic 评分(无则为 0)
+	Recommendations  int         `json:"recommendations"`   // 推荐数
+	DLC              []uint64    `json:"dlc"`               // DLC 应用ID列表
+	Packages         []uint64    `json:"packages"`          // 礼包ID列表
+	Screenshots      []string    `json:"screenshots"`       // 截图原图地址
+}
+
+// ============================ store/api/packagedetails ============================
+
+// SteamPackageDetailsResponse store/api/packagedetails, 以礼包ID字符串为键
+type SteamPackageDetailsResponse map[string]SteamPackageDetailsEntry
+
+// SteamPackageDetailsEntry 单个礼包的详情结果
+type SteamPackageDetailsEntry struct {
+	Success bool                    `json:"success"` // 是否成功(礼包不存在或地区不可用时为 false)
+	Data    SteamPackageDetailsData `json:"data"`    // 礼包详情
+}
+
+// UnmarshalJSON 兼容 data 为空数组 [] 的情况
+func (e *SteamPackageDetailsEntry) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Success bool            `json:"success"`
+		Data    json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	e.Success = raw.Success
+	if isEmptyArray(raw.Data) {
+		return nil
+	}
+	return json.Unmarshal(raw.Data, &e.Data)
+}
+
+// SteamPackageDetailsData 礼包详情原始数据
+type SteamPackageDetailsData struct {
+	Name        string             `json:"name"`         // 礼包名称
+	PageContent string             `json:"page_content"` // 页面内容(HTML)
+	PageImage   string             `json:"page_image"`   // 页面图片地址
+	HeaderImage string             `json:"header_image"` // 头图地址
+	SmallLogo   string             `json:"small_logo"`   // 小图标地址
+	Apps        []StorePackageApp  `json:"apps"`         // 包含的应用
+	Price       *StorePackagePrice `json:"price"`        // 价格(免费时为 nil)
+	Platforms   StorePlatforms     `json:"platforms"`    // 支持平台
+	Controller  struct {
+		FullGamepad bool `json:"full_gamepad"` // 完整手柄支持
+	} `json:"controller"` // 手柄支持
+	ReleaseDate StoreReleaseDate `json:"release_date"` // 发行日期
+}
+
+// StorePackageApp 礼包包含的应用
+type StorePackageApp struct {
+	ID   uint64 `json:"id"`   // 应用ID
+	Name string `json:"name"` // 应用名称
+}
+
+// StorePackagePrice 礼包价格, 金额单位为货币的 1/100
+type StorePackagePrice struct {
+	Currency        string `json:"currency"`         // 货币代码(ISO 4217)
+	Initial         int64  `json:"initial"`          // 原价(1/100 货币单位)
+	Final           int64  `json:"final"`            // 现价(1/100 货币单位)
+	DiscountPercent int    `json:"discount_percent"` // 折扣百分比
+	Individual      int64  `json:"individual"`       // 单独购买所含应用的总价(1/100 货币单位)
+}
+
+// PackageDetails 礼包详情精简模型
+type PackageDetails struct {
+	PackageID   uint64            `json:"packageid"`    // 礼包ID
+	Name        string            `json:"name"`         // 礼包名称
+	HeaderImage string            `json:"header_image"` // 头图地址
+	Apps        []StorePackageApp `json:"apps"`         // 包含的应用
+	Price       *StorePrice       `json:"price"`        // 价格(免费时为 nil)
+	Individual  int64             `json:"individual"`   // 单独购买所含应用的总价(1/100 货币单位)
+	Platforms   []string          `json:"platforms"`    // 支持平台(windows/mac/linux)
+	ReleaseDate string            `json:"release_date"` // 发行日期
+	ComingSoon  bool              `json:"coming_soon"`  // 是否即将推出
+}
+
+// ============================ store/appreviews ============================
+
+// SteamAppReviewsResponse store/appreviews/{appid}?json=1
+type SteamAppReviewsResponse struct {
+	Success      int                  `json:"success"`       // 1 表示成功
+	QuerySummary SteamReviewSummary   `json:"query_summary"` // 查询汇总(总数仅在首页 cursor=* 返回)
+	Reviews      []SteamAppReviewItem `json:"reviews"`       // 当前页评测
+	Cursor       string               `json:"cursor"`        // 下一页游标
+}
+
+// SteamReviewSummary 评测查询汇总
+type SteamReviewSummary struct {
+	NumReviews      int    `json:"num_reviews"`       // 当前页评测数
+	ReviewScore     int    `json:"review_score"`      // 评分等级(1-9)
+	ReviewScoreDesc string `json:"review_score_desc"` // 评分描述(如 Very Positive)
+	TotalPositive   int    `json:"total_positive"`    // 好评总数
+	TotalNegative   int    `json:"total_negative"`    // 差评总数
+	TotalReviews    int    `json:"total_reviews"`     // 评测总数
+}
+
+// SteamAppReviewItem 单条评测原始数据
+type SteamAppReviewItem struct {
+	RecommendationID string `json:"recommendationid"` // 评测ID
+	Author           struct {
+		SteamID              string `json:"steamid"`                 // 作者 SteamID
+		NumGamesOwned        int    `json:"num_games_owned"`         // 拥有游戏数
+		NumReviews           int    `json:"num_reviews"`             // 撰写评测数
+		PlaytimeForever      int    `json:"playtime_forever"`        // 总游玩时长(分钟)
+		PlaytimeLastTwoWeeks int    `json:"playtime_last_two_weeks"` // 近2周游玩时长(分钟)
+		PlaytimeAtReview     int    `json:"playtime_at_review"`      // 撰写评测时的游玩时长(分钟)
+		LastPlayed           int64  `json:"last_played"`             // 最后游玩时间戳
+	} `json:"author"` // 作者信息
+	Language                 string    `json:"language"`                    // 评测语言
+	Review                   string    `json:"review"`                      // 评测内容
+	TimestampCreated         int64     `json:"timestamp_created"`           // 创建时间戳
+	TimestampUpdated         int64     `json:"timestamp_updated"`           // 更新时间戳
+	VotedUp                  bool      `json:"voted_up"`                    // 是否推荐
+	VotesUp                  int       `json:"votes_up"`                    // 认为有价值的人数
+	VotesFunny               int       `json:"votes_funny"`                 // 认为欢乐的人数
+	WeightedVoteScore        FlexFloat `json:"weighted_vote_score"`         // 加权有用度(可能为字符串)
+	CommentCount             int       `json:"comment_count"`               // 评论数
+	SteamPurchase            bool      `json:"steam_purchase"`              // 是否在 Steam 购买
+	ReceivedForFree          bool      `json:"received_for_free"`           // 是否免费获得
+	WrittenDuringEarlyAccess bool      `json:"written_during_early_access"` // 是否撰写于抢先体验期间
+	PrimarilySteamDeck       bool      `json:"primarily_steam_deck"`        // 是否主要在 Steam Deck 上游玩
+	DeveloperResponse        string    `json:"developer_response"`          // 开发者回复
+	TimestampDevResponded    int64     `json:"timestamp_dev_responded"`     // 开发者回复时间戳
+}
+
+// AppReview 评测精简模型
+type AppReview struct {
+	RecommendationID         string  `json:"recommendation_id"`           // 评测ID
+	AuthorSteamID            string  `json:"author_steamid"`              // 作者 SteamID
+	PlaytimeAtReview         int     `json:"playtime_at_review"`          // 撰写评测时的游玩时长(分钟)
+	PlaytimeForever          int     `json:"playtime_forever"`            // 总游玩时长(分钟)
+	Language                 string  `json:"language"`                    // 评测语言
+	Review                   string  `json:"review"`                      // 评测内容
+	VotedUp                  bool    `json:"voted_up"`                    // 是否推荐
+	VotesUp                  int     `json:"votes_up"`                    // 认为有价值的人数
+	VotesFunny               int     `json:"votes_funny"`                 // 认为欢乐的人数
+	WeightedVoteScore        float64 `json:"weighted_vote_score"`         // 加权有用度
+	SteamPurchase            bool    `json:"steam_purchase"`              // 是否在 Steam 购买
+	ReceivedForFree          bool    `json:"received_for_free"`           // 是否免费获得
+	WrittenDuringEarlyAccess bool    `json:"written_during_early_access"` // 是否撰写于抢先体验期间
+	Created                  int64   `json:"created"`                     // 创建时间戳
+	CreatedStr               string  `json:"created_str"`                 // 创建时间格式化字符串
+	Updated                  int64   `json:"updated"`                     // 更新时间戳
+}
+
+// AppReviewPage 评测单页精简模型
+type AppReviewPage struct {
+	Reviews []AppReview `json:"reviews"` // 当前页评测
+	Cursor  string      `json:"cursor"`  // 下一页游标
+}
+
+// ReviewSummary 评测汇总精简模型
+type ReviewSummary struct {
+	ReviewScore     int     `json:"review_score"`      // 评分等级(1-9)
+	ReviewScoreDesc string  `json:"review_score_desc"` // 评分描述(如 Very Positive)
+	TotalPositive   int     `json:"total_positive"`    // 好评总数
+	TotalNegative   int     `json:"total_negative"`    // 差评总数
+	TotalReviews    int     `json:"total_reviews"`     // 评测总数
+	PositiveRatio   float64 `json:"positive_ratio"`    // 好评率(0-1, 无评测时为 0)
+}
+
+// ReviewWindow 时间窗口内的评测统计
+type ReviewWindow struct {
+	Window        time.Duration `json:"window"`         // 窗口长度(截至统计时刻)
+	Since         int64         `json:"since"`          // 窗口起点时间戳
+	Positive      int           `json:"positive"`       // 好评数
+	Negative      int           `json:"negative"`       // 差评数
+	Total         int           `json:"total"`          // 评测数
+	PositiveRatio float64       `json:"positive_ratio"` // 好评率(0-1, 无评测时为 0)
+}
+
+// ============================ store search ============================
+
+// SteamStoreSearchResponse store/api/storesearch
+type SteamStoreSearchResponse struct {
+	Total int                    `json:"total"` // 结果总数
+	Items []SteamStoreSearchItem `json:"items"` // 搜索结果
+}
+
+// SteamStoreSearchItem storesearch 单个结果
+type SteamStoreSearchItem struct {
+	Type              string            `json:"type"`               // 类型(app/sub/bundle)
+	Name              string            `json:"name"`               // 名称
+	ID                uint64            `json:"id"`                 // 应用ID
+	Price             *StoreSearchPrice `json:"price"`              // 价格(免费时为 nil)
+	TinyImage         string            `json:"tiny_image"`         // 小图地址
+	Metascore         FlexInt           `json:"metascore"`          // Metacritic 评分(可能为空字符串)
+	Platforms         StorePlatforms    `json:"platforms"`          // 支持平台
+	StreamingVideo    bool              `json:"streamingvideo"`     // 是否为视频
+	ControllerSupport string            `json:"controller_support"` // 手柄支持
+}
+
+// StoreSearchPrice storesearch 价格, 金额单位为货币的 1/100
+type StoreSearchPrice struct {
+	Currency string `json:"currency"` // 货币代码(ISO 4217)
+	Initial  int64  `json:"initial"`  // 原价(1/100 货币单位)
+	Final    int64  `json:"final"`    // 现价(1/100 货币单位)
+}
+
+// StoreSearchItem storesearch 精简模型
+type StoreSearchItem struct {
+	AppID      uint64      `json:"appid"`       // 应用ID
+	Type       string      `json:"type"`        // 类型
+	Name       string      `json:"name"`        // 名称
+	Price      *StorePrice `json:"price"`       // 价格(免费时为 nil)
+	Metascore  int         `json:"metascore"`   // Metacritic 评分(无则为 0)
+	Platforms  []string    `json:"platforms"`   // 支持平台(windows/mac/linux)
+	CapsuleURL string      `json:"capsule_url"` // 封面完整URL
+}
+
+// SteamSearchSuggestItem store/search/suggest?f=json 单个建议
+type SteamSearchSuggestItem struct {
+	ID    string `json:"id"`    // 应用ID(字符串)
+	Type  string `json:"type"`  // 类型
+	Name  string `json:"name"`  // 名称
+	Logo  string `json:"logo"`  // 小图地址
+	Price string `json:"price"` // 格式化价格
+}
+
+// SearchSuggestion 搜索建议精简模型
+type SearchSuggestion struct {
+	AppID      uint64 `json:"appid"`       // 应用ID
+	Type       string `json:"type"`        // 类型
+	Name       string `json:"name"`        // 名称
+	Price      string `json:"price"`       // 格式化价格
+	CapsuleURL string `json:"capsule_url"` // 封面完整URL
+}
+
+// SteamSearchResultsResponse store/search/results?infinite=1, 结果以 HTML 片段返回
+type SteamSearchResultsResponse struct {
+	Success     int    `json:"success"`      // 1 表示成功
+	ResultsHTML string `json:"results_html"` // 结果 HTML 片段
+	TotalCount  int    `json:"total_count"`  // 结果总数
+	Start       int    `json:"start"`        // 当前页起始偏移
+}
+
+// SearchResult 搜索结果精简模型(由 results_html 解析)
+type SearchResult struct {
+	ItemKey         string   `json:"item_key"`         // 商品键(App_620/Sub_7877/Bundle_232)
+	AppID           uint64   `json:"appid"`            // 应用ID(礼包/捆绑包为首个应用)
+	Name            string   `json:"name"`             // 名称
+	URL             string   `json:"url"`              // 商店页地址
+	ReleaseDate     string   `json:"release_date"`     // 发行日期(本地化文本)
+	ReviewSummary   string   `json:"review_summary"`   // 评测摘要(如 Very Positive)
+	DiscountPercent int      `json:"discount_percent"` // 折扣百分比
+	FinalPrice      int64    `json:"final_price"`      // 现价(1/100 货币单位)
+	FinalFormatted  string   `json:"final_formatted"`  // 格式化现价
+	Platforms       []string `json:"platforms"`        // 支持平台(windows/mac/linux)
+	CapsuleURL      string   `json:"capsule_url"`      // 封面完整URL
+}
+
+// SearchResultPage 搜索结果单页精简模型
+type SearchResultPage struct {
+	Results    []SearchResult `json:"results"`     // 当前页结果
+	Start      int            `json:"start"`       // 当前页起始偏移
+	TotalCount int            `json:"total_count"` // 结果总数
+}
+
+// ============================ store featured ============================
+
+// SteamFeaturedResponse store/api/featured
+type SteamFeaturedResponse struct {
+	LargeCapsules []SteamFeaturedItem `json:"large_capsules"` // 大图推荐
+	FeaturedWin   []SteamFeaturedItem `json:"featured_win"`   // Windows 推荐
+	FeaturedMac   []SteamFeaturedItem `json:"featured_mac"`   // macOS 推荐
+	FeaturedLinux []SteamFeaturedItem `json:"featured_linux"` // Linux 推荐
+	Layout        string              `json:"layout"`         // 布局
+	Status        int                 `json:"status"`         // 1 表示成功
+}
+
+// SteamFeaturedCategoriesResponse store/api/featuredcategories(仅解析固定分类)
+type SteamFeaturedCategoriesResponse struct {
+	Specials    SteamFeaturedCategory `json:"specials"`     // 特惠
+	TopSellers  SteamFeaturedCategory `json:"top_sellers"`  // 热销
+	NewReleases SteamFeaturedCategory `json:"new_releases"` // 新品
+	ComingSoon  SteamFeaturedCategory `json:"coming_soon"`  // 即将推出
+	Status      int                   `json:"status"`       // 1 表示成功
+}
+
+// SteamFeaturedCategory 推荐分类
+type SteamFeaturedCategory struct {
+	ID    string              `json:"id"`    // 分类ID(如 cat_specials)
+	Name  string              `json:"name"`  // 分类名称
+	Items []SteamFeaturedItem `json:"items"` // 分类商品
+}
+
+// SteamFeaturedItem 推荐商品原始数据, 金额单位为货币的 1/100
+type SteamFeaturedItem struct {
+	ID                      uint64 `json:"id"`                       // 应用ID(type=1 时为礼包ID)
+	Type                    int    `json:"type"`                     // 类型(0 应用, 1 礼包)
+	Name                    string `json:"name"`                     // 名称
+	Discounted              bool   `json:"discounted"`               // 是否打折
+	DiscountPercent         int    `json:"discount_percent"`         // 折扣百分比
+	OriginalPrice           *int64 `json:"original_price"`           // 原价(未打折时为 nil)
+	FinalPrice              int64  `json:"final_price"`              // 现价
+	Currency                string `json:"currency"`                 // 货币代码(ISO 4217)
+	LargeCapsuleImage       string `json:"large_capsule_image"`      // 大图地址
+	SmallCapsuleImage       string `json:"small_capsule_image"`      // 小图地址
+	HeaderImage             string `json:"header_image"`             // 头图地址
+	WindowsAvailable        bool   `json:"windows_available"`        // 支持 Windows
+	MacAvailable            bool   `json:"mac_available"`            // 支持 macOS
+	LinuxAvailable          bool   `json:"linux_available"`          // 支持 Linux
+	StreamingVideoAvailable bool   `json:"streamingvideo_available"` // 是否为视频
+	DiscountExpiration      int64  `json:"discount_expiration"`      // 折扣结束时间戳
+	ControllerSupport       string `json:"controller_support"`       // 手柄支持
+}
+
+// FeaturedApp 推荐商品精简模型
+type FeaturedApp struct {
+	ID                    uint64      `json:"id"`                      // 应用ID(Type=1 时为礼包ID)
+	Type                  int         `json:"type"`                    // 类型(0 应用, 1 礼包)
+	Name                  string      `json:"name"`                    // 名称
+	Discounted            bool        `json:"discounted"`              // 是否打折
+	Price                 *StorePrice `json:"price"`                   // 价格(无价格时为 nil)
+	DiscountExpiration    int64       `json:"discount_expiration"`     // 折扣结束时间戳
+	DiscountExpirationStr string      `json:"discount_expiration_str"` // 折扣结束时间格式化字符串
+	Platforms             []string    `json:"platforms"`               // 支持平台(windows/mac/linux)
+	CapsuleURL            string      `json:"capsule_url"`             // 封面完整URL
+}
+
+// Featured 首页推荐精简模型
+type Featured struct {
+	LargeCapsules []FeaturedApp `json:"large_capsules"` // 大图推荐
+	Windows       []FeaturedApp `json:"windows"`        // Windows 推荐
+	Mac           []FeaturedApp `json:"mac"`            // macOS 推荐
+	Linux         []FeaturedApp `json:"linux"`          // Linux 推荐
+}
+
+// FeaturedCategories 推荐分类精简模型
+type FeaturedCategories struct {
+	Specials    []FeaturedApp `json:"specials"`     // 特惠
+	TopSellers  []FeaturedApp `json:"top_sellers"`  // 热销
+	NewReleases []FeaturedApp `json:"new_releases"` // 新品
+	ComingSoon  []FeaturedApp `json:"coming_soon"`  // 即将推出
+}
+
+// ============================ 价格 ============================
+
+// StorePrice 统一的价格模型, 同时提供 1/100 货币单位的整数与换算后的金额
+type StorePrice struct {
+	Currency        string  `json:"currency"`         // 货币代码(ISO 4217)
+	Initial         int64   `json:"initial"`          // 原价(1/100 货币单位)
+	Final           int64   `json:"final"`            // 现价(1/100 货币单位)
+	InitialAmount   float64 `json:"initial_amount"`   // 原价金额
+	FinalAmount     float64 `json:"final_amount"`     // 现价金额
+	DiscountPercent int     `json:"discount_percent"` // 折扣百分比
+	FinalFormatted  string  `json:"final_formatted"`  // 格式化现价(礼包价格无此字段)
+}
+
+// RegionPrice 单个地区的应用价格
+type RegionPrice struct {
+	CountryCode string      `json:"country_code"` // 国家/地区代码(ISO 3166-1 alpha-2)
+	Available   bool        `json:"available"`    // 该地区是否可购买(应用在该地区不可用时为 false)
+	Price       *StorePrice `json:"price"`        // 价格(免费、未发售或不可用时为 nil)
+}
+
+// ============================ 工具类型 ============================
+
+// FlexInt 兼容数字与数字字符串的整数, 如 18 与 "18"
+type FlexInt int
+
+// UnmarshalJSON 解析数字或数字字符串, 无法解析的字符串记为 0
+func (f *FlexInt) UnmarshalJSON(data []byte) error {
+	s := strings.Trim(string(data), `"`)
+	if s == "" || s == "null" {
+		*f = 0
+		return nil
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		// 商店偶尔返回 "18+" 等文本 | The store occasionally returns text such as "18+"
+		v, _ = strconv.Atoi(strings.TrimRight(s, "+"))
+	}
+	*f = FlexInt(v)
+	return nil
+}
+
+// FlexInt64 兼容数字与数字字符串的 int64(protobuf 服务以字符串返回 64 位整数)
+type FlexInt64 int64
+
+// UnmarshalJSON 解析数字或数字字符串
+func (f *FlexInt64) UnmarshalJSON(data []byte) error {
+	s := strings.Trim(string(data), `"`)
+	if s == "" || s == "null" {
+		*f = 0
+		return nil
+	}
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return err
+	}
+	*f = FlexInt64(v)
+	return nil
+}
+
+// isEmptyArray 判断 JSON 值是否为空数组 [] 或缺失 | Report whether a JSON value is [] or missing
+func isEmptyArray(data []byte) bool {
+	data = bytes.TrimSpace(data)
+	return len(data) == 0 || bytes.Equal(data, []byte("[]")) || bytes.Equal(data, []byte("null"))
+}
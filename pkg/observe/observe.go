@@ -0,0 +1,117 @@
+// Package observe 提供 SDK 请求的指标与链路追踪钩子
+// 每次 API 请求、爬虫访问和 A2S 查询前后都会调用 Observer, 默认实现为空操作, 不引入任何第三方依赖
+// 子包 promobserve 提供 Prometheus 风格的计数器/直方图, otelobserve 提供 OpenTelemetry span 适配
+// Package observe provides metrics and tracing hooks for SDK requests
+// An Observer is invoked around every API request, crawler visit and A2S query; the default is a no-op and pulls in no dependencies
+// Sub-package promobserve ships Prometheus-style counters/histograms, otelobserve adapts to OpenTelemetry spans
+
+package observe
+
+import (
+	"context"
+	"time"
+)
+
+// Kind 请求类别
+// Kind is the request category
+type Kind string
+
+const (
+	KindAPI     Kind = "api"     // Steam Web API 请求 | Steam Web API request
+	KindCrawler Kind = "crawler" // 爬虫访问 | Crawler visit
+	KindA2S     Kind = "a2s"     // A2S 服务器查询 | A2S server query
+)
+
+// RequestInfo 请求开始时已知的信息
+// RequestInfo holds what is known when a request starts
+type RequestInfo struct {
+	Kind     Kind   // 请求类别 | Request category
+	Endpoint string // 接口名: API 为 "Interface/Method", 爬虫为主机名, A2S 为 "A2S/Info" 等 | API "Interface/Method", crawler host, A2S "A2S/Info", ...
+	Method   string // HTTP 方法, A2S 为 "UDP" | HTTP method, "UDP" for A2S
+	Target   string // 请求地址(不含查询参数, 不会泄露 Key) 或服务器地址 | URL without query (never leaks the key) or server address
+}
+
+// Event 请求结束时的完整信息, 不适用的字段为零值
+// Event is the full picture once a request ends; fields that do not apply are zero
+type Event struct {
+	RequestInfo
+	Status        int           // 最后一次响应的状态码(无响应为 0) | Status of the last response (0 if none)
+	Attempts      int           // 实际发出的尝试次数(缓存命中为 0) | Attempts actually sent (0 on a cache hit)
+	Latency       time.Duration // 总耗时(含限流等待与重试退避) | Total time including rate-limit waits and retry backoff
+	RateLimitWait time.Duration // 限流等待耗时 | Time spent waiting on rate limiters
+	Bytes         int           // 响应体字节数 | Response body size in bytes
+	CacheHit      bool          // 是否由响应缓存返回(含 304 重新验证) | Served by the response cache (304 revalidation included)
+	Err           error         // 最终错误 | Final error
+}
+
+// Observer 请求观察者
+// RequestStart 返回的 ctx 会用于本次请求(可携带 span 等), 并原样传给 RequestEnd; 实现需并发安全且不应阻塞
+// Observer watches SDK requests
+// The ctx returned by RequestStart is used for the request (it may carry a span, ...) and handed back to RequestEnd;
+// implementations must be safe for concurrent use and must not block
+type Observer interface {
+	RequestStart(ctx context.Context, info RequestInfo) context.Context
+	RequestEnd(ctx context.Context, ev Event)
+}
+
+// Nop 空操作观察者(默认) | No-op observer (the default)
+type Nop struct{}
+
+// RequestStart 实现 Observer 接口
+func (Nop) RequestStart(ctx context.Context, _ RequestInfo) context.Context { return ctx }
+
+// RequestEnd 实现 Observer 接口
+func (Nop) RequestEnd(context.Context, Event) {}
+
+// multi 组合多个观察者 | Fans out to several observers
+type multi []Observer
+
+// Multi 组合多个观察者, 按顺序调用 RequestStart, 逆序调用 RequestEnd
+// 参数:
+//   - observers: 观察者列表(nil 将被忽略) | Observers (nil entries are ignored)
+//
+// 返回值:
+//   - Observer: 组合后的观察者 | Combined observer
+func Multi(observers ...Observer) Observer {
+	m := make(multi, 0, len(observers))
+	for _, o := range observers {
+		if o != nil {
+			m = append(m, o)
+		}
+	}
+	switch len(m) {
+	case 0:
+		return Nop{}
+	case 1:
+		return m[0]
+	}
+	return m
+}
+
+// RequestStart 实现 Observer 接口
+func (m multi) RequestStart(ctx context.Context, info RequestInfo) context.Context {
+	for _, o := range m {
+		ctx = o.RequestStart(ctx, info)
+	}
+	return ctx
+}
+
+// RequestEnd 实现 Observer 接口
+func (m multi) RequestEnd(ctx context.Context, ev Event) {
+	for i := len(m) - 1; i >= 0; i-- {
+		m[i].RequestEnd(ctx, ev)
+	}
+}
+
+// OrNop 为 nil 时返回空操作观察者
+// 参数:
+//   - o: 观察者(可为 nil) | Observer (may be nil)
+//
+// 返回值:
+//   - Observer: 非 nil 的观察者 | Non-nil observer
+func OrNop(o Observer) Observer {
+	if o == nil {
+		return Nop{}
+	}
+	return o
+}
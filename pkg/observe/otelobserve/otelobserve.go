@@ -0,0 +1,105 @@
+// Package otelobserve 将 SDK 请求适配为 OpenTelemetry span
+// 不依赖 OpenTelemetry 库, 通过 StartFunc/Span 两个小接口桥接, 调用方用几行代码接入自己的 Tracer
+// Package otelobserve adapts SDK requests to OpenTelemetry spans
+// It does not depend on the OpenTelemetry libraries: callers bridge their own Tracer through the small StartFunc/Span pair
+//
+//	tracer := otel.Tracer("gf-steam-sdk")
+//	obs := otelobserve.New(func(ctx context.Context, name string) (context.Context, otelobserve.Span) {
+//		ctx, span := tracer.Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient))
+//		return ctx, spanBridge{span}
+//	})
+package otelobserve
+
+import (
+	"context"
+	"strings"
+
+	"github.com/GoFurry/gf-steam-sdk/pkg/observe"
+)
+
+// Span 最小 span 接口, 由调用方适配到具体实现
+// Span is the minimal span surface, adapted by the caller to a concrete implementation
+type Span interface {
+	SetAttribute(key string, value any) // 设置属性(值为 string/int/int64/bool) | Set an attribute (string/int/int64/bool value)
+	RecordError(err error)              // 记录错误并标记 span 失败 | Record an error and mark the span failed
+	End()                               // 结束 span | End the span
+}
+
+// StartFunc 开启 span, 返回携带该 span 的 ctx
+// StartFunc starts a span and returns a ctx carrying it
+type StartFunc func(ctx context.Context, name string) (context.Context, Span)
+
+// spanKey ctx 中保存 span 的键, 每个观察者各持有一个, 经 observe.Multi 组合时互不覆盖(非零大小, 保证指针互不相同)
+// spanKey is the key of the span inside ctx; each observer owns one so that observers combined with observe.Multi
+// never see each other's spans (non-zero sized so every pointer is distinct)
+type spanKey struct{ _ byte }
+
+// observer span 观察者 | Span observer
+type observer struct {
+	start StartFunc
+	key   *spanKey // 本观察者的 ctx 键 | This observer's ctx key
+}
+
+// New 创建 span 观察者
+// span 名称为 "steam.<kind> <endpoint>", 属性遵循 OpenTelemetry HTTP 语义约定, SDK 专有属性以 "steam." 为前缀
+// 参数:
+//   - start: 开启 span 的函数 | Function starting a span
+//
+// 返回值:
+//   - observe.Observer: 观察者(start 为 nil 时为空操作) | Observer (no-op if start is nil)
+func New(start StartFunc) observe.Observer {
+	if start == nil {
+		return observe.Nop{}
+	}
+	return &observer{start: start, key: new(spanKey)}
+}
+
+// RequestStart 实现 observe.Observer 接口
+func (o *observer) RequestStart(ctx context.Context, info observe.RequestInfo) context.Context {
+	ctx, span := o.start(ctx, spanName(info))
+	if span == nil {
+		return ctx
+	}
+	span.SetAttribute("steam.kind", string(info.Kind))
+	span.SetAttribute("steam.endpoint", info.Endpoint)
+	if info.Kind == observe.KindA2S {
+		span.SetAttribute("server.address", info.Target)
+	} else {
+		span.SetAttribute("http.request.method", info.Method)
+		span.SetAttribute("url.full", info.Target)
+	}
+	return context.WithValue(ctx, o.key, span)
+}
+
+// RequestEnd 实现 observe.Observer 接口
+func (o *observer) RequestEnd(ctx context.Context, ev observe.Event) {
+	span, ok := ctx.Value(o.key).(Span)
+	if !ok {
+		return
+	}
+	if ev.Status > 0 {
+		span.SetAttribute("http.response.status_code", ev.Status)
+	}
+	if ev.Bytes > 0 {
+		span.SetAttribute("http.response.body.size", ev.Bytes)
+	}
+	span.SetAttribute("steam.attempts", ev.Attempts)
+	span.SetAttribute("steam.cache_hit", ev.CacheHit)
+	span.SetAttribute("steam.rate_limit_wait_ms", ev.RateLimitWait.Milliseconds())
+	if ev.Err != nil {
+		span.RecordError(ev.Err)
+	}
+	span.End()
+}
+
+// spanName 生成 span 名称 | Build the span name
+func spanName(info observe.RequestInfo) string {
+	var sb strings.Builder
+	sb.WriteString("steam.")
+	sb.WriteString(string(info.Kind))
+	if info.Endpoint != "" {
+		sb.WriteByte(' ')
+		sb.WriteString(info.Endpoint)
+	}
+	return sb.String()
+}
@@ -0,0 +1,52 @@
+package otelobserve_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GoFurry/gf-steam-sdk/pkg/observe"
+	"github.com/GoFurry/gf-steam-sdk/pkg/observe/otelobserve"
+)
+
+// fakeSpan 记录属性与结束次数的 span | Span recording its attributes and how often it ended
+type fakeSpan struct {
+	attrs map[string]any
+	ended int
+}
+
+func (s *fakeSpan) SetAttribute(key string, value any) { s.attrs[key] = value }
+func (s *fakeSpan) RecordError(error)                  {}
+func (s *fakeSpan) End()                               { s.ended++ }
+
+// tracer 记录开启的 span | Records the spans it started
+type tracer struct {
+	spans []*fakeSpan
+}
+
+func (tr *tracer) start(ctx context.Context, _ string) (context.Context, otelobserve.Span) {
+	span := &fakeSpan{attrs: map[string]any{}}
+	tr.spans = append(tr.spans, span)
+	return ctx, span
+}
+
+func TestMultiKeepsSpansApart(t *testing.T) {
+	var a, b tracer
+	obs := observe.Multi(otelobserve.New(a.start), otelobserve.New(b.start))
+
+	info := observe.RequestInfo{Kind: observe.KindAPI, Endpoint: "IPlayerService/GetOwnedGames", Method: "GET"}
+	ctx := obs.RequestStart(context.Background(), info)
+	obs.RequestEnd(ctx, observe.Event{RequestInfo: info, Status: 200, Attempts: 1})
+
+	for name, tr := range map[string]*tracer{"first": &a, "second": &b} {
+		if len(tr.spans) != 1 {
+			t.Fatalf("%s observer: want 1 span, got %d", name, len(tr.spans))
+		}
+		span := tr.spans[0]
+		if span.ended != 1 {
+			t.Fatalf("%s observer: want its span ended once, got %d", name, span.ended)
+		}
+		if span.attrs["http.response.status_code"] != 200 {
+			t.Fatalf("%s observer: end attributes missing: %v", name, span.attrs)
+		}
+	}
+}
@@ -0,0 +1,209 @@
+// Package promobserve 提供 Prometheus 风格的请求指标
+// 不依赖 Prometheus 客户端库, 自行维护计数器/直方图并以 Prometheus 文本格式输出, 可直接挂载为 /metrics
+// Package promobserve provides Prometheus-style request metrics
+// It does not depend on the Prometheus client library: counters/histograms are kept in-process and rendered in the
+// Prometheus text exposition format, so the collector can be mounted as /metrics directly
+
+package promobserve
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/GoFurry/gf-steam-sdk/pkg/observe"
+)
+
+// DefaultBuckets 默认耗时直方图分桶(秒) | Default latency histogram buckets (seconds)
+var DefaultBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// Metrics Prometheus 风格的请求指标收集器, 实现 observe.Observer 和 http.Handler
+// 指标(前缀为命名空间):
+//   - requests_total{kind,endpoint,method,status}: 请求数
+//   - request_duration_seconds{kind,endpoint}: 请求耗时直方图
+//   - rate_limit_wait_seconds{kind}: 限流等待直方图
+//   - retries_total{kind,endpoint}: 重试次数
+//   - response_bytes_total{kind,endpoint}: 响应字节数
+//   - cache_hits_total{kind,endpoint}: 缓存命中数
+//
+// Metrics collects Prometheus-style request metrics, implementing observe.Observer and http.Handler
+type Metrics struct {
+	ns      string
+	buckets []float64
+
+	mu         sync.Mutex
+	requests   map[string]float64    // 标签 -> 计数 | Labels -> count
+	retries    map[string]float64    // 标签 -> 计数 | Labels -> count
+	bytes      map[string]float64    // 标签 -> 计数 | Labels -> count
+	cacheHits  map[string]float64    // 标签 -> 计数 | Labels -> count
+	durations  map[string]*histogram // 标签 -> 直方图 | Labels -> histogram
+	limitWaits map[string]*histogram // 标签 -> 直方图 | Labels -> histogram
+}
+
+// histogram 累积直方图 | Cumulative histogram
+type histogram struct {
+	counts []uint64 // 每个分桶的累计数(不含 +Inf) | Cumulative count per bucket (+Inf excluded)
+	count  uint64
+	sum    float64
+}
+
+// New 创建指标收集器
+// 参数:
+//   - namespace: 指标前缀(如 "steam_sdk", 为空则不加前缀) | Metric name prefix (e.g. "steam_sdk", none if empty)
+//   - buckets: 耗时直方图分桶(秒, nil 使用 DefaultBuckets) | Latency buckets in seconds (DefaultBuckets if nil)
+//
+// 返回值:
+//   - *Metrics: 指标收集器 | Metrics collector
+func New(namespace string, buckets []float64) *Metrics {
+	if len(buckets) == 0 {
+		buckets = DefaultBuckets
+	}
+	buckets = append([]float64(nil), buckets...)
+	sort.Float64s(buckets)
+	if namespace != "" && !strings.HasSuffix(namespace, "_") {
+		namespace += "_"
+	}
+	return &Metrics{
+		ns:         namespace,
+		buckets:    buckets,
+		requests:   map[string]float64{},
+		retries:    map[string]float64{},
+		bytes:      map[string]float64{},
+		cacheHits:  map[string]float64{},
+		durations:  map[string]*histogram{},
+		limitWaits: map[string]*histogram{},
+	}
+}
+
+// RequestStart 实现 observe.Observer 接口
+func (m *Metrics) RequestStart(ctx context.Context, _ observe.RequestInfo) context.Context {
+	return ctx
+}
+
+// RequestEnd 实现 observe.Observer 接口
+func (m *Metrics) RequestEnd(_ context.Context, ev observe.Event) {
+	status := "error"
+	if ev.Status > 0 {
+		status = strconv.Itoa(ev.Status)
+	} else if ev.Err == nil {
+		status = "ok"
+	}
+	kind := string(ev.Kind)
+	byEndpoint := labels("kind", kind, "endpoint", ev.Endpoint)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.requests[labels("kind", kind, "endpoint", ev.Endpoint, "method", ev.Method, "status", status)]++
+	if ev.Attempts > 1 {
+		m.retries[byEndpoint] += float64(ev.Attempts - 1)
+	}
+	if ev.Bytes > 0 {
+		m.bytes[byEndpoint] += float64(ev.Bytes)
+	}
+	if ev.CacheHit {
+		m.cacheHits[byEndpoint]++
+	}
+	m.observe(m.durations, byEndpoint, ev.Latency.Seconds())
+	m.observe(m.limitWaits, labels("kind", kind), ev.RateLimitWait.Seconds())
+}
+
+// observe 记录一次直方图观测(需持有锁) | Record a histogram observation (lock held)
+func (m *Metrics) observe(set map[string]*histogram, key string, v float64) {
+	h := set[key]
+	if h == nil {
+		h = &histogram{counts: make([]uint64, len(m.buckets))}
+		set[key] = h
+	}
+	for i, b := range m.buckets {
+		if v <= b {
+			h.counts[i]++
+		}
+	}
+	h.count++
+	h.sum += v
+}
+
+// ServeHTTP 以 Prometheus 文本格式输出指标, 实现 http.Handler
+func (m *Metrics) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	_, _ = m.WriteTo(w)
+}
+
+// WriteTo 以 Prometheus 文本格式输出指标
+// 参数:
+//   - w: 输出目标 | Destination
+//
+// 返回值:
+//   - int64: 写入字节数 | Bytes written
+//   - error: 写入失败时返回错误 | Error if writing fails
+func (m *Metrics) WriteTo(w io.Writer) (int64, error) {
+	var buf bytes.Buffer
+	m.mu.Lock()
+	m.writeCounter(&buf, "requests_total", "SDK requests by kind, endpoint, method and status.", m.requests)
+	m.writeHistogram(&buf, "request_duration_seconds", "SDK request latency including retries.", m.durations)
+	m.writeHistogram(&buf, "rate_limit_wait_seconds", "Time spent waiting on rate limiters.", m.limitWaits)
+	m.writeCounter(&buf, "retries_total", "Retried attempts.", m.retries)
+	m.writeCounter(&buf, "response_bytes_total", "Response body bytes.", m.bytes)
+	m.writeCounter(&buf, "cache_hits_total", "Responses served by the response cache.", m.cacheHits)
+	m.mu.Unlock()
+	return buf.WriteTo(w)
+}
+
+// writeCounter 输出计数器 | Render a counter
+func (m *Metrics) writeCounter(buf *bytes.Buffer, name, help string, set map[string]float64) {
+	name = m.ns + name
+	fmt.Fprintf(buf, "# HELP %s %s\n# TYPE %s counter\n", name, help, name)
+	for _, key := range sortedKeys(set) {
+		fmt.Fprintf(buf, "%s{%s} %s\n", name, key, formatFloat(set[key]))
+	}
+}
+
+// writeHistogram 输出直方图 | Render a histogram
+func (m *Metrics) writeHistogram(buf *bytes.Buffer, name, help string, set map[string]*histogram) {
+	name = m.ns + name
+	fmt.Fprintf(buf, "# HELP %s %s\n# TYPE %s histogram\n", name, help, name)
+	for _, key := range sortedKeys(set) {
+		h := set[key]
+		for i, b := range m.buckets {
+			fmt.Fprintf(buf, "%s_bucket{%s,le=\"%s\"} %d\n", name, key, formatFloat(b), h.counts[i])
+		}
+		fmt.Fprintf(buf, "%s_bucket{%s,le=\"+Inf\"} %d\n", name, key, h.count)
+		fmt.Fprintf(buf, "%s_sum{%s} %s\n", name, key, formatFloat(h.sum))
+		fmt.Fprintf(buf, "%s_count{%s} %d\n", name, key, h.count)
+	}
+}
+
+// labels 按顺序拼接标签对 | Join label pairs in order
+func labels(kv ...string) string {
+	var sb strings.Builder
+	for i := 0; i+1 < len(kv); i += 2 {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteString(kv[i])
+		sb.WriteString("=")
+		sb.WriteString(strconv.Quote(kv[i+1]))
+	}
+	return sb.String()
+}
+
+// sortedKeys 排序后的键, 保证输出稳定 | Sorted keys for a stable output
+func sortedKeys[V any](set map[string]V) []string {
+	keys := make([]string, 0, len(set))
+	for k := range set {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// formatFloat 格式化数值 | Format a sample value
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
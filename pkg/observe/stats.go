@@ -0,0 +1,36 @@
+package observe
+
+import (
+	"time"
+
+	"github.com/GoFurry/gf-steam-sdk/pkg/cache"
+)
+
+// Stats SDK 运行统计快照(由 SteamSDK.Stats 返回)
+// Stats is a snapshot of SDK runtime statistics (returned by SteamSDK.Stats)
+type Stats struct {
+	Keys     []KeyStats     `json:"keys"`     // API Key 池使用情况(未配置为空) | API key pool usage (empty if not configured)
+	Cache    cache.Stats    `json:"cache"`    // 响应缓存统计 | Response cache stats
+	Circuits []CircuitStats `json:"circuits"` // 各主机熔断状态(未启用为空) | Per-host breaker state (empty if disabled)
+}
+
+// KeyStats 单个 API Key 的使用统计(Key 已脱敏)
+// KeyStats is the usage of one API key (the key is masked)
+type KeyStats struct {
+	Key              string    `json:"key"`               // 脱敏后的 Key | Masked key
+	UsedToday        uint64    `json:"used_today"`        // 当日调用次数 | Calls today
+	RemainingToday   int64     `json:"remaining_today"`   // 当日剩余额度(-1 表示不限) | Remaining today (-1 means unlimited)
+	Total            uint64    `json:"total"`             // 累计调用次数 | Calls in total
+	Failures         uint64    `json:"failures"`          // Key 被拒绝次数(429/Key 无效的 403) | Times the key was rejected (429/bad-key 403)
+	Quarantined      bool      `json:"quarantined"`       // 是否处于隔离 | Whether quarantined
+	QuarantinedUntil time.Time `json:"quarantined_until"` // 隔离截止时间 | Quarantined until
+}
+
+// CircuitStats 单个主机的熔断统计
+// CircuitStats is the breaker state of one host
+type CircuitStats struct {
+	Host      string    `json:"host"`       // 主机 | Host
+	State     string    `json:"state"`      // closed/open/half_open
+	Failures  int       `json:"failures"`   // 连续失败次数 | Consecutive failures
+	OpenUntil time.Time `json:"open_until"` // 熔断截止时间 | Open until
+}
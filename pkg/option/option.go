@@ -0,0 +1,87 @@
+// Package option 提供单次请求级别的配置项
+// 用于在共享同一 SDK 实例的情况下, 按请求覆盖全局配置中的 API Key、Access Token、语言、国家和请求头
+// Package option provides per-request options
+// Used to override API key, access token, language, country and headers from the global config for a single call while sharing one SDK instance
+
+package option
+
+// RequestOption 单次请求配置函数
+// RequestOption configures a single request
+type RequestOption func(*RequestOptions)
+
+// RequestOptions 单次请求配置集合
+// 字段为 nil 表示未设置, 沿用全局配置(全局也未配置则不发送该参数)
+// RequestOptions holds per-request settings
+// A nil field means unset: the global config is used, and the param is omitted if that is empty too
+type RequestOptions struct {
+	APIKey      *string           // 覆盖 API Key | Overrides API key
+	AccessToken *string           // 覆盖 Access Token | Overrides access token
+	Language    *string           // 覆盖语言参数 | Overrides language param
+	Country     *string           // 覆盖国家/地区参数 | Overrides country param
+	Headers     map[string]string // 额外请求头(覆盖同名全局请求头) | Extra headers (override global headers with the same name)
+}
+
+// Apply 依次应用配置函数, 返回合并后的请求配置
+// 参数:
+//   - opts: 请求配置函数列表(nil 将被忽略) | Request options (nil entries are ignored)
+//
+// 返回值:
+//   - *RequestOptions: 合并后的请求配置 | Merged request options
+func Apply(opts ...RequestOption) *RequestOptions {
+	o := &RequestOptions{}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(o)
+		}
+	}
+	return o
+}
+
+// WithAPIKey 本次请求使用指定的 API Key
+// 参数:
+//   - apiKey: Steam API 密钥 | Steam API key
+func WithAPIKey(apiKey string) RequestOption {
+	return func(o *RequestOptions) {
+		o.APIKey = &apiKey
+	}
+}
+
+// WithAccessToken 本次请求使用指定的 Access Token, 优先于接口参数和全局配置
+// 参数:
+//   - accessToken: Access Token
+func WithAccessToken(accessToken string) RequestOption {
+	return func(o *RequestOptions) {
+		o.AccessToken = &accessToken
+	}
+}
+
+// WithLanguage 本次请求使用指定的语言(如 english、schinese)
+// 参数:
+//   - language: Steam 语言代码 | Steam language code
+func WithLanguage(language string) RequestOption {
+	return func(o *RequestOptions) {
+		o.Language = &language
+	}
+}
+
+// WithCountry 本次请求使用指定的国家/地区代码(如 US、CN)
+// 参数:
+//   - country: ISO 3166 国家代码 | ISO 3166 country code
+func WithCountry(country string) RequestOption {
+	return func(o *RequestOptions) {
+		o.Country = &country
+	}
+}
+
+// WithHeader 本次请求追加请求头, 可多次使用
+// 参数:
+//   - key: 请求头名称 | Header name
+//   - value: 请求头值 | Header value
+func WithHeader(key, value string) RequestOption {
+	return func(o *RequestOptions) {
+		if o.Headers == nil {
+			o.Headers = map[string]string{}
+		}
+		o.Headers[key] = value
+	}
+}
@@ -1,11 +1,13 @@
 package dev
 
 import (
+	"context"
 	"net/url"
 
 	"github.com/GoFurry/gf-steam-sdk/internal/api"
 	"github.com/GoFurry/gf-steam-sdk/internal/client"
 	"github.com/GoFurry/gf-steam-sdk/pkg/models"
+	"github.com/GoFurry/gf-steam-sdk/pkg/option"
 	"github.com/GoFurry/gf-steam-sdk/pkg/util"
 )
 
@@ -16,22 +18,39 @@ const (
 // ============================ Raw Bytes 原始字节流接口 ============================
 
 // GetUserCartRawBytes requires access_token, return cart info from the access_token's owner.
-func (s *DevService) GetUserCartRawBytes(countryCode string, accessToken *string) (respBytes []byte, err error) {
-	return api.GetRawBytes(s.buildUserCart(countryCode, accessToken))
+func (s *DevService) GetUserCartRawBytes(countryCode string, accessToken *string, opts ...option.RequestOption) (respBytes []byte, err error) {
+	return s.GetUserCartRawBytesCtx(context.Background(), countryCode, accessToken, opts...)
+}
+
+// GetUserCartRawBytesCtx is the context-aware variant of GetUserCartRawBytes
+func (s *DevService) GetUserCartRawBytesCtx(ctx context.Context, countryCode string, accessToken *string, opts ...option.RequestOption) (respBytes []byte, err error) {
+	c, method, reqPath, params := s.buildUserCart(countryCode, accessToken)
+	return api.GetRawBytesCtx(ctx, c, method, reqPath, params, opts...)
 }
 
 // ============================ Structed Raw Model 结构化原始模型接口 ============================
 
 // GetUserCartRawModel requires access_token, return cart info from the access_token's owner.
-func (s *DevService) GetUserCartRawModel(countryCode string, accessToken *string) (models.SteamUserCartResponse, error) {
-	return api.GetRawModel[models.SteamUserCartResponse](s.buildUserCart(countryCode, accessToken))
+func (s *DevService) GetUserCartRawModel(countryCode string, accessToken *string, opts ...option.RequestOption) (models.SteamUserCartResponse, error) {
+	return s.GetUserCartRawModelCtx(context.Background(), countryCode, accessToken, opts...)
+}
+
+// GetUserCartRawModelCtx is the context-aware variant of GetUserCartRawModel
+func (s *DevService) GetUserCartRawModelCtx(ctx context.Context, countryCode string, accessToken *string, opts ...option.RequestOption) (models.SteamUserCartResponse, error) {
+	c, method, reqPath, params := s.buildUserCart(countryCode, accessToken)
+	return api.GetRawModelCtx[models.SteamUserCartResponse](ctx, c, method, reqPath, params, opts...)
 }
 
 // ============================ Brief Model 精简模型接口 ============================
 
 // GetUserCartBrief requires access_token, return cart info from the access_token's owner.
-func (s *DevService) GetUserCartBrief(countryCode string, accessToken *string) (models.UserCart, error) {
-	rawCart, err := s.GetUserCartRawModel(countryCode, accessToken)
+func (s *DevService) GetUserCartBrief(countryCode string, accessToken *string, opts ...option.RequestOption) (models.UserCart, error) {
+	return s.GetUserCartBriefCtx(context.Background(), countryCode, accessToken, opts...)
+}
+
+// GetUserCartBriefCtx is the context-aware variant of GetUserCartBrief
+func (s *DevService) GetUserCartBriefCtx(ctx context.Context, countryCode string, accessToken *string, opts ...option.RequestOption) (models.UserCart, error) {
+	rawCart, err := s.GetUserCartRawModelCtx(ctx, countryCode, accessToken, opts...)
 	if err != nil {
 		return models.UserCart{}, err
 	}
@@ -62,18 +81,28 @@ func (s *DevService) GetUserCartBrief(countryCode string, accessToken *string) (
 // GetUserCart requires access_token, return cart info from the access_token's owner.
 //   - countryCode changes price
 //   - accessToken is required, if globally initialized, use nil
-func (s *DevService) GetUserCart(countryCode string, accessToken *string) (models.UserCart, error) {
-	return s.GetUserCartBrief(countryCode, accessToken)
+func (s *DevService) GetUserCart(countryCode string, accessToken *string, opts ...option.RequestOption) (models.UserCart, error) {
+	return s.GetUserCartBrief(countryCode, accessToken, opts...)
+}
+
+// GetUserCartCtx is the context-aware variant of GetUserCart
+func (s *DevService) GetUserCartCtx(ctx context.Context, countryCode string, accessToken *string, opts ...option.RequestOption) (models.UserCart, error) {
+	return s.GetUserCartBriefCtx(ctx, countryCode, accessToken, opts...)
 }
 
 // DeleteUserCart requires access_token, clear all cart items from the access_token's owner.
 //   - accessToken is required, if globally initialized, use nil
-func (s *DevService) DeleteUserCart(accessToken *string) error {
+func (s *DevService) DeleteUserCart(accessToken *string, opts ...option.RequestOption) error {
+	return s.DeleteUserCartCtx(context.Background(), accessToken, opts...)
+}
+
+// DeleteUserCartCtx is the context-aware variant of DeleteUserCart
+func (s *DevService) DeleteUserCartCtx(ctx context.Context, accessToken *string, opts ...option.RequestOption) error {
 	params := url.Values{}
 	if accessToken != nil {
 		params.Set("access_token", *accessToken)
 	}
-	_, err := s.client.DoRequest("POST", IAccountCartService+"/DeleteCart/v1/", params)
+	_, err := s.client.DoRequestCtx(ctx, "POST", IAccountCartService+"/DeleteCart/v1/", params, opts...)
 	return err
 }
 
@@ -1,11 +1,13 @@
 package dev
 
 import (
+	"context"
 	"net/url"
 
 	"github.com/GoFurry/gf-steam-sdk/internal/api"
 	"github.com/GoFurry/gf-steam-sdk/internal/client"
 	"github.com/GoFurry/gf-steam-sdk/pkg/models"
+	"github.com/GoFurry/gf-steam-sdk/pkg/option"
 	"github.com/GoFurry/gf-steam-sdk/pkg/util"
 )
 
@@ -18,8 +20,14 @@ const (
 // GetSubscriptionBillCountRawBytes requires access_token, return bill count from the access_token's owner.
 // If globally init access_token, you can use access_token = nil.
 // 返回 access_token 拥有者的订阅账单数量, 若 access_token 全局初始化则可填 nil.
-func (s *DevService) GetSubscriptionBillCountRawBytes(accessToken *string) (respBytes []byte, err error) {
-	return api.GetRawBytes(s.buildSubscriptionBill(accessToken))
+func (s *DevService) GetSubscriptionBillCountRawBytes(accessToken *string, opts ...option.RequestOption) (respBytes []byte, err error) {
+	return s.GetSubscriptionBillCountRawBytesCtx(context.Background(), accessToken, opts...)
+}
+
+// GetSubscriptionBillCountRawBytesCtx is the context-aware variant of GetSubscriptionBillCountRawBytes
+func (s *DevService) GetSubscriptionBillCountRawBytesCtx(ctx context.Context, accessToken *string, opts ...option.RequestOption) (respBytes []byte, err error) {
+	c, method, reqPath, params := s.buildSubscriptionBill(accessToken)
+	return api.GetRawBytesCtx(ctx, c, method, reqPath, params, opts...)
 }
 
 // ============================ Default Interface 默认接口 ============================
@@ -27,8 +35,14 @@ func (s *DevService) GetSubscriptionBillCountRawBytes(accessToken *string) (resp
 // GetSubscriptionBillCount requires access_token, return bill count from the access_token's owner.
 // If globally init access_token, you can use access_token = nil.
 // 返回 access_token 拥有者的订阅账单数量, 若 access_token 全局初始化则可填 nil.
-func (s *DevService) GetSubscriptionBillCount(accessToken *string) (models.SubscriptionBillCountResponse, error) {
-	return api.GetRawModel[models.SubscriptionBillCountResponse](s.buildSubscriptionBill(accessToken))
+func (s *DevService) GetSubscriptionBillCount(accessToken *string, opts ...option.RequestOption) (models.SubscriptionBillCountResponse, error) {
+	return s.GetSubscriptionBillCountCtx(context.Background(), accessToken, opts...)
+}
+
+// GetSubscriptionBillCountCtx is the context-aware variant of GetSubscriptionBillCount
+func (s *DevService) GetSubscriptionBillCountCtx(ctx context.Context, accessToken *string, opts ...option.RequestOption) (models.SubscriptionBillCountResponse, error) {
+	c, method, reqPath, params := s.buildSubscriptionBill(accessToken)
+	return api.GetRawModelCtx[models.SubscriptionBillCountResponse](ctx, c, method, reqPath, params, opts...)
 }
 
 // ============================ Build 构造入参 ============================
@@ -1,12 +1,13 @@
 package dev
 
 import (
-	"fmt"
+	"context"
 	"net/url"
 
 	"github.com/GoFurry/gf-steam-sdk/internal/api"
 	"github.com/GoFurry/gf-steam-sdk/internal/client"
 	"github.com/GoFurry/gf-steam-sdk/pkg/models"
+	"github.com/GoFurry/gf-steam-sdk/pkg/option"
 	"github.com/GoFurry/gf-steam-sdk/pkg/util"
 )
 
@@ -17,27 +18,45 @@ const (
 // ============================ Raw Bytes 原始字节流接口 ============================
 
 // GetAppsRawBytes return game brief info. 返回入参对应游戏的简略信息.
-func (s *DevService) GetAppsRawBytes(appids []string) (respBytes []byte, err error) {
-	return api.GetRawBytes(s.buildApps(appids))
+func (s *DevService) GetAppsRawBytes(appids []string, opts ...option.RequestOption) (respBytes []byte, err error) {
+	return s.GetAppsRawBytesCtx(context.Background(), appids, opts...)
+}
+
+// GetAppsRawBytesCtx is the context-aware variant of GetAppsRawBytes
+func (s *DevService) GetAppsRawBytesCtx(ctx context.Context, appids []string, opts ...option.RequestOption) (respBytes []byte, err error) {
+	c, method, reqPath, params := s.buildApps(appids)
+	return api.GetRawBytesCtx(ctx, c, method, reqPath, params, opts...)
 }
 
 // ============================ Structed Raw Model 结构化原始模型接口 ============================
 
 // GetAppsRawModel return game brief info. 返回入参对应游戏的简略信息.
-func (s *DevService) GetAppsRawModel(appids []string) (models.GetAppsResponse, error) {
-	return api.GetRawModel[models.GetAppsResponse](s.buildApps(appids))
+func (s *DevService) GetAppsRawModel(appids []string, opts ...option.RequestOption) (models.GetAppsResponse, error) {
+	return s.GetAppsRawModelCtx(context.Background(), appids, opts...)
+}
+
+// GetAppsRawModelCtx is the context-aware variant of GetAppsRawModel
+func (s *DevService) GetAppsRawModelCtx(ctx context.Context, appids []string, opts ...option.RequestOption) (models.GetAppsResponse, error) {
+	c, method, reqPath, params := s.buildApps(appids)
+	return api.GetRawModelCtx[models.GetAppsResponse](ctx, c, method, reqPath, params, opts...)
 }
 
 // ============================ Brief Model 精简模型接口 ============================
 
 // GetAppsBrief return game brief info. 返回入参对应游戏的简略信息.
-func (s *DevService) GetAppsBrief(appids []string) ([]models.AppBriefInfo, error) {
-	rawApp, err := s.GetAppsRawModel(appids)
+func (s *DevService) GetAppsBrief(appids []string, opts ...option.RequestOption) ([]models.AppBriefInfo, error) {
+	return s.GetAppsBriefCtx(context.Background(), appids, opts...)
+}
+
+// GetAppsBriefCtx is the context-aware variant of GetAppsBrief
+func (s *DevService) GetAppsBriefCtx(ctx context.Context, appids []string, opts ...option.RequestOption) ([]models.AppBriefInfo, error) {
+	rawApp, err := s.GetAppsRawModelCtx(ctx, appids, opts...)
 	if err != nil {
 		return nil, err
 	}
 
 	apps := make([]models.AppBriefInfo, 0, len(rawApp.Response.Apps))
+	endpoints := s.client.Endpoints()
 	for _, a := range rawApp.Response.Apps {
 		app := models.AppBriefInfo{
 			ID:               a.AppID,
@@ -45,7 +64,7 @@ func (s *DevService) GetAppsBrief(appids []string) ([]models.AppBriefInfo, error
 			Type:             a.AppType,
 			CommunityVisible: a.CommunityVisibleStats,
 			Propagation:      a.Propagation,
-			Icon:             fmt.Sprintf(util.STEAM_ICON_URL, a.AppID, a.Icon),
+			Icon:             endpoints.IconURL(uint64(a.AppID), a.Icon),
 		}
 		apps = append(apps, app)
 	}
@@ -56,8 +75,13 @@ func (s *DevService) GetAppsBrief(appids []string) ([]models.AppBriefInfo, error
 // ============================ Default Interface 默认接口 ============================
 
 // GetApps return game brief info. 返回入参对应游戏的简略信息.
-func (s *DevService) GetApps(appids []string) ([]models.AppBriefInfo, error) {
-	return s.GetAppsBrief(appids)
+func (s *DevService) GetApps(appids []string, opts ...option.RequestOption) ([]models.AppBriefInfo, error) {
+	return s.GetAppsBrief(appids, opts...)
+}
+
+// GetAppsCtx is the context-aware variant of GetApps
+func (s *DevService) GetAppsCtx(ctx context.Context, appids []string, opts ...option.RequestOption) ([]models.AppBriefInfo, error) {
+	return s.GetAppsBriefCtx(ctx, appids, opts...)
 }
 
 // ============================ Build 构造入参 ============================
@@ -1,12 +1,13 @@
 package dev
 
 import (
-	"fmt"
+	"context"
 	"net/url"
 
 	"github.com/GoFurry/gf-steam-sdk/internal/api"
 	"github.com/GoFurry/gf-steam-sdk/internal/client"
 	"github.com/GoFurry/gf-steam-sdk/pkg/models"
+	"github.com/GoFurry/gf-steam-sdk/pkg/option"
 	"github.com/GoFurry/gf-steam-sdk/pkg/util"
 )
 
@@ -17,62 +18,127 @@ const (
 // ============================ Raw Bytes 原始字节流接口 ============================
 
 // GetFamilyChangeLogRawBytes return family change log. 返回家庭组变更日志.
-func (s *DevService) GetFamilyChangeLogRawBytes(familyID string) (respBytes []byte, err error) {
-	return api.GetRawBytes(s.buildFamilyChangeLog(familyID))
+func (s *DevService) GetFamilyChangeLogRawBytes(familyID string, opts ...option.RequestOption) (respBytes []byte, err error) {
+	return s.GetFamilyChangeLogRawBytesCtx(context.Background(), familyID, opts...)
+}
+
+// GetFamilyChangeLogRawBytesCtx is the context-aware variant of GetFamilyChangeLogRawBytes
+func (s *DevService) GetFamilyChangeLogRawBytesCtx(ctx context.Context, familyID string, opts ...option.RequestOption) (respBytes []byte, err error) {
+	c, method, reqPath, params := s.buildFamilyChangeLog(familyID)
+	return api.GetRawBytesCtx(ctx, c, method, reqPath, params, opts...)
 }
 
 // GetFamilyMembersRawBytes return family info. 返回家庭组信息.
-func (s *DevService) GetFamilyMembersRawBytes(familyID string) (respBytes []byte, err error) {
-	return api.GetRawBytes(s.buildFamilyMembers(familyID))
+func (s *DevService) GetFamilyMembersRawBytes(familyID string, opts ...option.RequestOption) (respBytes []byte, err error) {
+	return s.GetFamilyMembersRawBytesCtx(context.Background(), familyID, opts...)
+}
+
+// GetFamilyMembersRawBytesCtx is the context-aware variant of GetFamilyMembersRawBytes
+func (s *DevService) GetFamilyMembersRawBytesCtx(ctx context.Context, familyID string, opts ...option.RequestOption) (respBytes []byte, err error) {
+	c, method, reqPath, params := s.buildFamilyMembers(familyID)
+	return api.GetRawBytesCtx(ctx, c, method, reqPath, params, opts...)
 }
 
 // GetFamilyGroupRawBytes return family group info by user. 返回当前access token用户的家庭组详细信息.
-func (s *DevService) GetFamilyGroupRawBytes(familyID string, included bool) (respBytes []byte, err error) {
-	return api.GetRawBytes(s.buildFamilyGroup(familyID, included))
+func (s *DevService) GetFamilyGroupRawBytes(familyID string, included bool, opts ...option.RequestOption) (respBytes []byte, err error) {
+	return s.GetFamilyGroupRawBytesCtx(context.Background(), familyID, included, opts...)
+}
+
+// GetFamilyGroupRawBytesCtx is the context-aware variant of GetFamilyGroupRawBytes
+func (s *DevService) GetFamilyGroupRawBytesCtx(ctx context.Context, familyID string, included bool, opts ...option.RequestOption) (respBytes []byte, err error) {
+	c, method, reqPath, params := s.buildFamilyGroup(familyID, included)
+	return api.GetRawBytesCtx(ctx, c, method, reqPath, params, opts...)
 }
 
 // GetFamilyPlaytimeRawBytes return family playtime. 返回家庭组游玩记录信息.
-func (s *DevService) GetFamilyPlaytimeRawBytes(familyID string) (respBytes []byte, err error) {
-	return api.GetRawBytes(s.buildFamilyPlaytime(familyID))
+func (s *DevService) GetFamilyPlaytimeRawBytes(familyID string, opts ...option.RequestOption) (respBytes []byte, err error) {
+	return s.GetFamilyPlaytimeRawBytesCtx(context.Background(), familyID, opts...)
+}
+
+// GetFamilyPlaytimeRawBytesCtx is the context-aware variant of GetFamilyPlaytimeRawBytes
+func (s *DevService) GetFamilyPlaytimeRawBytesCtx(ctx context.Context, familyID string, opts ...option.RequestOption) (respBytes []byte, err error) {
+	c, method, reqPath, params := s.buildFamilyPlaytime(familyID)
+	return api.GetRawBytesCtx(ctx, c, method, reqPath, params, opts...)
 }
 
 // GetSharedAppsRawBytes return family shared apps. 返回家庭组共享的游戏.
-func (s *DevService) GetSharedAppsRawBytes(familyID string) (respBytes []byte, err error) {
-	return api.GetRawBytes(s.buildSharedApps(familyID))
+func (s *DevService) GetSharedAppsRawBytes(familyID string, opts ...option.RequestOption) (respBytes []byte, err error) {
+	return s.GetSharedAppsRawBytesCtx(context.Background(), familyID, opts...)
+}
+
+// GetSharedAppsRawBytesCtx is the context-aware variant of GetSharedAppsRawBytes
+func (s *DevService) GetSharedAppsRawBytesCtx(ctx context.Context, familyID string, opts ...option.RequestOption) (respBytes []byte, err error) {
+	c, method, reqPath, params := s.buildSharedApps(familyID)
+	return api.GetRawBytesCtx(ctx, c, method, reqPath, params, opts...)
 }
 
 // ============================ Structed Raw Model 结构化原始模型接口 ============================
 
 // GetFamilyChangeLogRawModel return family change log. 返回家庭组变更日志.
-func (s *DevService) GetFamilyChangeLogRawModel(familyID string) (models.FamilyGroupChangeLogResponse, error) {
-	return api.GetRawModel[models.FamilyGroupChangeLogResponse](s.buildFamilyChangeLog(familyID))
+func (s *DevService) GetFamilyChangeLogRawModel(familyID string, opts ...option.RequestOption) (models.FamilyGroupChangeLogResponse, error) {
+	return s.GetFamilyChangeLogRawModelCtx(context.Background(), familyID, opts...)
+}
+
+// GetFamilyChangeLogRawModelCtx is the context-aware variant of GetFamilyChangeLogRawModel
+func (s *DevService) GetFamilyChangeLogRawModelCtx(ctx context.Context, familyID string, opts ...option.RequestOption) (models.FamilyGroupChangeLogResponse, error) {
+	c, method, reqPath, params := s.buildFamilyChangeLog(familyID)
+	return api.GetRawModelCtx[models.FamilyGroupChangeLogResponse](ctx, c, method, reqPath, params, opts...)
 }
 
 // GetFamilyMembersRawModel return family info. 返回家庭组信息.
-func (s *DevService) GetFamilyMembersRawModel(familyID string) (models.FamilyGroupResponse, error) {
-	return api.GetRawModel[models.FamilyGroupResponse](s.buildFamilyMembers(familyID))
+func (s *DevService) GetFamilyMembersRawModel(familyID string, opts ...option.RequestOption) (models.FamilyGroupResponse, error) {
+	return s.GetFamilyMembersRawModelCtx(context.Background(), familyID, opts...)
+}
+
+// GetFamilyMembersRawModelCtx is the context-aware variant of GetFamilyMembersRawModel
+func (s *DevService) GetFamilyMembersRawModelCtx(ctx context.Context, familyID string, opts ...option.RequestOption) (models.FamilyGroupResponse, error) {
+	c, method, reqPath, params := s.buildFamilyMembers(familyID)
+	return api.GetRawModelCtx[models.FamilyGroupResponse](ctx, c, method, reqPath, params, opts...)
 }
 
 // GetFamilyGroupRawModel return family group info by user. 返回当前access token用户的家庭组详细信息.
-func (s *DevService) GetFamilyGroupRawModel(familyID string, included bool) (models.FamilyGroupForUserResponse, error) {
-	return api.GetRawModel[models.FamilyGroupForUserResponse](s.buildFamilyGroup(familyID, included))
+func (s *DevService) GetFamilyGroupRawModel(familyID string, included bool, opts ...option.RequestOption) (models.FamilyGroupForUserResponse, error) {
+	return s.GetFamilyGroupRawModelCtx(context.Background(), familyID, included, opts...)
+}
+
+// GetFamilyGroupRawModelCtx is the context-aware variant of GetFamilyGroupRawModel
+func (s *DevService) GetFamilyGroupRawModelCtx(ctx context.Context, familyID string, included bool, opts ...option.RequestOption) (models.FamilyGroupForUserResponse, error) {
+	c, method, reqPath, params := s.buildFamilyGroup(familyID, included)
+	return api.GetRawModelCtx[models.FamilyGroupForUserResponse](ctx, c, method, reqPath, params, opts...)
 }
 
 // GetFamilyPlaytimeRawModel return family playtime. 返回家庭组游玩记录信息.
-func (s *DevService) GetFamilyPlaytimeRawModel(familyID string) (models.FamilyGroupPlaytimeSummaryResponse, error) {
-	return api.GetRawModel[models.FamilyGroupPlaytimeSummaryResponse](s.buildFamilyPlaytime(familyID))
+func (s *DevService) GetFamilyPlaytimeRawModel(familyID string, opts ...option.RequestOption) (models.FamilyGroupPlaytimeSummaryResponse, error) {
+	return s.GetFamilyPlaytimeRawModelCtx(context.Background(), familyID, opts...)
+}
+
+// GetFamilyPlaytimeRawModelCtx is the context-aware variant of GetFamilyPlaytimeRawModel
+func (s *DevService) GetFamilyPlaytimeRawModelCtx(ctx context.Context, familyID string, opts ...option.RequestOption) (models.FamilyGroupPlaytimeSummaryResponse, error) {
+	c, method, reqPath, params := s.buildFamilyPlaytime(familyID)
+	return api.GetRawModelCtx[models.FamilyGroupPlaytimeSummaryResponse](ctx, c, method, reqPath, params, opts...)
 }
 
 // GetSharedAppsRawModel return family shared apps. 返回家庭组共享的游戏.
-func (s *DevService) GetSharedAppsRawModel(familyID string) (models.FamilySharedLibraryResponse, error) {
-	return api.GetRawModel[models.FamilySharedLibraryResponse](s.buildSharedApps(familyID))
+func (s *DevService) GetSharedAppsRawModel(familyID string, opts ...option.RequestOption) (models.FamilySharedLibraryResponse, error) {
+	return s.GetSharedAppsRawModelCtx(context.Background(), familyID, opts...)
+}
+
+// GetSharedAppsRawModelCtx is the context-aware variant of GetSharedAppsRawModel
+func (s *DevService) GetSharedAppsRawModelCtx(ctx context.Context, familyID string, opts ...option.RequestOption) (models.FamilySharedLibraryResponse, error) {
+	c, method, reqPath, params := s.buildSharedApps(familyID)
+	return api.GetRawModelCtx[models.FamilySharedLibraryResponse](ctx, c, method, reqPath, params, opts...)
 }
 
 // ============================ Brief Model 精简模型接口 ============================
 
 // GetFamilyChangeLogBrief return game brief info. 返回入参对应游戏的简略信息.
-func (s *DevService) GetFamilyChangeLogBrief(familyID string) ([]models.FamilyGroupChange, error) {
-	rawLog, err := s.GetFamilyChangeLogRawModel(familyID)
+func (s *DevService) GetFamilyChangeLogBrief(familyID string, opts ...option.RequestOption) ([]models.FamilyGroupChange, error) {
+	return s.GetFamilyChangeLogBriefCtx(context.Background(), familyID, opts...)
+}
+
+// GetFamilyChangeLogBriefCtx is the context-aware variant of GetFamilyChangeLogBrief
+func (s *DevService) GetFamilyChangeLogBriefCtx(ctx context.Context, familyID string, opts ...option.RequestOption) ([]models.FamilyGroupChange, error) {
+	rawLog, err := s.GetFamilyChangeLogRawModelCtx(ctx, familyID, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -81,8 +147,13 @@ func (s *DevService) GetFamilyChangeLogBrief(familyID string) ([]models.FamilyGr
 }
 
 // GetFamilyPlaytimeBrief return family playtime. 返回家庭组游玩记录信息.
-func (s *DevService) GetFamilyPlaytimeBrief(familyID string) ([]models.FamilyGroupPlaytimeBrief, error) {
-	rawPlaytime, err := s.GetFamilyPlaytimeRawModel(familyID)
+func (s *DevService) GetFamilyPlaytimeBrief(familyID string, opts ...option.RequestOption) ([]models.FamilyGroupPlaytimeBrief, error) {
+	return s.GetFamilyPlaytimeBriefCtx(context.Background(), familyID, opts...)
+}
+
+// GetFamilyPlaytimeBriefCtx is the context-aware variant of GetFamilyPlaytimeBrief
+func (s *DevService) GetFamilyPlaytimeBriefCtx(ctx context.Context, familyID string, opts ...option.RequestOption) ([]models.FamilyGroupPlaytimeBrief, error) {
+	rawPlaytime, err := s.GetFamilyPlaytimeRawModelCtx(ctx, familyID, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -104,21 +175,27 @@ func (s *DevService) GetFamilyPlaytimeBrief(familyID string) ([]models.FamilyGro
 }
 
 // GetSharedAppsBrief return family shared apps. 返回家庭组共享的游戏.
-func (s *DevService) GetSharedAppsBrief(familyID string) (models.FamilySharedLibraryAppBrief, error) {
-	rawShared, err := s.GetSharedAppsRawModel(familyID)
+func (s *DevService) GetSharedAppsBrief(familyID string, opts ...option.RequestOption) (models.FamilySharedLibraryAppBrief, error) {
+	return s.GetSharedAppsBriefCtx(context.Background(), familyID, opts...)
+}
+
+// GetSharedAppsBriefCtx is the context-aware variant of GetSharedAppsBrief
+func (s *DevService) GetSharedAppsBriefCtx(ctx context.Context, familyID string, opts ...option.RequestOption) (models.FamilySharedLibraryAppBrief, error) {
+	rawShared, err := s.GetSharedAppsRawModelCtx(ctx, familyID, opts...)
 	if err != nil {
 		return models.FamilySharedLibraryAppBrief{}, err
 	}
 
 	// 转换为精简模型 | Convert to simplified model
 	apps := make([]models.SharedAppBrief, 0, len(rawShared.Response.Apps))
+	endpoints := s.client.Endpoints()
 	for _, a := range rawShared.Response.Apps {
 		app := models.SharedAppBrief{
 			AppID:          a.AppID,
 			OwnerSteamIDs:  a.OwnerSteamIDs,
 			Name:           a.Name,
-			Cover:          fmt.Sprintf(util.STEAM_CAPSULE_URL, a.AppID),
-			Icon:           fmt.Sprintf(util.STEAM_ICON_URL, a.AppID, a.ImgIconHash),
+			Cover:          endpoints.CapsuleURL(a.AppID),
+			Icon:           endpoints.IconURL(a.AppID, a.ImgIconHash),
 			ExcludeReason:  a.ExcludeReason,
 			RtTimeAcquired: util.TimeUnix2String(a.RtTimeAcquired),
 			RtLastPlayed:   util.TimeUnix2String(a.RtLastPlayed),
@@ -139,13 +216,23 @@ func (s *DevService) GetSharedAppsBrief(familyID string) (models.FamilySharedLib
 // ============================ Default Interface 默认接口 ============================
 
 // GetFamilyChangeLog return game brief info. 返回入参对应游戏的简略信息.
-func (s *DevService) GetFamilyChangeLog(familyID string) ([]models.FamilyGroupChange, error) {
-	return s.GetFamilyChangeLogBrief(familyID)
+func (s *DevService) GetFamilyChangeLog(familyID string, opts ...option.RequestOption) ([]models.FamilyGroupChange, error) {
+	return s.GetFamilyChangeLogBrief(familyID, opts...)
+}
+
+// GetFamilyChangeLogCtx is the context-aware variant of GetFamilyChangeLog
+func (s *DevService) GetFamilyChangeLogCtx(ctx context.Context, familyID string, opts ...option.RequestOption) ([]models.FamilyGroupChange, error) {
+	return s.GetFamilyChangeLogBriefCtx(ctx, familyID, opts...)
 }
 
 // GetFamilyMembers return family info. 返回家庭组信息.
-func (s *DevService) GetFamilyMembers(familyID string) (models.FamilyGroup, error) {
-	member, err := s.GetFamilyMembersRawModel(familyID)
+func (s *DevService) GetFamilyMembers(familyID string, opts ...option.RequestOption) (models.FamilyGroup, error) {
+	return s.GetFamilyMembersCtx(context.Background(), familyID, opts...)
+}
+
+// GetFamilyMembersCtx is the context-aware variant of GetFamilyMembers
+func (s *DevService) GetFamilyMembersCtx(ctx context.Context, familyID string, opts ...option.RequestOption) (models.FamilyGroup, error) {
+	member, err := s.GetFamilyMembersRawModelCtx(ctx, familyID, opts...)
 	if err != nil {
 		return models.FamilyGroup{}, err
 	}
@@ -153,18 +240,33 @@ func (s *DevService) GetFamilyMembers(familyID string) (models.FamilyGroup, erro
 }
 
 // GetFamilyGroup return family group info by user. 返回当前access token用户的家庭组详细信息.
-func (s *DevService) GetFamilyGroup(familyID string, included bool) (models.FamilyGroupForUserResponse, error) {
-	return s.GetFamilyGroupRawModel(familyID, included)
+func (s *DevService) GetFamilyGroup(familyID string, included bool, opts ...option.RequestOption) (models.FamilyGroupForUserResponse, error) {
+	return s.GetFamilyGroupRawModel(familyID, included, opts...)
+}
+
+// GetFamilyGroupCtx is the context-aware variant of GetFamilyGroup
+func (s *DevService) GetFamilyGroupCtx(ctx context.Context, familyID string, included bool, opts ...option.RequestOption) (models.FamilyGroupForUserResponse, error) {
+	return s.GetFamilyGroupRawModelCtx(ctx, familyID, included, opts...)
 }
 
 // GetFamilyPlaytime return family playtime. 返回家庭组游玩记录信息.
-func (s *DevService) GetFamilyPlaytime(familyID string) ([]models.FamilyGroupPlaytimeBrief, error) {
-	return s.GetFamilyPlaytimeBrief(familyID)
+func (s *DevService) GetFamilyPlaytime(familyID string, opts ...option.RequestOption) ([]models.FamilyGroupPlaytimeBrief, error) {
+	return s.GetFamilyPlaytimeBrief(familyID, opts...)
+}
+
+// GetFamilyPlaytimeCtx is the context-aware variant of GetFamilyPlaytime
+func (s *DevService) GetFamilyPlaytimeCtx(ctx context.Context, familyID string, opts ...option.RequestOption) ([]models.FamilyGroupPlaytimeBrief, error) {
+	return s.GetFamilyPlaytimeBriefCtx(ctx, familyID, opts...)
 }
 
 // GetSharedApps return family shared apps. 返回家庭组共享的游戏.
-func (s *DevService) GetSharedApps(familyID string) (models.FamilySharedLibraryAppBrief, error) {
-	return s.GetSharedAppsBrief(familyID)
+func (s *DevService) GetSharedApps(familyID string, opts ...option.RequestOption) (models.FamilySharedLibraryAppBrief, error) {
+	return s.GetSharedAppsBrief(familyID, opts...)
+}
+
+// GetSharedAppsCtx is the context-aware variant of GetSharedApps
+func (s *DevService) GetSharedAppsCtx(ctx context.Context, familyID string, opts ...option.RequestOption) (models.FamilySharedLibraryAppBrief, error) {
+	return s.GetSharedAppsBriefCtx(ctx, familyID, opts...)
 }
 
 // ============================ Build 构造入参 ============================
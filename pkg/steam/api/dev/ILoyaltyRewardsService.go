@@ -1,12 +1,13 @@
 package dev
 
 import (
-	"fmt"
+	"context"
 	"net/url"
 
 	"github.com/GoFurry/gf-steam-sdk/internal/api"
 	"github.com/GoFurry/gf-steam-sdk/internal/client"
 	"github.com/GoFurry/gf-steam-sdk/pkg/models"
+	"github.com/GoFurry/gf-steam-sdk/pkg/option"
 	"github.com/GoFurry/gf-steam-sdk/pkg/util"
 )
 
@@ -18,42 +19,83 @@ const (
 // ============================ Raw Bytes 原始字节流接口 ============================
 
 // GetEquippedProfileItemsRawBytes 返回已装备个人资料道具的原始字节流
-func (s *DevService) GetEquippedProfileItemsRawBytes(steamID string, language *string) (respBytes []byte, err error) {
-	return api.GetRawBytes(s.buildEquippedProfileItems(steamID, language))
+func (s *DevService) GetEquippedProfileItemsRawBytes(steamID string, language *string, opts ...option.RequestOption) (respBytes []byte, err error) {
+	return s.GetEquippedProfileItemsRawBytesCtx(context.Background(), steamID, language, opts...)
+}
+
+// GetEquippedProfileItemsRawBytesCtx is the context-aware variant of GetEquippedProfileItemsRawBytes
+func (s *DevService) GetEquippedProfileItemsRawBytesCtx(ctx context.Context, steamID string, language *string, opts ...option.RequestOption) (respBytes []byte, err error) {
+	c, method, reqPath, params := s.buildEquippedProfileItems(steamID, language)
+	return api.GetRawBytesCtx(ctx, c, method, reqPath, params, opts...)
 }
 
 // GetReactionsSummaryForUserRawBytes 返回用户互动汇总的原始字节流
-func (s *DevService) GetReactionsSummaryForUserRawBytes(steamID string) (respBytes []byte, err error) {
-	return api.GetRawBytes(s.buildReactionsSummaryForUser(steamID))
+func (s *DevService) GetReactionsSummaryForUserRawBytes(steamID string, opts ...option.RequestOption) (respBytes []byte, err error) {
+	return s.GetReactionsSummaryForUserRawBytesCtx(context.Background(), steamID, opts...)
+}
+
+// GetReactionsSummaryForUserRawBytesCtx is the context-aware variant of GetReactionsSummaryForUserRawBytes
+func (s *DevService) GetReactionsSummaryForUserRawBytesCtx(ctx context.Context, steamID string, opts ...option.RequestOption) (respBytes []byte, err error) {
+	c, method, reqPath, params := s.buildReactionsSummaryForUser(steamID)
+	return api.GetRawBytesCtx(ctx, c, method, reqPath, params, opts...)
 }
 
 // GetLoyaltyRewardsSummaryRawBytes 返回点数汇总的原始字节流
-func (s *DevService) GetLoyaltyRewardsSummaryRawBytes(steamID string) (respBytes []byte, err error) {
-	return api.GetRawBytes(s.buildLoyaltyRewardsSummary(steamID))
+func (s *DevService) GetLoyaltyRewardsSummaryRawBytes(steamID string, opts ...option.RequestOption) (respBytes []byte, err error) {
+	return s.GetLoyaltyRewardsSummaryRawBytesCtx(context.Background(), steamID, opts...)
+}
+
+// GetLoyaltyRewardsSummaryRawBytesCtx is the context-aware variant of GetLoyaltyRewardsSummaryRawBytes
+func (s *DevService) GetLoyaltyRewardsSummaryRawBytesCtx(ctx context.Context, steamID string, opts ...option.RequestOption) (respBytes []byte, err error) {
+	c, method, reqPath, params := s.buildLoyaltyRewardsSummary(steamID)
+	return api.GetRawBytesCtx(ctx, c, method, reqPath, params, opts...)
 }
 
 // ============================ Structed Raw Model 结构化原始模型接口 ============================
 
 // GetEquippedProfileItemsRawModel 返回已装备个人资料道具的原始结构化模型
-func (s *DevService) GetEquippedProfileItemsRawModel(steamID string, language *string) (models.GetEquippedProfileItemsResponse, error) {
-	return api.GetRawModel[models.GetEquippedProfileItemsResponse](s.buildEquippedProfileItems(steamID, language))
+func (s *DevService) GetEquippedProfileItemsRawModel(steamID string, language *string, opts ...option.RequestOption) (models.GetEquippedProfileItemsResponse, error) {
+	return s.GetEquippedProfileItemsRawModelCtx(context.Background(), steamID, language, opts...)
+}
+
+// GetEquippedProfileItemsRawModelCtx is the context-aware variant of GetEquippedProfileItemsRawModel
+func (s *DevService) GetEquippedProfileItemsRawModelCtx(ctx context.Context, steamID string, language *string, opts ...option.RequestOption) (models.GetEquippedProfileItemsResponse, error) {
+	c, method, reqPath, params := s.buildEquippedProfileItems(steamID, language)
+	return api.GetRawModelCtx[models.GetEquippedProfileItemsResponse](ctx, c, method, reqPath, params, opts...)
 }
 
 // GetReactionsSummaryForUserRawModel 返回用户互动汇总的原始结构化模型
-func (s *DevService) GetReactionsSummaryForUserRawModel(steamID string) (models.GetReactionsSummaryForUserResponse, error) {
-	return api.GetRawModel[models.GetReactionsSummaryForUserResponse](s.buildReactionsSummaryForUser(steamID))
+func (s *DevService) GetReactionsSummaryForUserRawModel(steamID string, opts ...option.RequestOption) (models.GetReactionsSummaryForUserResponse, error) {
+	return s.GetReactionsSummaryForUserRawModelCtx(context.Background(), steamID, opts...)
+}
+
+// GetReactionsSummaryForUserRawModelCtx is the context-aware variant of GetReactionsSummaryForUserRawModel
+func (s *DevService) GetReactionsSummaryForUserRawModelCtx(ctx context.Context, steamID string, opts ...option.RequestOption) (models.GetReactionsSummaryForUserResponse, error) {
+	c, method, reqPath, params := s.buildReactionsSummaryForUser(steamID)
+	return api.GetRawModelCtx[models.GetReactionsSummaryForUserResponse](ctx, c, method, reqPath, params, opts...)
 }
 
 // GetLoyaltyRewardsSummaryRawModel 返回点数汇总的原始结构化模型
-func (s *DevService) GetLoyaltyRewardsSummaryRawModel(steamID string) (models.GetLoyaltyRewardsSummaryResponse, error) {
-	return api.GetRawModel[models.GetLoyaltyRewardsSummaryResponse](s.buildLoyaltyRewardsSummary(steamID))
+func (s *DevService) GetLoyaltyRewardsSummaryRawModel(steamID string, opts ...option.RequestOption) (models.GetLoyaltyRewardsSummaryResponse, error) {
+	return s.GetLoyaltyRewardsSummaryRawModelCtx(context.Background(), steamID, opts...)
+}
+
+// GetLoyaltyRewardsSummaryRawModelCtx is the context-aware variant of GetLoyaltyRewardsSummaryRawModel
+func (s *DevService) GetLoyaltyRewardsSummaryRawModelCtx(ctx context.Context, steamID string, opts ...option.RequestOption) (models.GetLoyaltyRewardsSummaryResponse, error) {
+	c, method, reqPath, params := s.buildLoyaltyRewardsSummary(steamID)
+	return api.GetRawModelCtx[models.GetLoyaltyRewardsSummaryResponse](ctx, c, method, reqPath, params, opts...)
 }
 
 // ============================ Brief Model 精简模型接口 ============================
 
 // GetEquippedProfileItemsBrief 返回已装备个人资料道具的精简信息列表
-func (s *DevService) GetEquippedProfileItemsBrief(steamID string, language *string) ([]models.ProfileItemBriefInfo, error) {
-	rawResp, err := s.GetEquippedProfileItemsRawModel(steamID, language)
+func (s *DevService) GetEquippedProfileItemsBrief(steamID string, language *string, opts ...option.RequestOption) ([]models.ProfileItemBriefInfo, error) {
+	return s.GetEquippedProfileItemsBriefCtx(context.Background(), steamID, language, opts...)
+}
+
+// GetEquippedProfileItemsBriefCtx is the context-aware variant of GetEquippedProfileItemsBrief
+func (s *DevService) GetEquippedProfileItemsBriefCtx(ctx context.Context, steamID string, language *string, opts ...option.RequestOption) ([]models.ProfileItemBriefInfo, error) {
+	rawResp, err := s.GetEquippedProfileItemsRawModelCtx(ctx, steamID, language, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -66,8 +108,13 @@ func (s *DevService) GetEquippedProfileItemsBrief(steamID string, language *stri
 }
 
 // GetReactionsSummaryForUserBrief 返回用户互动汇总的精简信息
-func (s *DevService) GetReactionsSummaryForUserBrief(steamID string) (models.UserReactionsTotalBrief, error) {
-	rawResp, err := s.GetReactionsSummaryForUserRawModel(steamID)
+func (s *DevService) GetReactionsSummaryForUserBrief(steamID string, opts ...option.RequestOption) (models.UserReactionsTotalBrief, error) {
+	return s.GetReactionsSummaryForUserBriefCtx(context.Background(), steamID, opts...)
+}
+
+// GetReactionsSummaryForUserBriefCtx is the context-aware variant of GetReactionsSummaryForUserBrief
+func (s *DevService) GetReactionsSummaryForUserBriefCtx(ctx context.Context, steamID string, opts ...option.RequestOption) (models.UserReactionsTotalBrief, error) {
+	rawResp, err := s.GetReactionsSummaryForUserRawModelCtx(ctx, steamID, opts...)
 	if err != nil {
 		return models.UserReactionsTotalBrief{}, err
 	}
@@ -88,8 +135,13 @@ func (s *DevService) GetReactionsSummaryForUserBrief(steamID string) (models.Use
 }
 
 // GetLoyaltyRewardsSummaryBrief 返回点数汇总的精简信息
-func (s *DevService) GetLoyaltyRewardsSummaryBrief(steamID string) (models.LoyaltyRewardsSummaryBriefInfo, error) {
-	rawResp, err := s.GetLoyaltyRewardsSummaryRawModel(steamID)
+func (s *DevService) GetLoyaltyRewardsSummaryBrief(steamID string, opts ...option.RequestOption) (models.LoyaltyRewardsSummaryBriefInfo, error) {
+	return s.GetLoyaltyRewardsSummaryBriefCtx(context.Background(), steamID, opts...)
+}
+
+// GetLoyaltyRewardsSummaryBriefCtx is the context-aware variant of GetLoyaltyRewardsSummaryBrief
+func (s *DevService) GetLoyaltyRewardsSummaryBriefCtx(ctx context.Context, steamID string, opts ...option.RequestOption) (models.LoyaltyRewardsSummaryBriefInfo, error) {
+	rawResp, err := s.GetLoyaltyRewardsSummaryRawModelCtx(ctx, steamID, opts...)
 	if err != nil {
 		return models.LoyaltyRewardsSummaryBriefInfo{}, err
 	}
@@ -107,18 +159,33 @@ func (s *DevService) GetLoyaltyRewardsSummaryBrief(steamID string) (models.Loyal
 // ============================ Default Interface 默认接口 ============================
 
 // GetEquippedProfileItems 返回已装备个人资料道具的精简信息
-func (s *DevService) GetEquippedProfileItems(steamID string, language *string) ([]models.ProfileItemBriefInfo, error) {
-	return s.GetEquippedProfileItemsBrief(steamID, language)
+func (s *DevService) GetEquippedProfileItems(steamID string, language *string, opts ...option.RequestOption) ([]models.ProfileItemBriefInfo, error) {
+	return s.GetEquippedProfileItemsBrief(steamID, language, opts...)
+}
+
+// GetEquippedProfileItemsCtx is the context-aware variant of GetEquippedProfileItems
+func (s *DevService) GetEquippedProfileItemsCtx(ctx context.Context, steamID string, language *string, opts ...option.RequestOption) ([]models.ProfileItemBriefInfo, error) {
+	return s.GetEquippedProfileItemsBriefCtx(ctx, steamID, language, opts...)
 }
 
 // GetReactionsSummaryForUser 返回用户互动汇总的精简信息
-func (s *DevService) GetReactionsSummaryForUser(steamID string) (models.UserReactionsTotalBrief, error) {
-	return s.GetReactionsSummaryForUserBrief(steamID)
+func (s *DevService) GetReactionsSummaryForUser(steamID string, opts ...option.RequestOption) (models.UserReactionsTotalBrief, error) {
+	return s.GetReactionsSummaryForUserBrief(steamID, opts...)
+}
+
+// GetReactionsSummaryForUserCtx is the context-aware variant of GetReactionsSummaryForUser
+func (s *DevService) GetReactionsSummaryForUserCtx(ctx context.Context, steamID string, opts ...option.RequestOption) (models.UserReactionsTotalBrief, error) {
+	return s.GetReactionsSummaryForUserBriefCtx(ctx, steamID, opts...)
 }
 
 // GetLoyaltyRewardsSummary 返回点数汇总的精简信息
-func (s *DevService) GetLoyaltyRewardsSummary(steamID string) (models.LoyaltyRewardsSummaryBriefInfo, error) {
-	return s.GetLoyaltyRewardsSummaryBrief(steamID)
+func (s *DevService) GetLoyaltyRewardsSummary(steamID string, opts ...option.RequestOption) (models.LoyaltyRewardsSummaryBriefInfo, error) {
+	return s.GetLoyaltyRewardsSummaryBrief(steamID, opts...)
+}
+
+// GetLoyaltyRewardsSummaryCtx is the context-aware variant of GetLoyaltyRewardsSummary
+func (s *DevService) GetLoyaltyRewardsSummaryCtx(ctx context.Context, steamID string, opts ...option.RequestOption) (models.LoyaltyRewardsSummaryBriefInfo, error) {
+	return s.GetLoyaltyRewardsSummaryBriefCtx(ctx, steamID, opts...)
 }
 
 // ============================ 工具方法 ============================
@@ -126,6 +193,7 @@ func (s *DevService) GetLoyaltyRewardsSummary(steamID string) (models.LoyaltyRew
 // convertToBriefItems 转换原始道具定义为精简模型
 func (s *DevService) convertToBriefItems(rawItems []models.ProfileItemDefinition, isActive bool) []models.ProfileItemBriefInfo {
 	briefItems := make([]models.ProfileItemBriefInfo, 0, len(rawItems))
+	endpoints := s.client.Endpoints()
 	for _, item := range rawItems {
 		brief := models.ProfileItemBriefInfo{
 			ID:            item.DefID,
@@ -136,17 +204,17 @@ func (s *DevService) convertToBriefItems(rawItems []models.ProfileItemDefinition
 			PointCost:     item.PointCost,
 			IsActive:      isActive,
 			IsAnimated:    item.CommunityItemData.Animated,
-			LargeImageURL: util.STEAM_COMMUNITY_ASSETS_IMAGES_URL + util.Int642String(item.AppID) + "/" + item.CommunityItemData.ItemImageLarge,
+			LargeImageURL: endpoints.CommunityAssetURL(item.AppID, item.CommunityItemData.ItemImageLarge),
 		}
 		// 补充可选字段
 		if item.CommunityItemData.ItemImageSmall != "" {
-			brief.SmallImageURL = util.STEAM_COMMUNITY_ASSETS_IMAGES_URL + util.Int642String(item.AppID) + "/" + item.CommunityItemData.ItemImageSmall
+			brief.SmallImageURL = endpoints.CommunityAssetURL(item.AppID, item.CommunityItemData.ItemImageSmall)
 		}
 		if item.CommunityItemData.ItemMovieWebm != "" {
-			brief.WebmMovieURL = util.STEAM_COMMUNITY_ASSETS_IMAGES_URL + util.Int642String(item.AppID) + "/" + item.CommunityItemData.ItemMovieWebm
+			brief.WebmMovieURL = endpoints.CommunityAssetURL(item.AppID, item.CommunityItemData.ItemMovieWebm)
 		}
 		if item.CommunityItemData.ItemMovieMp4 != "" {
-			brief.Mp4MovieURL = util.STEAM_COMMUNITY_ASSETS_IMAGES_URL + util.Int642String(item.AppID) + "/" + item.CommunityItemData.ItemMovieMp4
+			brief.Mp4MovieURL = endpoints.CommunityAssetURL(item.AppID, item.CommunityItemData.ItemMovieMp4)
 		}
 		briefItems = append(briefItems, brief)
 	}
@@ -156,6 +224,7 @@ func (s *DevService) convertToBriefItems(rawItems []models.ProfileItemDefinition
 // convertToReactionBriefItems 转换原始互动汇总项为精简模型
 func (s *DevService) convertToReactionBriefItems(rawItems []models.ReactionSummaryItem) []models.ReactionSummaryBriefInfo {
 	briefItems := make([]models.ReactionSummaryBriefInfo, 0, len(rawItems))
+	endpoints := s.client.Endpoints()
 	for _, item := range rawItems {
 		brief := models.ReactionSummaryBriefInfo{
 			ReactionID:     item.ReactionID,
@@ -163,7 +232,7 @@ func (s *DevService) convertToReactionBriefItems(rawItems []models.ReactionSumma
 			Received:       item.Received,
 			PointsGiven:    item.PointsGiven,
 			PointsReceived: item.PointsReceived,
-			IconURL:        fmt.Sprintf("%s%d.png", util.STEAM_LOYALTY_REACTION_ICON_BASE_URL, item.ReactionID),
+			IconURL:        endpoints.ReactionIconURL(item.ReactionID),
 		}
 		briefItems = append(briefItems, brief)
 	}
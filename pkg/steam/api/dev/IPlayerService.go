@@ -1,26 +1,118 @@
 package dev
 
 import (
-	"fmt"
+	"context"
 	"net/url"
+	"strconv"
 
 	"github.com/GoFurry/gf-steam-sdk/internal/api"
 	"github.com/GoFurry/gf-steam-sdk/internal/client"
 	"github.com/GoFurry/gf-steam-sdk/pkg/models"
+	"github.com/GoFurry/gf-steam-sdk/pkg/option"
 	"github.com/GoFurry/gf-steam-sdk/pkg/util"
+	"github.com/GoFurry/gf-steam-sdk/pkg/util/errors"
 )
 
 const (
 	IPlayerService = util.STEAM_API_BASE_URL + "IPlayerService"
 )
 
+// OwnedGamesQuery GetOwnedGames 可选参数, 字段为 nil 时使用默认值
+// OwnedGamesQuery holds the optional GetOwnedGames params; nil fields use the defaults
+type OwnedGamesQuery struct {
+	IncludeAppInfo         *bool    // 包含游戏名称/图标(默认 true) | Include game name/icon (default true)
+	IncludePlayedFreeGames *bool    // 包含玩过的免费游戏(默认 false) | Include played free games (default false)
+	AppIDsFilter           []uint64 // 仅返回指定游戏(为空返回全部) | Only return these apps (all if empty)
+	SkipUnvettedApps       *bool    // 跳过未审核的应用(nil 使用 Steam 默认值) | Skip unvetted apps (nil for Steam default)
+}
+
 // ============================ Raw Bytes 原始字节流接口 ============================
 
 // GetOwnedGamesRawBytes get player's owned games 获取玩家已拥有的游戏
 //   - steamID: Player SteamID
 //   - includeFree: Whether to include free games
-func (s *DevService) GetOwnedGamesRawBytes(steamID string, includeFree bool) (respBytes []byte, err error) {
-	return api.GetRawBytes(s.buildOwnedGames(steamID, includeFree))
+func (s *DevService) GetOwnedGamesRawBytes(steamID string, includeFree bool, opts ...option.RequestOption) (respBytes []byte, err error) {
+	return s.GetOwnedGamesRawBytesCtx(context.Background(), steamID, includeFree, opts...)
+}
+
+// GetOwnedGamesRawBytesCtx is the context-aware variant of GetOwnedGamesRawBytes
+func (s *DevService) GetOwnedGamesRawBytesCtx(ctx context.Context, steamID string, includeFree bool, opts ...option.RequestOption) (respBytes []byte, err error) {
+	return s.GetOwnedGamesWithQueryRawBytesCtx(ctx, steamID, &OwnedGamesQuery{IncludePlayedFreeGames: &includeFree}, opts...)
+}
+
+// GetOwnedGamesWithQueryRawBytes get player's owned games with optional params 按可选参数获取玩家已拥有的游戏
+//   - steamID: Player SteamID
+//   - query: Optional params (nil for defaults)
+func (s *DevService) GetOwnedGamesWithQueryRawBytes(steamID string, query *OwnedGamesQuery, opts ...option.RequestOption) (respBytes []byte, err error) {
+	return s.GetOwnedGamesWithQueryRawBytesCtx(context.Background(), steamID, query, opts...)
+}
+
+// GetOwnedGamesWithQueryRawBytesCtx is the context-aware variant of GetOwnedGamesWithQueryRawBytes
+func (s *DevService) GetOwnedGamesWithQueryRawBytesCtx(ctx context.Context, steamID string, query *OwnedGamesQuery, opts ...option.RequestOption) (respBytes []byte, err error) {
+	c, method, reqPath, params := s.buildOwnedGames(steamID, query)
+	return api.GetRawBytesCtx(ctx, c, method, reqPath, params, opts...)
+}
+
+// GetRecentlyPlayedGamesRawBytes get games played in the last two weeks 获取玩家近2周游玩的游戏
+//   - steamID: Player SteamID
+//   - count: Max number of games (nil for all)
+func (s *DevService) GetRecentlyPlayedGamesRawBytes(steamID string, count *int, opts ...option.RequestOption) (respBytes []byte, err error) {
+	return s.GetRecentlyPlayedGamesRawBytesCtx(context.Background(), steamID, count, opts...)
+}
+
+// GetRecentlyPlayedGamesRawBytesCtx is the context-aware variant of GetRecentlyPlayedGamesRawBytes
+func (s *DevService) GetRecentlyPlayedGamesRawBytesCtx(ctx context.Context, steamID string, count *int, opts ...option.RequestOption) (respBytes []byte, err error) {
+	if steamID == "" {
+		return respBytes, errors.ErrInvalidSteamID
+	}
+	c, method, reqPath, params := s.buildRecentlyPlayedGames(steamID, count)
+	return api.GetRawBytesCtx(ctx, c, method, reqPath, params, opts...)
+}
+
+// GetSteamLevelRawBytes get player's Steam level 获取玩家 Steam 等级
+//   - steamID: Player SteamID
+func (s *DevService) GetSteamLevelRawBytes(steamID string, opts ...option.RequestOption) (respBytes []byte, err error) {
+	return s.GetSteamLevelRawBytesCtx(context.Background(), steamID, opts...)
+}
+
+// GetSteamLevelRawBytesCtx is the context-aware variant of GetSteamLevelRawBytes
+func (s *DevService) GetSteamLevelRawBytesCtx(ctx context.Context, steamID string, opts ...option.RequestOption) (respBytes []byte, err error) {
+	if steamID == "" {
+		return respBytes, errors.ErrInvalidSteamID
+	}
+	c, method, reqPath, params := s.buildSteamLevel(steamID)
+	return api.GetRawBytesCtx(ctx, c, method, reqPath, params, opts...)
+}
+
+// GetBadgesRawBytes get player's badges, level and XP 获取玩家徽章、等级与经验值
+//   - steamID: Player SteamID
+func (s *DevService) GetBadgesRawBytes(steamID string, opts ...option.RequestOption) (respBytes []byte, err error) {
+	return s.GetBadgesRawBytesCtx(context.Background(), steamID, opts...)
+}
+
+// GetBadgesRawBytesCtx is the context-aware variant of GetBadgesRawBytes
+func (s *DevService) GetBadgesRawBytesCtx(ctx context.Context, steamID string, opts ...option.RequestOption) (respBytes []byte, err error) {
+	if steamID == "" {
+		return respBytes, errors.ErrInvalidSteamID
+	}
+	c, method, reqPath, params := s.buildBadges(steamID)
+	return api.GetRawBytesCtx(ctx, c, method, reqPath, params, opts...)
+}
+
+// GetCommunityBadgeProgressRawBytes get player's community badge quest progress 获取玩家社区徽章任务进度
+//   - steamID: Player SteamID
+//   - badgeID: Community badge ID (nil for the Steam Community badge)
+func (s *DevService) GetCommunityBadgeProgressRawBytes(steamID string, badgeID *int, opts ...option.RequestOption) (respBytes []byte, err error) {
+	return s.GetCommunityBadgeProgressRawBytesCtx(context.Background(), steamID, badgeID, opts...)
+}
+
+// GetCommunityBadgeProgressRawBytesCtx is the context-aware variant of GetCommunityBadgeProgressRawBytes
+func (s *DevService) GetCommunityBadgeProgressRawBytesCtx(ctx context.Context, steamID string, badgeID *int, opts ...option.RequestOption) (respBytes []byte, err error) {
+	if steamID == "" {
+		return respBytes, errors.ErrInvalidSteamID
+	}
+	c, method, reqPath, params := s.buildCommunityBadgeProgress(steamID, badgeID)
+	return api.GetRawBytesCtx(ctx, c, method, reqPath, params, opts...)
 }
 
 // ============================ Structed Raw Model 结构化原始模型接口 ============================
@@ -28,8 +120,91 @@ func (s *DevService) GetOwnedGamesRawBytes(steamID string, includeFree bool) (re
 // GetOwnedGamesRawModel get player's owned games 获取玩家已拥有的游戏
 //   - steamID: Player SteamID
 //   - includeFree: Whether to include free games
-func (s *DevService) GetOwnedGamesRawModel(steamID string, includeFree bool) (models.SteamOwnedGamesResponse, error) {
-	return api.GetRawModel[models.SteamOwnedGamesResponse](s.buildOwnedGames(steamID, includeFree))
+func (s *DevService) GetOwnedGamesRawModel(steamID string, includeFree bool, opts ...option.RequestOption) (models.SteamOwnedGamesResponse, error) {
+	return s.GetOwnedGamesRawModelCtx(context.Background(), steamID, includeFree, opts...)
+}
+
+// GetOwnedGamesRawModelCtx is the context-aware variant of GetOwnedGamesRawModel
+func (s *DevService) GetOwnedGamesRawModelCtx(ctx context.Context, steamID string, includeFree bool, opts ...option.RequestOption) (models.SteamOwnedGamesResponse, error) {
+	return s.GetOwnedGamesWithQueryRawModelCtx(ctx, steamID, &OwnedGamesQuery{IncludePlayedFreeGames: &includeFree}, opts...)
+}
+
+// GetOwnedGamesWithQueryRawModel get player's owned games with optional params 按可选参数获取玩家已拥有的游戏
+//   - steamID: Player SteamID
+//   - query: Optional params (nil for defaults)
+func (s *DevService) GetOwnedGamesWithQueryRawModel(steamID string, query *OwnedGamesQuery, opts ...option.RequestOption) (models.SteamOwnedGamesResponse, error) {
+	return s.GetOwnedGamesWithQueryRawModelCtx(context.Background(), steamID, query, opts...)
+}
+
+// GetOwnedGamesWithQueryRawModelCtx is the context-aware variant of GetOwnedGamesWithQueryRawModel
+func (s *DevService) GetOwnedGamesWithQueryRawModelCtx(ctx context.Context, steamID string, query *OwnedGamesQuery, opts ...option.RequestOption) (models.SteamOwnedGamesResponse, error) {
+	c, method, reqPath, params := s.buildOwnedGames(steamID, query)
+	return api.GetRawModelCtx[models.SteamOwnedGamesResponse](ctx, c, method, reqPath, params, opts...)
+}
+
+// GetRecentlyPlayedGamesRawModel get games played in the last two weeks 获取玩家近2周游玩的游戏
+//   - steamID: Player SteamID
+//   - count: Max number of games (nil for all)
+func (s *DevService) GetRecentlyPlayedGamesRawModel(steamID string, count *int, opts ...option.RequestOption) (models.SteamRecentlyPlayedGamesResponse, error) {
+	return s.GetRecentlyPlayedGamesRawModelCtx(context.Background(), steamID, count, opts...)
+}
+
+// GetRecentlyPlayedGamesRawModelCtx is the context-aware variant of GetRecentlyPlayedGamesRawModel
+func (s *DevService) GetRecentlyPlayedGamesRawModelCtx(ctx context.Context, steamID string, count *int, opts ...option.RequestOption) (models.SteamRecentlyPlayedGamesResponse, error) {
+	if steamID == "" {
+		return models.SteamRecentlyPlayedGamesResponse{}, errors.ErrInvalidSteamID
+	}
+	c, method, reqPath, params := s.buildRecentlyPlayedGames(steamID, count)
+	return api.GetRawModelCtx[models.SteamRecentlyPlayedGamesResponse](ctx, c, method, reqPath, params, opts...)
+}
+
+// GetSteamLevelRawModel get player's Steam level 获取玩家 Steam 等级
+// 资料未公开时返回 errors.ErrPrivateProfile | Fails with errors.ErrPrivateProfile for private profiles
+//   - steamID: Player SteamID
+func (s *DevService) GetSteamLevelRawModel(steamID string, opts ...option.RequestOption) (models.SteamLevelResponse, error) {
+	return s.GetSteamLevelRawModelCtx(context.Background(), steamID, opts...)
+}
+
+// GetSteamLevelRawModelCtx is the context-aware variant of GetSteamLevelRawModel
+func (s *DevService) GetSteamLevelRawModelCtx(ctx context.Context, steamID string, opts ...option.RequestOption) (models.SteamLevelResponse, error) {
+	if steamID == "" {
+		return models.SteamLevelResponse{}, errors.ErrInvalidSteamID
+	}
+	c, method, reqPath, params := s.buildSteamLevel(steamID)
+	return api.GetRawModelCtx[models.SteamLevelResponse](ctx, c, method, reqPath, params, opts...)
+}
+
+// GetBadgesRawModel get player's badges, level and XP 获取玩家徽章、等级与经验值
+// 资料未公开时返回 errors.ErrPrivateProfile | Fails with errors.ErrPrivateProfile for private profiles
+//   - steamID: Player SteamID
+func (s *DevService) GetBadgesRawModel(steamID string, opts ...option.RequestOption) (models.SteamBadgesResponse, error) {
+	return s.GetBadgesRawModelCtx(context.Background(), steamID, opts...)
+}
+
+// GetBadgesRawModelCtx is the context-aware variant of GetBadgesRawModel
+func (s *DevService) GetBadgesRawModelCtx(ctx context.Context, steamID string, opts ...option.RequestOption) (models.SteamBadgesResponse, error) {
+	if steamID == "" {
+		return models.SteamBadgesResponse{}, errors.ErrInvalidSteamID
+	}
+	c, method, reqPath, params := s.buildBadges(steamID)
+	return api.GetRawModelCtx[models.SteamBadgesResponse](ctx, c, method, reqPath, params, opts...)
+}
+
+// GetCommunityBadgeProgressRawModel get player's community badge quest progress 获取玩家社区徽章任务进度
+// 资料未公开时返回 errors.ErrPrivateProfile | Fails with errors.ErrPrivateProfile for private profiles
+//   - steamID: Player SteamID
+//   - badgeID: Community badge ID (nil for the Steam Community badge)
+func (s *DevService) GetCommunityBadgeProgressRawModel(steamID string, badgeID *int, opts ...option.RequestOption) (models.SteamCommunityBadgeProgressResponse, error) {
+	return s.GetCommunityBadgeProgressRawModelCtx(context.Background(), steamID, badgeID, opts...)
+}
+
+// GetCommunityBadgeProgressRawModelCtx is the context-aware variant of GetCommunityBadgeProgressRawModel
+func (s *DevService) GetCommunityBadgeProgressRawModelCtx(ctx context.Context, steamID string, badgeID *int, opts ...option.RequestOption) (models.SteamCommunityBadgeProgressResponse, error) {
+	if steamID == "" {
+		return models.SteamCommunityBadgeProgressResponse{}, errors.ErrInvalidSteamID
+	}
+	c, method, reqPath, params := s.buildCommunityBadgeProgress(steamID, badgeID)
+	return api.GetRawModelCtx[models.SteamCommunityBadgeProgressResponse](ctx, c, method, reqPath, params, opts...)
 }
 
 // ============================ Brief Model 精简模型接口 ============================
@@ -37,23 +212,41 @@ func (s *DevService) GetOwnedGamesRawModel(steamID string, includeFree bool) (mo
 // GetOwnedGamesBrief get player's owned games 获取玩家已拥有的游戏
 //   - steamID: Player SteamID
 //   - includeFree: Whether to include free games
-func (s *DevService) GetOwnedGamesBrief(steamID string, includeFree bool) ([]models.OwnedGame, error) {
+func (s *DevService) GetOwnedGamesBrief(steamID string, includeFree bool, opts ...option.RequestOption) ([]models.OwnedGame, error) {
+	return s.GetOwnedGamesBriefCtx(context.Background(), steamID, includeFree, opts...)
+}
+
+// GetOwnedGamesBriefCtx is the context-aware variant of GetOwnedGamesBrief
+func (s *DevService) GetOwnedGamesBriefCtx(ctx context.Context, steamID string, includeFree bool, opts ...option.RequestOption) ([]models.OwnedGame, error) {
+	return s.GetOwnedGamesWithQueryBriefCtx(ctx, steamID, &OwnedGamesQuery{IncludePlayedFreeGames: &includeFree}, opts...)
+}
+
+// GetOwnedGamesWithQueryBrief get player's owned games with optional params 按可选参数获取玩家已拥有的游戏
+//   - steamID: Player SteamID
+//   - query: Optional params (nil for defaults)
+func (s *DevService) GetOwnedGamesWithQueryBrief(steamID string, query *OwnedGamesQuery, opts ...option.RequestOption) ([]models.OwnedGame, error) {
+	return s.GetOwnedGamesWithQueryBriefCtx(context.Background(), steamID, query, opts...)
+}
+
+// GetOwnedGamesWithQueryBriefCtx is the context-aware variant of GetOwnedGamesWithQueryBrief
+func (s *DevService) GetOwnedGamesWithQueryBriefCtx(ctx context.Context, steamID string, query *OwnedGamesQuery, opts ...option.RequestOption) ([]models.OwnedGame, error) {
 	// 获取原始结构化模型 | Get raw structured model
-	rawGames, err := s.GetOwnedGamesRawModel(steamID, includeFree)
+	rawGames, err := s.GetOwnedGamesWithQueryRawModelCtx(ctx, steamID, query, opts...)
 	if err != nil {
 		return nil, err
 	}
 
 	// 转换为精简模型 | Convert to simplified model
 	games := make([]models.OwnedGame, 0, len(rawGames.Response.Games))
+	endpoints := s.client.Endpoints()
 	for _, g := range rawGames.Response.Games {
 		game := models.OwnedGame{
 			AppID:                  g.AppID,
 			Name:                   g.Name,
 			PlaytimeForever:        g.PlaytimeForever,
 			Playtime2Weeks:         g.Playtime2Weeks,
-			IconURL:                fmt.Sprintf(util.STEAM_ICON_URL, g.AppID, g.ImgIconURL), // 拼接图标URL | Splice icon URL
-			CapsuleURL:             fmt.Sprintf(util.STEAM_CAPSULE_URL, g.AppID),            // 拼接封面URL | Splice capsule URL
+			IconURL:                endpoints.IconURL(g.AppID, g.ImgIconURL), // 拼接图标URL | Splice icon URL
+			CapsuleURL:             endpoints.CapsuleURL(g.AppID),            // 拼接封面URL | Splice capsule URL
 			LastPlayedTime:         g.RTimeLastPlayed,
 			LastPlayedTimeStr:      util.TimeUnix2String(g.RTimeLastPlayed), // 格式化最后游玩时间 | Format last played time
 			HasCommunityVisible:    g.HasCommunityVisible,
@@ -67,28 +260,258 @@ func (s *DevService) GetOwnedGamesBrief(steamID string, includeFree bool) ([]mod
 	return games, nil
 }
 
+// GetRecentlyPlayedGamesBrief get games played in the last two weeks 获取玩家近2周游玩的游戏
+//   - steamID: Player SteamID
+//   - count: Max number of games (nil for all)
+func (s *DevService) GetRecentlyPlayedGamesBrief(steamID string, count *int, opts ...option.RequestOption) ([]models.RecentGame, error) {
+	return s.GetRecentlyPlayedGamesBriefCtx(context.Background(), steamID, count, opts...)
+}
+
+// GetRecentlyPlayedGamesBriefCtx is the context-aware variant of GetRecentlyPlayedGamesBrief
+func (s *DevService) GetRecentlyPlayedGamesBriefCtx(ctx context.Context, steamID string, count *int, opts ...option.RequestOption) ([]models.RecentGame, error) {
+	rawGames, err := s.GetRecentlyPlayedGamesRawModelCtx(ctx, steamID, count, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	games := make([]models.RecentGame, 0, len(rawGames.Response.Games))
+	endpoints := s.client.Endpoints()
+	for _, g := range rawGames.Response.Games {
+		games = append(games, models.RecentGame{
+			AppID:           g.AppID,
+			Name:            g.Name,
+			Playtime2Weeks:  g.Playtime2Weeks,
+			PlaytimeForever: g.PlaytimeForever,
+			IconURL:         endpoints.IconURL(g.AppID, g.ImgIconURL), // 拼接图标URL | Splice icon URL
+			CapsuleURL:      endpoints.CapsuleURL(g.AppID),            // 拼接封面URL | Splice capsule URL
+		})
+	}
+	return games, nil
+}
+
+// GetSteamLevelBrief get player's Steam level 获取玩家 Steam 等级
+//   - steamID: Player SteamID
+func (s *DevService) GetSteamLevelBrief(steamID string, opts ...option.RequestOption) (int, error) {
+	return s.GetSteamLevelBriefCtx(context.Background(), steamID, opts...)
+}
+
+// GetSteamLevelBriefCtx is the context-aware variant of GetSteamLevelBrief
+func (s *DevService) GetSteamLevelBriefCtx(ctx context.Context, steamID string, opts ...option.RequestOption) (int, error) {
+	rawLevel, err := s.GetSteamLevelRawModelCtx(ctx, steamID, opts...)
+	if err != nil {
+		return 0, err
+	}
+	return rawLevel.Response.PlayerLevel, nil
+}
+
+// GetBadgesBrief get player's badges, level and XP 获取玩家徽章、等级与经验值
+//   - steamID: Player SteamID
+func (s *DevService) GetBadgesBrief(steamID string, opts ...option.RequestOption) (models.PlayerBadges, error) {
+	return s.GetBadgesBriefCtx(context.Background(), steamID, opts...)
+}
+
+// GetBadgesBriefCtx is the context-aware variant of GetBadgesBrief
+func (s *DevService) GetBadgesBriefCtx(ctx context.Context, steamID string, opts ...option.RequestOption) (models.PlayerBadges, error) {
+	rawBadges, err := s.GetBadgesRawModelCtx(ctx, steamID, opts...)
+	if err != nil {
+		return models.PlayerBadges{}, err
+	}
+
+	resp := rawBadges.Response
+	badges := models.PlayerBadges{
+		Level:                resp.PlayerLevel,
+		XP:                   resp.PlayerXP,
+		XPNeededToLevelUp:    resp.PlayerXPNeededToLevelUp,
+		XPNeededCurrentLevel: resp.PlayerXPNeededCurrentLevel,
+		Badges:               make([]models.Badge, 0, len(resp.Badges)),
+	}
+	for _, b := range resp.Badges {
+		badges.Badges = append(badges.Badges, models.Badge{
+			BadgeID:           b.BadgeID,
+			AppID:             b.AppID,
+			Level:             b.Level,
+			XP:                b.XP,
+			Scarcity:          b.Scarcity,
+			Foil:              b.BorderColor == 1, // 边框颜色为 1 表示闪亮徽章 | Border color 1 marks a foil badge
+			CompletionTime:    b.CompletionTime,
+			CompletionTimeStr: util.TimeUnix2String(b.CompletionTime), // 格式化获得时间 | Format completion time
+			CommunityItemID:   b.CommunityItemID,
+		})
+	}
+	return badges, nil
+}
+
+// GetCommunityBadgeProgressBrief get player's community badge quest progress 获取玩家社区徽章任务进度
+//   - steamID: Player SteamID
+//   - badgeID: Community badge ID (nil for the Steam Community badge)
+func (s *DevService) GetCommunityBadgeProgressBrief(steamID string, badgeID *int, opts ...option.RequestOption) ([]models.BadgeQuest, error) {
+	return s.GetCommunityBadgeProgressBriefCtx(context.Background(), steamID, badgeID, opts...)
+}
+
+// GetCommunityBadgeProgressBriefCtx is the context-aware variant of GetCommunityBadgeProgressBrief
+func (s *DevService) GetCommunityBadgeProgressBriefCtx(ctx context.Context, steamID string, badgeID *int, opts ...option.RequestOption) ([]models.BadgeQuest, error) {
+	rawProgress, err := s.GetCommunityBadgeProgressRawModelCtx(ctx, steamID, badgeID, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	quests := make([]models.BadgeQuest, 0, len(rawProgress.Response.Quests))
+	for _, q := range rawProgress.Response.Quests {
+		quests = append(quests, models.BadgeQuest{QuestID: q.QuestID, Completed: q.Completed})
+	}
+	return quests, nil
+}
+
 // ============================ Default Interface 默认接口 ============================
 
 // GetOwnedGames get player's owned games 获取玩家已拥有的游戏
 //   - steamID: Player SteamID
 //   - includeFree: Whether to include free games
-func (s *DevService) GetOwnedGames(steamID string, includeFree bool) ([]models.OwnedGame, error) {
-	return s.GetOwnedGamesBrief(steamID, includeFree)
+func (s *DevService) GetOwnedGames(steamID string, includeFree bool, opts ...option.RequestOption) ([]models.OwnedGame, error) {
+	return s.GetOwnedGamesBrief(steamID, includeFree, opts...)
+}
+
+// GetOwnedGamesCtx is the context-aware variant of GetOwnedGames
+func (s *DevService) GetOwnedGamesCtx(ctx context.Context, steamID string, includeFree bool, opts ...option.RequestOption) ([]models.OwnedGame, error) {
+	return s.GetOwnedGamesBriefCtx(ctx, steamID, includeFree, opts...)
+}
+
+// GetOwnedGamesWithQuery get player's owned games with optional params 按可选参数获取玩家已拥有的游戏
+//   - steamID: Player SteamID
+//   - query: Optional params (nil for defaults)
+func (s *DevService) GetOwnedGamesWithQuery(steamID string, query *OwnedGamesQuery, opts ...option.RequestOption) ([]models.OwnedGame, error) {
+	return s.GetOwnedGamesWithQueryBrief(steamID, query, opts...)
+}
+
+// GetOwnedGamesWithQueryCtx is the context-aware variant of GetOwnedGamesWithQuery
+func (s *DevService) GetOwnedGamesWithQueryCtx(ctx context.Context, steamID string, query *OwnedGamesQuery, opts ...option.RequestOption) ([]models.OwnedGame, error) {
+	return s.GetOwnedGamesWithQueryBriefCtx(ctx, steamID, query, opts...)
+}
+
+// GetRecentlyPlayedGames get games played in the last two weeks 获取玩家近2周游玩的游戏
+//   - steamID: Player SteamID
+//   - count: Max number of games (nil for all)
+func (s *DevService) GetRecentlyPlayedGames(steamID string, count *int, opts ...option.RequestOption) ([]models.RecentGame, error) {
+	return s.GetRecentlyPlayedGamesBrief(steamID, count, opts...)
+}
+
+// GetRecentlyPlayedGamesCtx is the context-aware variant of GetRecentlyPlayedGames
+func (s *DevService) GetRecentlyPlayedGamesCtx(ctx context.Context, steamID string, count *int, opts ...option.RequestOption) ([]models.RecentGame, error) {
+	return s.GetRecentlyPlayedGamesBriefCtx(ctx, steamID, count, opts...)
+}
+
+// GetSteamLevel get player's Steam level 获取玩家 Steam 等级
+//   - steamID: Player SteamID
+func (s *DevService) GetSteamLevel(steamID string, opts ...option.RequestOption) (int, error) {
+	return s.GetSteamLevelBrief(steamID, opts...)
+}
+
+// GetSteamLevelCtx is the context-aware variant of GetSteamLevel
+func (s *DevService) GetSteamLevelCtx(ctx context.Context, steamID string, opts ...option.RequestOption) (int, error) {
+	return s.GetSteamLevelBriefCtx(ctx, steamID, opts...)
+}
+
+// GetBadges get player's badges, level and XP 获取玩家徽章、等级与经验值
+//   - steamID: Player SteamID
+func (s *DevService) GetBadges(steamID string, opts ...option.RequestOption) (models.PlayerBadges, error) {
+	return s.GetBadgesBrief(steamID, opts...)
+}
+
+// GetBadgesCtx is the context-aware variant of GetBadges
+func (s *DevService) GetBadgesCtx(ctx context.Context, steamID string, opts ...option.RequestOption) (models.PlayerBadges, error) {
+	return s.GetBadgesBriefCtx(ctx, steamID, opts...)
+}
+
+// GetCommunityBadgeProgress get player's community badge quest progress 获取玩家社区徽章任务进度
+//   - steamID: Player SteamID
+//   - badgeID: Community badge ID (nil for the Steam Community badge)
+func (s *DevService) GetCommunityBadgeProgress(steamID string, badgeID *int, opts ...option.RequestOption) ([]models.BadgeQuest, error) {
+	return s.GetCommunityBadgeProgressBrief(steamID, badgeID, opts...)
+}
+
+// GetCommunityBadgeProgressCtx is the context-aware variant of GetCommunityBadgeProgress
+func (s *DevService) GetCommunityBadgeProgressCtx(ctx context.Context, steamID string, badgeID *int, opts ...option.RequestOption) ([]models.BadgeQuest, error) {
+	return s.GetCommunityBadgeProgressBriefCtx(ctx, steamID, badgeID, opts...)
 }
 
 // ============================ Build 构造入参 ============================
 
 // buildOwnedGames builds input params.
-func (s *DevService) buildOwnedGames(steamID string, includeFree bool) (
+func (s *DevService) buildOwnedGames(steamID string, query *OwnedGamesQuery) (
 	c *client.Client,
 	method, reqPath string,
 	params url.Values,
 ) {
+	if query == nil {
+		query = &OwnedGamesQuery{}
+	}
 	params = url.Values{}
 	params.Set("steamid", steamID)
-	params.Set("include_appinfo", "1")                                              // 包含游戏名称/图标 | Include game name/icon
-	params.Set("include_extended_appinfo", "1")                                     // 包含扩展信息 | Include extended info
+	if query.IncludeAppInfo == nil || *query.IncludeAppInfo {
+		params.Set("include_appinfo", "1")          // 包含游戏名称/图标 | Include game name/icon
+		params.Set("include_extended_appinfo", "1") // 包含扩展信息 | Include extended info
+	} else {
+		params.Set("include_appinfo", "0")
+	}
+	includeFree := query.IncludePlayedFreeGames != nil && *query.IncludePlayedFreeGames
 	params.Set("include_played_free_games", util.Int2String(util.B2i(includeFree))) // 包含免费游戏 | Include free games
+	if query.SkipUnvettedApps != nil {
+		params.Set("skip_unvetted_apps", util.Int2String(util.B2i(*query.SkipUnvettedApps)))
+	}
+	// 数组参数按下标展开 | Array params are expanded by index
+	for i, appID := range query.AppIDsFilter {
+		params.Set("appids_filter["+strconv.Itoa(i)+"]", util.Uint642String(appID))
+	}
 
 	return s.client, "GET", IPlayerService + "/GetOwnedGames/v1/", params
 }
+
+// buildRecentlyPlayedGames builds input params.
+func (s *DevService) buildRecentlyPlayedGames(steamID string, count *int) (
+	c *client.Client,
+	method, reqPath string,
+	params url.Values,
+) {
+	params = url.Values{}
+	params.Set("steamid", steamID)
+	if count != nil {
+		params.Set("count", util.Int2String(*count))
+	}
+	return s.client, "GET", IPlayerService + "/GetRecentlyPlayedGames/v1/", params
+}
+
+// buildSteamLevel builds input params.
+func (s *DevService) buildSteamLevel(steamID string) (
+	c *client.Client,
+	method, reqPath string,
+	params url.Values,
+) {
+	params = url.Values{}
+	params.Set("steamid", steamID)
+	return s.client, "GET", IPlayerService + "/GetSteamLevel/v1/", params
+}
+
+// buildBadges builds input params.
+func (s *DevService) buildBadges(steamID string) (
+	c *client.Client,
+	method, reqPath string,
+	params url.Values,
+) {
+	params = url.Values{}
+	params.Set("steamid", steamID)
+	return s.client, "GET", IPlayerService + "/GetBadges/v1/", params
+}
+
+// buildCommunityBadgeProgress builds input params.
+func (s *DevService) buildCommunityBadgeProgress(steamID string, badgeID *int) (
+	c *client.Client,
+	method, reqPath string,
+	params url.Values,
+) {
+	params = url.Values{}
+	params.Set("steamid", steamID)
+	if badgeID != nil {
+		params.Set("badgeid", util.Int2String(*badgeID))
+	}
+	return s.client, "GET", IPlayerService + "/GetCommunityBadgeProgress/v1/", params
+}
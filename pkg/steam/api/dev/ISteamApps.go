@@ -0,0 +1,264 @@
+package dev
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/GoFurry/gf-steam-sdk/internal/api"
+	"github.com/GoFurry/gf-steam-sdk/internal/client"
+	"github.com/GoFurry/gf-steam-sdk/pkg/models"
+	"github.com/GoFurry/gf-steam-sdk/pkg/option"
+	"github.com/GoFurry/gf-steam-sdk/pkg/util"
+	"github.com/GoFurry/gf-steam-sdk/pkg/util/errors"
+)
+
+const (
+	ISteamApps = util.STEAM_API_BASE_URL + "ISteamApps"
+)
+
+// appListPath GetAppList 响应中应用数组的键路径 | Key path of the app array in the GetAppList response
+var appListPath = []string{"applist", "apps"}
+
+// ============================ Raw Bytes 原始字节流接口 ============================
+
+// UpToDateCheckRawBytes check whether a server version is up to date 检查服务器版本是否为最新
+//   - appID: Game AppID
+//   - version: Server version to check
+func (s *DevService) UpToDateCheckRawBytes(appID uint64, version uint32, opts ...option.RequestOption) (respBytes []byte, err error) {
+	return s.UpToDateCheckRawBytesCtx(context.Background(), appID, version, opts...)
+}
+
+// UpToDateCheckRawBytesCtx is the context-aware variant of UpToDateCheckRawBytes
+func (s *DevService) UpToDateCheckRawBytesCtx(ctx context.Context, appID uint64, version uint32, opts ...option.RequestOption) (respBytes []byte, err error) {
+	c, method, reqPath, params := s.buildUpToDateCheck(appID, version)
+	return api.GetRawBytesCtx(ctx, c, method, reqPath, params, opts...)
+}
+
+// GetServersAtAddressRawBytes get game servers running at an IP 获取指定 IP 上的游戏服务器
+//   - addr: IP address, optionally with port (e.g. "1.2.3.4" or "1.2.3.4:27015")
+func (s *DevService) GetServersAtAddressRawBytes(addr string, opts ...option.RequestOption) (respBytes []byte, err error) {
+	return s.GetServersAtAddressRawBytesCtx(context.Background(), addr, opts...)
+}
+
+// GetServersAtAddressRawBytesCtx is the context-aware variant of GetServersAtAddressRawBytes
+func (s *DevService) GetServersAtAddressRawBytesCtx(ctx context.Context, addr string, opts ...option.RequestOption) (respBytes []byte, err error) {
+	if addr == "" {
+		return respBytes, errServerAddrEmpty
+	}
+	c, method, reqPath, params := s.buildServersAtAddress(addr)
+	return api.GetRawBytesCtx(ctx, c, method, reqPath, params, opts...)
+}
+
+// ============================ Structed Raw Model 结构化原始模型接口 ============================
+
+// StreamAppList stream every Steam app 流式遍历 Steam 全部应用
+// 响应体超大(数十万条), 边下载边解析, 不整体读入内存, 也不经过响应缓存
+// The response is huge (hundreds of thousands of apps), so it is decoded while downloading, never held in memory and never cached
+//   - fn: Called for each app, return false to stop
+func (s *DevService) StreamAppList(fn func(app models.AppListItem) bool, opts ...option.RequestOption) error {
+	return s.StreamAppListCtx(context.Background(), fn, opts...)
+}
+
+// StreamAppListCtx is the context-aware variant of StreamAppList
+func (s *DevService) StreamAppListCtx(ctx context.Context, fn func(app models.AppListItem) bool, opts ...option.RequestOption) error {
+	c, method, reqPath, params := s.buildAppList()
+	return api.StreamArrayCtx(ctx, c, method, reqPath, params, appListPath, fn, opts...)
+}
+
+// UpToDateCheckRawModel check whether a server version is up to date 检查服务器版本是否为最新
+// 应用不存在时返回 errors.ErrAppNotFound | Fails with errors.ErrAppNotFound for unknown apps
+//   - appID: Game AppID
+//   - version: Server version to check
+func (s *DevService) UpToDateCheckRawModel(appID uint64, version uint32, opts ...option.RequestOption) (models.SteamUpToDateCheckResponse, error) {
+	return s.UpToDateCheckRawModelCtx(context.Background(), appID, version, opts...)
+}
+
+// UpToDateCheckRawModelCtx is the context-aware variant of UpToDateCheckRawModel
+func (s *DevService) UpToDateCheckRawModelCtx(ctx context.Context, appID uint64, version uint32, opts ...option.RequestOption) (models.SteamUpToDateCheckResponse, error) {
+	c, method, reqPath, params := s.buildUpToDateCheck(appID, version)
+	return api.GetRawModelCtx[models.SteamUpToDateCheckResponse](ctx, c, method, reqPath, params, opts...)
+}
+
+// GetServersAtAddressRawModel get game servers running at an IP 获取指定 IP 上的游戏服务器
+//   - addr: IP address, optionally with port (e.g. "1.2.3.4" or "1.2.3.4:27015")
+func (s *DevService) GetServersAtAddressRawModel(addr string, opts ...option.RequestOption) (models.SteamServersAtAddressResponse, error) {
+	return s.GetServersAtAddressRawModelCtx(context.Background(), addr, opts...)
+}
+
+// GetServersAtAddressRawModelCtx is the context-aware variant of GetServersAtAddressRawModel
+func (s *DevService) GetServersAtAddressRawModelCtx(ctx context.Context, addr string, opts ...option.RequestOption) (models.SteamServersAtAddressResponse, error) {
+	if addr == "" {
+		return models.SteamServersAtAddressResponse{}, errServerAddrEmpty
+	}
+	c, method, reqPath, params := s.buildServersAtAddress(addr)
+	return api.GetRawModelCtx[models.SteamServersAtAddressResponse](ctx, c, method, reqPath, params, opts...)
+}
+
+// ============================ Brief Model 精简模型接口 ============================
+
+// GetAppListBrief get every Steam app 获取 Steam 全部应用
+// 基于 StreamAppList 收集结果, 仅保留解析后的应用, 不保留原始响应体
+// Collects StreamAppList results, keeping only the decoded apps and never the raw body
+func (s *DevService) GetAppListBrief(opts ...option.RequestOption) ([]models.AppListItem, error) {
+	return s.GetAppListBriefCtx(context.Background(), opts...)
+}
+
+// GetAppListBriefCtx is the context-aware variant of GetAppListBrief
+func (s *DevService) GetAppListBriefCtx(ctx context.Context, opts ...option.RequestOption) ([]models.AppListItem, error) {
+	var apps []models.AppListItem
+	err := s.StreamAppListCtx(ctx, func(app models.AppListItem) bool {
+		apps = append(apps, app)
+		return true
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return apps, nil
+}
+
+// UpToDateCheckBrief check whether a server version is up to date 检查服务器版本是否为最新
+//   - appID: Game AppID
+//   - version: Server version to check
+func (s *DevService) UpToDateCheckBrief(appID uint64, version uint32, opts ...option.RequestOption) (models.UpToDateCheck, error) {
+	return s.UpToDateCheckBriefCtx(context.Background(), appID, version, opts...)
+}
+
+// UpToDateCheckBriefCtx is the context-aware variant of UpToDateCheckBrief
+func (s *DevService) UpToDateCheckBriefCtx(ctx context.Context, appID uint64, version uint32, opts ...option.RequestOption) (models.UpToDateCheck, error) {
+	rawCheck, err := s.UpToDateCheckRawModelCtx(ctx, appID, version, opts...)
+	if err != nil {
+		return models.UpToDateCheck{}, err
+	}
+	r := rawCheck.Response
+	return models.UpToDateCheck{
+		UpToDate:          r.UpToDate,
+		VersionIsListable: r.VersionIsListable,
+		RequiredVersion:   r.RequiredVersion,
+		Message:           r.Message,
+	}, nil
+}
+
+// GetServersAtAddressBrief get game servers running at an IP 获取指定 IP 上的游戏服务器
+//   - addr: IP address, optionally with port (e.g. "1.2.3.4" or "1.2.3.4:27015")
+func (s *DevService) GetServersAtAddressBrief(addr string, opts ...option.RequestOption) ([]models.ServerAtAddress, error) {
+	return s.GetServersAtAddressBriefCtx(context.Background(), addr, opts...)
+}
+
+// GetServersAtAddressBriefCtx is the context-aware variant of GetServersAtAddressBrief
+func (s *DevService) GetServersAtAddressBriefCtx(ctx context.Context, addr string, opts ...option.RequestOption) ([]models.ServerAtAddress, error) {
+	rawServers, err := s.GetServersAtAddressRawModelCtx(ctx, addr, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	servers := make([]models.ServerAtAddress, 0, len(rawServers.Response.Servers))
+	for _, sv := range rawServers.Response.Servers {
+		servers = append(servers, models.ServerAtAddress{
+			Addr:     sv.Addr,
+			SteamID:  sv.SteamID,
+			AppID:    sv.AppID,
+			GameDir:  sv.GameDir,
+			Region:   sv.Region,
+			Secure:   sv.Secure,
+			LAN:      sv.LAN,
+			GamePort: sv.GamePort,
+			SpecPort: sv.SpecPort,
+		})
+	}
+	return servers, nil
+}
+
+// ============================ Default Interface 默认接口 ============================
+
+// GetAppList get every Steam app 获取 Steam 全部应用
+func (s *DevService) GetAppList(opts ...option.RequestOption) ([]models.AppListItem, error) {
+	return s.GetAppListBrief(opts...)
+}
+
+// GetAppListCtx is the context-aware variant of GetAppList
+func (s *DevService) GetAppListCtx(ctx context.Context, opts ...option.RequestOption) ([]models.AppListItem, error) {
+	return s.GetAppListBriefCtx(ctx, opts...)
+}
+
+// UpToDateCheck check whether a server version is up to date 检查服务器版本是否为最新
+//   - appID: Game AppID
+//   - version: Server version to check
+func (s *DevService) UpToDateCheck(appID uint64, version uint32, opts ...option.RequestOption) (models.UpToDateCheck, error) {
+	return s.UpToDateCheckBrief(appID, version, opts...)
+}
+
+// UpToDateCheckCtx is the context-aware variant of UpToDateCheck
+func (s *DevService) UpToDateCheckCtx(ctx context.Context, appID uint64, version uint32, opts ...option.RequestOption) (models.UpToDateCheck, error) {
+	return s.UpToDateCheckBriefCtx(ctx, appID, version, opts...)
+}
+
+// GetServersAtAddress get game servers running at an IP 获取指定 IP 上的游戏服务器
+//   - addr: IP address, optionally with port (e.g. "1.2.3.4" or "1.2.3.4:27015")
+func (s *DevService) GetServersAtAddress(addr string, opts ...option.RequestOption) ([]models.ServerAtAddress, error) {
+	return s.GetServersAtAddressBrief(addr, opts...)
+}
+
+// GetServersAtAddressCtx is the context-aware variant of GetServersAtAddress
+func (s *DevService) GetServersAtAddressCtx(ctx context.Context, addr string, opts ...option.RequestOption) ([]models.ServerAtAddress, error) {
+	return s.GetServersAtAddressBriefCtx(ctx, addr, opts...)
+}
+
+// GetServerAddrsAtAddress get query addresses of game servers running at an IP 获取指定 IP 上游戏服务器的查询地址
+// 返回的 "ip:查询端口" 列表可直接传给 ServerService.GetServerDetailList
+// The returned "ip:query_port" list plugs directly into ServerService.GetServerDetailList
+//   - addr: IP address, optionally with port (e.g. "1.2.3.4" or "1.2.3.4:27015")
+func (s *DevService) GetServerAddrsAtAddress(addr string, opts ...option.RequestOption) ([]string, error) {
+	return s.GetServerAddrsAtAddressCtx(context.Background(), addr, opts...)
+}
+
+// GetServerAddrsAtAddressCtx is the context-aware variant of GetServerAddrsAtAddress
+func (s *DevService) GetServerAddrsAtAddressCtx(ctx context.Context, addr string, opts ...option.RequestOption) ([]string, error) {
+	servers, err := s.GetServersAtAddressBriefCtx(ctx, addr, opts...)
+	if err != nil {
+		return nil, err
+	}
+	addrs := make([]string, 0, len(servers))
+	for _, sv := range servers {
+		addrs = append(addrs, sv.Addr)
+	}
+	return addrs, nil
+}
+
+// ============================ Build 构造入参 ============================
+
+// buildAppList builds input params.
+func (s *DevService) buildAppList() (
+	c *client.Client,
+	method, reqPath string,
+	params url.Values,
+) {
+	return s.client, "GET", ISteamApps + "/GetAppList/v2/", url.Values{}
+}
+
+// buildUpToDateCheck builds input params.
+func (s *DevService) buildUpToDateCheck(appID uint64, version uint32) (
+	c *client.Client,
+	method, reqPath string,
+	params url.Values,
+) {
+	params = url.Values{}
+	params.Set("appid", util.Uint642String(appID))
+	params.Set("version", util.Uint642String(uint64(version)))
+	return s.client, "GET", ISteamApps + "/UpToDateCheck/v1/", params
+}
+
+// buildServersAtAddress builds input params.
+func (s *DevService) buildServersAtAddress(addr string) (
+	c *client.Client,
+	method, reqPath string,
+	params url.Values,
+) {
+	params = url.Values{}
+	params.Set("addr", addr)
+	return s.client, "GET", ISteamApps + "/GetServersAtAddress/v1/", params
+}
+
+// ============================ 工具方法 ============================
+
+// errServerAddrEmpty 服务器地址为空 | Empty server address
+var errServerAddrEmpty = errors.NewWithType(errors.ErrTypeParam, "server address is empty", nil)
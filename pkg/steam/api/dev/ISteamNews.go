@@ -0,0 +1,200 @@
+package dev
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/GoFurry/gf-steam-sdk/internal/api"
+	"github.com/GoFurry/gf-steam-sdk/internal/client"
+	"github.com/GoFurry/gf-steam-sdk/pkg/models"
+	"github.com/GoFurry/gf-steam-sdk/pkg/option"
+	steamutil "github.com/GoFurry/gf-steam-sdk/pkg/steam/util"
+	"github.com/GoFurry/gf-steam-sdk/pkg/util"
+)
+
+const (
+	ISteamNews = util.STEAM_API_BASE_URL + "ISteamNews"
+)
+
+// NewsQuery GetNewsForApp 可选参数, 字段为 nil 或空时不发送
+// NewsQuery holds the optional GetNewsForApp params; nil or empty fields are omitted
+type NewsQuery struct {
+	Count     *int     // 返回条数(Steam 默认 20) | Number of items (Steam default 20)
+	MaxLength *int     // 正文最大长度, 0 为完整正文 | Max contents length, 0 for full contents
+	EndDate   *int64   // 仅返回该时间戳及之前的新闻 | Only items published at or before this timestamp
+	Feeds     []string // 来源标识过滤(如 steam_community_announcements) | Feed name filter (e.g. steam_community_announcements)
+	Tags      []string // 标签过滤(如 patchnotes) | Tag filter (e.g. patchnotes)
+}
+
+// ============================ Raw Bytes 原始字节流接口 ============================
+
+// GetNewsForAppRawBytes get game's news 获取游戏新闻
+//   - appID: Game AppID
+//   - query: Optional params (nil for defaults)
+func (s *DevService) GetNewsForAppRawBytes(appID uint64, query *NewsQuery, opts ...option.RequestOption) (respBytes []byte, err error) {
+	return s.GetNewsForAppRawBytesCtx(context.Background(), appID, query, opts...)
+}
+
+// GetNewsForAppRawBytesCtx is the context-aware variant of GetNewsForAppRawBytes
+func (s *DevService) GetNewsForAppRawBytesCtx(ctx context.Context, appID uint64, query *NewsQuery, opts ...option.RequestOption) (respBytes []byte, err error) {
+	c, method, reqPath, params := s.buildNewsForApp(appID, query)
+	return api.GetRawBytesCtx(ctx, c, method, reqPath, params, opts...)
+}
+
+// ============================ Structed Raw Model 结构化原始模型接口 ============================
+
+// GetNewsForAppRawModel get game's news 获取游戏新闻
+//   - appID: Game AppID
+//   - query: Optional params (nil for defaults)
+func (s *DevService) GetNewsForAppRawModel(appID uint64, query *NewsQuery, opts ...option.RequestOption) (models.SteamNewsResponse, error) {
+	return s.GetNewsForAppRawModelCtx(context.Background(), appID, query, opts...)
+}
+
+// GetNewsForAppRawModelCtx is the context-aware variant of GetNewsForAppRawModel
+func (s *DevService) GetNewsForAppRawModelCtx(ctx context.Context, appID uint64, query *NewsQuery, opts ...option.RequestOption) (models.SteamNewsResponse, error) {
+	c, method, reqPath, params := s.buildNewsForApp(appID, query)
+	return api.GetRawModelCtx[models.SteamNewsResponse](ctx, c, method, reqPath, params, opts...)
+}
+
+// ============================ Brief Model 精简模型接口 ============================
+
+// GetNewsForAppBrief get game's news with rendered contents 获取游戏新闻(正文渲染为安全 HTML)
+// 社区公告的 BBCode 经 ParseBBCode 渲染, 外部源的 HTML 直接使用, 两者均经 SanitizeHTML 过滤
+// Community announcements are rendered from BBCode with ParseBBCode, external feeds keep their HTML, and both pass through SanitizeHTML
+//   - appID: Game AppID
+//   - query: Optional params (nil for defaults)
+func (s *DevService) GetNewsForAppBrief(appID uint64, query *NewsQuery, opts ...option.RequestOption) ([]models.NewsItem, error) {
+	return s.GetNewsForAppBriefCtx(context.Background(), appID, query, opts...)
+}
+
+// GetNewsForAppBriefCtx is the context-aware variant of GetNewsForAppBrief
+func (s *DevService) GetNewsForAppBriefCtx(ctx context.Context, appID uint64, query *NewsQuery, opts ...option.RequestOption) ([]models.NewsItem, error) {
+	rawNews, err := s.GetNewsForAppRawModelCtx(ctx, appID, query, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]models.NewsItem, 0, len(rawNews.AppNews.NewsItems))
+	for _, n := range rawNews.AppNews.NewsItems {
+		contents := renderNewsContents(n.Contents, n.FeedType)
+		items = append(items, models.NewsItem{
+			GID:           n.GID,
+			AppID:         n.AppID,
+			Title:         n.Title,
+			URL:           n.URL,
+			IsExternalURL: n.IsExternalURL,
+			Author:        n.Author,
+			FeedLabel:     n.FeedLabel,
+			FeedName:      n.FeedName,
+			Date:          n.Date,
+			DateStr:       util.TimeUnix2String(n.Date), // 格式化发布时间 | Format publish time
+			Tags:          n.Tags,
+			ContentsHTML:  contents,
+			Excerpt:       steamutil.HTMLToText(contents, util.NEWS_EXCERPT_LENGTH),
+		})
+	}
+	return items, nil
+}
+
+// ============================ Default Interface 默认接口 ============================
+
+// GetNewsForApp get game's news with rendered contents 获取游戏新闻(正文渲染为安全 HTML)
+//   - appID: Game AppID
+//   - query: Optional params (nil for defaults)
+func (s *DevService) GetNewsForApp(appID uint64, query *NewsQuery, opts ...option.RequestOption) ([]models.NewsItem, error) {
+	return s.GetNewsForAppBrief(appID, query, opts...)
+}
+
+// GetNewsForAppCtx is the context-aware variant of GetNewsForApp
+func (s *DevService) GetNewsForAppCtx(ctx context.Context, appID uint64, query *NewsQuery, opts ...option.RequestOption) ([]models.NewsItem, error) {
+	return s.GetNewsForAppBriefCtx(ctx, appID, query, opts...)
+}
+
+// WalkNewsForApp page back through older news by enddate 按 enddate 向前翻页遍历游戏新闻
+// 每页以上一页最早的发布时间作为 enddate 继续请求, 并按 gid 去重; fn 返回 false 或没有更早的新闻时结束
+// Each page requests with the oldest date of the previous page as enddate and drops items already seen by gid;
+// the walk ends when fn returns false or no older items remain
+//   - appID: Game AppID
+//   - query: Optional params, Count is the page size and EndDate the starting point (nil for defaults)
+//   - fn: Called with each page of new items, return false to stop
+func (s *DevService) WalkNewsForApp(appID uint64, query *NewsQuery, fn func(items []models.NewsItem) bool, opts ...option.RequestOption) error {
+	return s.WalkNewsForAppCtx(context.Background(), appID, query, fn, opts...)
+}
+
+// WalkNewsForAppCtx is the context-aware variant of WalkNewsForApp
+func (s *DevService) WalkNewsForAppCtx(ctx context.Context, appID uint64, query *NewsQuery, fn func(items []models.NewsItem) bool, opts ...option.RequestOption) error {
+	page := NewsQuery{}
+	if query != nil {
+		page = *query
+	}
+	seen := make(map[string]bool)
+	for {
+		items, err := s.GetNewsForAppBriefCtx(ctx, appID, &page, opts...)
+		if err != nil {
+			return err
+		}
+
+		// 同一时间戳的新闻可能跨页重复出现 | Items sharing a timestamp may repeat across pages
+		fresh := make([]models.NewsItem, 0, len(items))
+		oldest := int64(0)
+		for _, item := range items {
+			if oldest == 0 || item.Date < oldest {
+				oldest = item.Date
+			}
+			if !seen[item.GID] {
+				seen[item.GID] = true
+				fresh = append(fresh, item)
+			}
+		}
+		if len(fresh) == 0 || !fn(fresh) {
+			return nil
+		}
+		page.EndDate = &oldest
+	}
+}
+
+// ============================ Build 构造入参 ============================
+
+// buildNewsForApp builds input params.
+func (s *DevService) buildNewsForApp(appID uint64, query *NewsQuery) (
+	c *client.Client,
+	method, reqPath string,
+	params url.Values,
+) {
+	params = url.Values{}
+	params.Set("appid", util.Uint642String(appID))
+	if query != nil {
+		if query.Count != nil {
+			params.Set("count", util.Int2String(*query.Count))
+		}
+		if query.MaxLength != nil {
+			params.Set("maxlength", util.Int2String(*query.MaxLength))
+		}
+		if query.EndDate != nil {
+			params.Set("enddate", util.Int642String(*query.EndDate))
+		}
+		if len(query.Feeds) > 0 {
+			params.Set("feeds", strings.Join(query.Feeds, ","))
+		}
+		if len(query.Tags) > 0 {
+			params.Set("tags", strings.Join(query.Tags, ","))
+		}
+	}
+	return s.client, "GET", ISteamNews + "/GetNewsForApp/v2/", params
+}
+
+// ============================ 工具方法 ============================
+
+// bbcodeEscaper 转义 BBCode 正文中的 HTML 特殊字符, 避免原样输出标签
+// bbcodeEscaper escapes HTML special characters in BBCode contents so raw tags are not passed through
+var bbcodeEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// renderNewsContents 将新闻正文渲染为安全 HTML
+// renderNewsContents renders news contents to safe HTML
+func renderNewsContents(contents string, feedType int) string {
+	if feedType == util.NEWS_FEED_TYPE_BBCODE {
+		contents = steamutil.ParseBBCode(bbcodeEscaper.Replace(contents), util.NEWS_BBCODE_NESTED_DEPTH)
+	}
+	return steamutil.SanitizeHTML(contents)
+}
@@ -1,12 +1,15 @@
 package dev
 
 import (
+	"context"
 	"net/url"
+	"strconv"
 	"strings"
 
 	"github.com/GoFurry/gf-steam-sdk/internal/api"
 	"github.com/GoFurry/gf-steam-sdk/internal/client"
 	"github.com/GoFurry/gf-steam-sdk/pkg/models"
+	"github.com/GoFurry/gf-steam-sdk/pkg/option"
 	"github.com/GoFurry/gf-steam-sdk/pkg/util"
 	"github.com/GoFurry/gf-steam-sdk/pkg/util/errors"
 )
@@ -19,46 +22,180 @@ const (
 
 // GetPlayerSummariesRawBytes get player's information 获取玩家信息
 //   - steamIDs: Multiple SteamIDs separated by commas (max 100)
-func (s *DevService) GetPlayerSummariesRawBytes(steamIDs string) (respBytes []byte, err error) {
+func (s *DevService) GetPlayerSummariesRawBytes(steamIDs string, opts ...option.RequestOption) (respBytes []byte, err error) {
+	return s.GetPlayerSummariesRawBytesCtx(context.Background(), steamIDs, opts...)
+}
+
+// GetPlayerSummariesRawBytesCtx is the context-aware variant of GetPlayerSummariesRawBytes
+func (s *DevService) GetPlayerSummariesRawBytesCtx(ctx context.Context, steamIDs string, opts ...option.RequestOption) (respBytes []byte, err error) {
 	// 参数校验 | Parameter validation
-	if steamIDs == "" {
+	if err = checkSteamIDList(steamIDs); err != nil {
+		return respBytes, err
+	}
+
+	c, method, reqPath, params := s.buildPlayerSummaries(steamIDs)
+	return api.GetRawBytesCtx(ctx, c, method, reqPath, params, opts...)
+}
+
+// GetFriendListRawBytes get player's friend list 获取玩家好友列表
+//   - steamID: Player SteamID
+//   - relationship: Relationship filter "friend" or "all" (nil for Steam default)
+func (s *DevService) GetFriendListRawBytes(steamID string, relationship *string, opts ...option.RequestOption) (respBytes []byte, err error) {
+	return s.GetFriendListRawBytesCtx(context.Background(), steamID, relationship, opts...)
+}
+
+// GetFriendListRawBytesCtx is the context-aware variant of GetFriendListRawBytes
+func (s *DevService) GetFriendListRawBytesCtx(ctx context.Context, steamID string, relationship *string, opts ...option.RequestOption) (respBytes []byte, err error) {
+	if steamID == "" {
 		return respBytes, errors.ErrInvalidSteamID
 	}
-	ids := strings.Split(steamIDs, ",")
-	if len(ids) > 100 {
-		return respBytes, errors.NewWithType(errors.ErrTypeParam,
-			"steamids count exceeds 100 (Steam API maximum limit)", nil)
+	c, method, reqPath, params := s.buildFriendList(steamID, relationship)
+	return api.GetRawBytesCtx(ctx, c, method, reqPath, params, opts...)
+}
+
+// GetPlayerBansRawBytes get players' VAC, game, community and economy bans 获取玩家封禁记录
+//   - steamIDs: Multiple SteamIDs separated by commas (max 100)
+func (s *DevService) GetPlayerBansRawBytes(steamIDs string, opts ...option.RequestOption) (respBytes []byte, err error) {
+	return s.GetPlayerBansRawBytesCtx(context.Background(), steamIDs, opts...)
+}
+
+// GetPlayerBansRawBytesCtx is the context-aware variant of GetPlayerBansRawBytes
+func (s *DevService) GetPlayerBansRawBytesCtx(ctx context.Context, steamIDs string, opts ...option.RequestOption) (respBytes []byte, err error) {
+	if err = checkSteamIDList(steamIDs); err != nil {
+		return respBytes, err
+	}
+	c, method, reqPath, params := s.buildPlayerBans(steamIDs)
+	return api.GetRawBytesCtx(ctx, c, method, reqPath, params, opts...)
+}
+
+// ResolveVanityURLRawBytes resolve a vanity URL to a SteamID 解析自定义 URL 对应的 SteamID
+//   - vanityURL: Vanity name or full profile/group URL (e.g. "gabelogannewell")
+//   - urlType: 1=individual profile, 2=group, 3=official game group (nil for individual)
+func (s *DevService) ResolveVanityURLRawBytes(vanityURL string, urlType *int, opts ...option.RequestOption) (respBytes []byte, err error) {
+	return s.ResolveVanityURLRawBytesCtx(context.Background(), vanityURL, urlType, opts...)
+}
+
+// ResolveVanityURLRawBytesCtx is the context-aware variant of ResolveVanityURLRawBytes
+func (s *DevService) ResolveVanityURLRawBytesCtx(ctx context.Context, vanityURL string, urlType *int, opts ...option.RequestOption) (respBytes []byte, err error) {
+	if vanityName(vanityURL) == "" {
+		return respBytes, errVanityURLEmpty
 	}
+	c, method, reqPath, params := s.buildResolveVanityURL(vanityURL, urlType)
+	return api.GetRawBytesCtx(ctx, c, method, reqPath, params, opts...)
+}
 
-	return api.GetRawBytes(s.buildPlayerSummaries(steamIDs))
+// GetUserGroupListRawBytes get groups the player belongs to 获取玩家所属群组
+//   - steamID: Player SteamID
+func (s *DevService) GetUserGroupListRawBytes(steamID string, opts ...option.RequestOption) (respBytes []byte, err error) {
+	return s.GetUserGroupListRawBytesCtx(context.Background(), steamID, opts...)
+}
+
+// GetUserGroupListRawBytesCtx is the context-aware variant of GetUserGroupListRawBytes
+func (s *DevService) GetUserGroupListRawBytesCtx(ctx context.Context, steamID string, opts ...option.RequestOption) (respBytes []byte, err error) {
+	if steamID == "" {
+		return respBytes, errors.ErrInvalidSteamID
+	}
+	c, method, reqPath, params := s.buildUserGroupList(steamID)
+	return api.GetRawBytesCtx(ctx, c, method, reqPath, params, opts...)
 }
 
 // ============================ Structed Raw Model 结构化原始模型接口 ============================
 
 // GetPlayerSummariesRawModel get player's information 获取玩家信息
 //   - steamIDs: Multiple SteamIDs separated by commas (max 100)
-func (s *DevService) GetPlayerSummariesRawModel(steamIDs string) (models.SteamPlayerResponse, error) {
+func (s *DevService) GetPlayerSummariesRawModel(steamIDs string, opts ...option.RequestOption) (models.SteamPlayerResponse, error) {
+	return s.GetPlayerSummariesRawModelCtx(context.Background(), steamIDs, opts...)
+}
+
+// GetPlayerSummariesRawModelCtx is the context-aware variant of GetPlayerSummariesRawModel
+func (s *DevService) GetPlayerSummariesRawModelCtx(ctx context.Context, steamIDs string, opts ...option.RequestOption) (models.SteamPlayerResponse, error) {
 	// 参数校验 | Parameter validation
-	if steamIDs == "" {
-		return models.SteamPlayerResponse{}, errors.ErrInvalidSteamID
+	if err := checkSteamIDList(steamIDs); err != nil {
+		return models.SteamPlayerResponse{}, err
 	}
-	ids := strings.Split(steamIDs, ",")
-	if len(ids) > 100 {
-		return models.SteamPlayerResponse{}, errors.NewWithType(errors.ErrTypeParam,
-			"steamids count exceeds 100 (Steam API maximum limit)", nil)
+
+	c, method, reqPath, params := s.buildPlayerSummaries(steamIDs)
+	return api.GetRawModelCtx[models.SteamPlayerResponse](ctx, c, method, reqPath, params, opts...)
+}
+
+// GetFriendListRawModel get player's friend list 获取玩家好友列表
+// 好友列表未公开时返回 errors.ErrPrivateFriendList(errors.Is 匹配 errors.ErrPrivateProfile)
+// A private friend list fails with errors.ErrPrivateFriendList (errors.Is matches errors.ErrPrivateProfile)
+//   - steamID: Player SteamID
+//   - relationship: Relationship filter "friend" or "all" (nil for Steam default)
+func (s *DevService) GetFriendListRawModel(steamID string, relationship *string, opts ...option.RequestOption) (models.SteamFriendListResponse, error) {
+	return s.GetFriendListRawModelCtx(context.Background(), steamID, relationship, opts...)
+}
+
+// GetFriendListRawModelCtx is the context-aware variant of GetFriendListRawModel
+func (s *DevService) GetFriendListRawModelCtx(ctx context.Context, steamID string, relationship *string, opts ...option.RequestOption) (models.SteamFriendListResponse, error) {
+	if steamID == "" {
+		return models.SteamFriendListResponse{}, errors.ErrInvalidSteamID
 	}
+	c, method, reqPath, params := s.buildFriendList(steamID, relationship)
+	return api.GetRawModelCtx[models.SteamFriendListResponse](ctx, c, method, reqPath, params, opts...)
+}
 
-	return api.GetRawModel[models.SteamPlayerResponse](s.buildPlayerSummaries(steamIDs))
+// GetPlayerBansRawModel get players' VAC, game, community and economy bans 获取玩家封禁记录
+//   - steamIDs: Multiple SteamIDs separated by commas (max 100)
+func (s *DevService) GetPlayerBansRawModel(steamIDs string, opts ...option.RequestOption) (models.SteamPlayerBansResponse, error) {
+	return s.GetPlayerBansRawModelCtx(context.Background(), steamIDs, opts...)
+}
+
+// GetPlayerBansRawModelCtx is the context-aware variant of GetPlayerBansRawModel
+func (s *DevService) GetPlayerBansRawModelCtx(ctx context.Context, steamIDs string, opts ...option.RequestOption) (models.SteamPlayerBansResponse, error) {
+	if err := checkSteamIDList(steamIDs); err != nil {
+		return models.SteamPlayerBansResponse{}, err
+	}
+	c, method, reqPath, params := s.buildPlayerBans(steamIDs)
+	return api.GetRawModelCtx[models.SteamPlayerBansResponse](ctx, c, method, reqPath, params, opts...)
+}
+
+// ResolveVanityURLRawModel resolve a vanity URL to a SteamID 解析自定义 URL 对应的 SteamID
+// 未匹配时返回 errors.ErrVanityURLNotFound | Fails with errors.ErrVanityURLNotFound when nothing matches
+//   - vanityURL: Vanity name or full profile/group URL (e.g. "gabelogannewell")
+//   - urlType: 1=individual profile, 2=group, 3=official game group (nil for individual)
+func (s *DevService) ResolveVanityURLRawModel(vanityURL string, urlType *int, opts ...option.RequestOption) (models.SteamVanityURLResponse, error) {
+	return s.ResolveVanityURLRawModelCtx(context.Background(), vanityURL, urlType, opts...)
+}
+
+// ResolveVanityURLRawModelCtx is the context-aware variant of ResolveVanityURLRawModel
+func (s *DevService) ResolveVanityURLRawModelCtx(ctx context.Context, vanityURL string, urlType *int, opts ...option.RequestOption) (models.SteamVanityURLResponse, error) {
+	if vanityName(vanityURL) == "" {
+		return models.SteamVanityURLResponse{}, errVanityURLEmpty
+	}
+	c, method, reqPath, params := s.buildResolveVanityURL(vanityURL, urlType)
+	return api.GetRawModelCtx[models.SteamVanityURLResponse](ctx, c, method, reqPath, params, opts...)
+}
+
+// GetUserGroupListRawModel get groups the player belongs to 获取玩家所属群组
+// 资料未公开时返回 errors.ErrPrivateProfile | Fails with errors.ErrPrivateProfile for private profiles
+//   - steamID: Player SteamID
+func (s *DevService) GetUserGroupListRawModel(steamID string, opts ...option.RequestOption) (models.SteamUserGroupListResponse, error) {
+	return s.GetUserGroupListRawModelCtx(context.Background(), steamID, opts...)
+}
+
+// GetUserGroupListRawModelCtx is the context-aware variant of GetUserGroupListRawModel
+func (s *DevService) GetUserGroupListRawModelCtx(ctx context.Context, steamID string, opts ...option.RequestOption) (models.SteamUserGroupListResponse, error) {
+	if steamID == "" {
+		return models.SteamUserGroupListResponse{}, errors.ErrInvalidSteamID
+	}
+	c, method, reqPath, params := s.buildUserGroupList(steamID)
+	return api.GetRawModelCtx[models.SteamUserGroupListResponse](ctx, c, method, reqPath, params, opts...)
 }
 
 // ============================ Brief Model 精简模型接口 ============================
 
 // GetPlayerSummariesBrief get player's information 获取玩家信息
 //   - steamIDs: Multiple SteamIDs separated by commas (max 100)
-func (s *DevService) GetPlayerSummariesBrief(steamIDs string) ([]models.Player, error) {
+func (s *DevService) GetPlayerSummariesBrief(steamIDs string, opts ...option.RequestOption) ([]models.Player, error) {
+	return s.GetPlayerSummariesBriefCtx(context.Background(), steamIDs, opts...)
+}
 
+// GetPlayerSummariesBriefCtx is the context-aware variant of GetPlayerSummariesBrief
+func (s *DevService) GetPlayerSummariesBriefCtx(ctx context.Context, steamIDs string, opts ...option.RequestOption) ([]models.Player, error) {
 	// 获取原始结构化模型 | Get raw structured model
-	rawPlayers, err := s.GetPlayerSummariesRawModel(steamIDs)
+	rawPlayers, err := s.GetPlayerSummariesRawModelCtx(ctx, steamIDs, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -85,12 +222,159 @@ func (s *DevService) GetPlayerSummariesBrief(steamIDs string) ([]models.Player,
 	return players, nil
 }
 
+// GetFriendListBrief get player's friend list 获取玩家好友列表
+//   - steamID: Player SteamID
+//   - relationship: Relationship filter "friend" or "all" (nil for Steam default)
+func (s *DevService) GetFriendListBrief(steamID string, relationship *string, opts ...option.RequestOption) ([]models.Friend, error) {
+	return s.GetFriendListBriefCtx(context.Background(), steamID, relationship, opts...)
+}
+
+// GetFriendListBriefCtx is the context-aware variant of GetFriendListBrief
+func (s *DevService) GetFriendListBriefCtx(ctx context.Context, steamID string, relationship *string, opts ...option.RequestOption) ([]models.Friend, error) {
+	rawFriends, err := s.GetFriendListRawModelCtx(ctx, steamID, relationship, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	friends := make([]models.Friend, 0, len(rawFriends.FriendsList.Friends))
+	for _, f := range rawFriends.FriendsList.Friends {
+		friends = append(friends, models.Friend{
+			SteamID:      f.SteamID,
+			Relationship: f.Relationship,
+			FriendSince:  util.TimeUnix2String(f.FriendSince), // 格式化成为好友时间 | Format friend-since time
+		})
+	}
+	return friends, nil
+}
+
+// GetPlayerBansBrief get players' VAC, game, community and economy bans 获取玩家封禁记录
+//   - steamIDs: Multiple SteamIDs separated by commas (max 100)
+func (s *DevService) GetPlayerBansBrief(steamIDs string, opts ...option.RequestOption) ([]models.PlayerBan, error) {
+	return s.GetPlayerBansBriefCtx(context.Background(), steamIDs, opts...)
+}
+
+// GetPlayerBansBriefCtx is the context-aware variant of GetPlayerBansBrief
+func (s *DevService) GetPlayerBansBriefCtx(ctx context.Context, steamIDs string, opts ...option.RequestOption) ([]models.PlayerBan, error) {
+	rawBans, err := s.GetPlayerBansRawModelCtx(ctx, steamIDs, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	bans := make([]models.PlayerBan, 0, len(rawBans.Players))
+	for _, p := range rawBans.Players {
+		bans = append(bans, models.PlayerBan{
+			SteamID:          p.SteamID,
+			VACBanned:        p.VACBanned,
+			GameBanned:       p.NumberOfGameBans > 0,
+			CommunityBanned:  p.CommunityBanned,
+			EconomyBanned:    p.EconomyBan == economyBanBanned, // 观察期不算封禁 | Probation is not a ban
+			EconomyBan:       p.EconomyBan,
+			NumberOfVACBans:  p.NumberOfVACBans,
+			NumberOfGameBans: p.NumberOfGameBans,
+			DaysSinceLastBan: p.DaysSinceLastBan,
+		})
+	}
+	return bans, nil
+}
+
+// ResolveVanityURLBrief resolve a vanity URL to a SteamID 解析自定义 URL 对应的 SteamID
+//   - vanityURL: Vanity name or full profile/group URL (e.g. "gabelogannewell")
+//   - urlType: 1=individual profile, 2=group, 3=official game group (nil for individual)
+func (s *DevService) ResolveVanityURLBrief(vanityURL string, urlType *int, opts ...option.RequestOption) (string, error) {
+	return s.ResolveVanityURLBriefCtx(context.Background(), vanityURL, urlType, opts...)
+}
+
+// ResolveVanityURLBriefCtx is the context-aware variant of ResolveVanityURLBrief
+func (s *DevService) ResolveVanityURLBriefCtx(ctx context.Context, vanityURL string, urlType *int, opts ...option.RequestOption) (string, error) {
+	raw, err := s.ResolveVanityURLRawModelCtx(ctx, vanityURL, urlType, opts...)
+	if err != nil {
+		return "", err
+	}
+	return raw.Response.SteamID, nil
+}
+
+// GetUserGroupListBrief get groups the player belongs to 获取玩家所属群组
+//   - steamID: Player SteamID
+func (s *DevService) GetUserGroupListBrief(steamID string, opts ...option.RequestOption) ([]models.UserGroup, error) {
+	return s.GetUserGroupListBriefCtx(context.Background(), steamID, opts...)
+}
+
+// GetUserGroupListBriefCtx is the context-aware variant of GetUserGroupListBrief
+func (s *DevService) GetUserGroupListBriefCtx(ctx context.Context, steamID string, opts ...option.RequestOption) ([]models.UserGroup, error) {
+	rawGroups, err := s.GetUserGroupListRawModelCtx(ctx, steamID, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make([]models.UserGroup, 0, len(rawGroups.Response.Groups))
+	for _, g := range rawGroups.Response.Groups {
+		group := models.UserGroup{GroupID: g.GID}
+		// 群组账号 ID 转换为 64 位 SteamID | Convert the group account ID to a 64-bit SteamID
+		if gid, err := strconv.ParseUint(g.GID, 10, 32); err == nil {
+			group.SteamID = util.Uint642String(util.STEAM_GROUP_ID_BASE + gid)
+		}
+		groups = append(groups, group)
+	}
+	return groups, nil
+}
+
 // ============================ Default Interface 默认接口 ============================
 
 // GetPlayerSummaries get player's information 获取玩家信息
 //   - steamIDs: Multiple SteamIDs separated by commas (max 100)
-func (s *DevService) GetPlayerSummaries(steamIDs string) ([]models.Player, error) {
-	return s.GetPlayerSummariesBrief(steamIDs)
+func (s *DevService) GetPlayerSummaries(steamIDs string, opts ...option.RequestOption) ([]models.Player, error) {
+	return s.GetPlayerSummariesBrief(steamIDs, opts...)
+}
+
+// GetPlayerSummariesCtx is the context-aware variant of GetPlayerSummaries
+func (s *DevService) GetPlayerSummariesCtx(ctx context.Context, steamIDs string, opts ...option.RequestOption) ([]models.Player, error) {
+	return s.GetPlayerSummariesBriefCtx(ctx, steamIDs, opts...)
+}
+
+// GetFriendList get player's friend list 获取玩家好友列表
+//   - steamID: Player SteamID
+//   - relationship: Relationship filter "friend" or "all" (nil for Steam default)
+func (s *DevService) GetFriendList(steamID string, relationship *string, opts ...option.RequestOption) ([]models.Friend, error) {
+	return s.GetFriendListBrief(steamID, relationship, opts...)
+}
+
+// GetFriendListCtx is the context-aware variant of GetFriendList
+func (s *DevService) GetFriendListCtx(ctx context.Context, steamID string, relationship *string, opts ...option.RequestOption) ([]models.Friend, error) {
+	return s.GetFriendListBriefCtx(ctx, steamID, relationship, opts...)
+}
+
+// GetPlayerBans get players' VAC, game, community and economy bans 获取玩家封禁记录
+//   - steamIDs: Multiple SteamIDs separated by commas (max 100)
+func (s *DevService) GetPlayerBans(steamIDs string, opts ...option.RequestOption) ([]models.PlayerBan, error) {
+	return s.GetPlayerBansBrief(steamIDs, opts...)
+}
+
+// GetPlayerBansCtx is the context-aware variant of GetPlayerBans
+func (s *DevService) GetPlayerBansCtx(ctx context.Context, steamIDs string, opts ...option.RequestOption) ([]models.PlayerBan, error) {
+	return s.GetPlayerBansBriefCtx(ctx, steamIDs, opts...)
+}
+
+// ResolveVanityURL resolve a vanity URL to a SteamID 解析自定义 URL 对应的 SteamID
+//   - vanityURL: Vanity name or full profile/group URL (e.g. "gabelogannewell")
+//   - urlType: 1=individual profile, 2=group, 3=official game group (nil for individual)
+func (s *DevService) ResolveVanityURL(vanityURL string, urlType *int, opts ...option.RequestOption) (string, error) {
+	return s.ResolveVanityURLBrief(vanityURL, urlType, opts...)
+}
+
+// ResolveVanityURLCtx is the context-aware variant of ResolveVanityURL
+func (s *DevService) ResolveVanityURLCtx(ctx context.Context, vanityURL string, urlType *int, opts ...option.RequestOption) (string, error) {
+	return s.ResolveVanityURLBriefCtx(ctx, vanityURL, urlType, opts...)
+}
+
+// GetUserGroupList get groups the player belongs to 获取玩家所属群组
+//   - steamID: Player SteamID
+func (s *DevService) GetUserGroupList(steamID string, opts ...option.RequestOption) ([]models.UserGroup, error) {
+	return s.GetUserGroupListBrief(steamID, opts...)
+}
+
+// GetUserGroupListCtx is the context-aware variant of GetUserGroupList
+func (s *DevService) GetUserGroupListCtx(ctx context.Context, steamID string, opts ...option.RequestOption) ([]models.UserGroup, error) {
+	return s.GetUserGroupListBriefCtx(ctx, steamID, opts...)
 }
 
 // ============================ Build 构造入参 ============================
@@ -105,3 +389,90 @@ func (s *DevService) buildPlayerSummaries(steamIDs string) (
 	params.Set("steamids", steamIDs)
 	return s.client, "GET", ISteamUser + "/GetPlayerSummaries/v2/", params
 }
+
+// buildFriendList builds input params.
+func (s *DevService) buildFriendList(steamID string, relationship *string) (
+	c *client.Client,
+	method, reqPath string,
+	params url.Values,
+) {
+	params = url.Values{}
+	params.Set("steamid", steamID)
+	if relationship != nil {
+		params.Set("relationship", *relationship)
+	}
+	return s.client, "GET", ISteamUser + "/GetFriendList/v1/", params
+}
+
+// buildPlayerBans builds input params.
+func (s *DevService) buildPlayerBans(steamIDs string) (
+	c *client.Client,
+	method, reqPath string,
+	params url.Values,
+) {
+	params = url.Values{}
+	params.Set("steamids", steamIDs)
+	return s.client, "GET", ISteamUser + "/GetPlayerBans/v1/", params
+}
+
+// buildResolveVanityURL builds input params.
+func (s *DevService) buildResolveVanityURL(vanityURL string, urlType *int) (
+	c *client.Client,
+	method, reqPath string,
+	params url.Values,
+) {
+	params = url.Values{}
+	params.Set("vanityurl", vanityName(vanityURL))
+	if urlType != nil {
+		params.Set("url_type", util.Int2String(*urlType))
+	}
+	return s.client, "GET", ISteamUser + "/ResolveVanityURL/v1/", params
+}
+
+// buildUserGroupList builds input params.
+func (s *DevService) buildUserGroupList(steamID string) (
+	c *client.Client,
+	method, reqPath string,
+	params url.Values,
+) {
+	params = url.Values{}
+	params.Set("steamid", steamID)
+	return s.client, "GET", ISteamUser + "/GetUserGroupList/v1/", params
+}
+
+// ============================ 工具方法 ============================
+
+// economyBanBanned 交易封禁状态中的封禁值 | EconomyBan value of an actual trade ban
+const economyBanBanned = "banned"
+
+// errVanityURLEmpty 自定义 URL 为空 | Empty vanity URL
+var errVanityURLEmpty = errors.NewWithType(errors.ErrTypeParam, "vanity url is empty", nil)
+
+// checkSteamIDList 校验逗号分隔的 SteamID 列表(非空且不超过 100 个)
+// checkSteamIDList validates a comma separated SteamID list (non-empty, at most 100)
+func checkSteamIDList(steamIDs string) error {
+	if steamIDs == "" {
+		return errors.ErrInvalidSteamID
+	}
+	if len(strings.Split(steamIDs, ",")) > 100 {
+		return errors.NewWithType(errors.ErrTypeParam,
+			"steamids count exceeds 100 (Steam API maximum limit)", nil)
+	}
+	return nil
+}
+
+// vanityName 从完整地址中提取自定义名称, 如 "https://steamcommunity.com/id/name/" -> "name"
+// vanityName extracts the vanity name from a full URL, e.g. "https://steamcommunity.com/id/name/" -> "name"
+func vanityName(vanityURL string) string {
+	name := strings.Trim(strings.TrimSpace(vanityURL), "/")
+	for _, marker := range []string{"/id/", "/groups/", "/games/"} {
+		if i := strings.LastIndex(name, marker); i >= 0 {
+			name = name[i+len(marker):]
+			break
+		}
+	}
+	if i := strings.IndexAny(name, "/?#"); i >= 0 {
+		name = name[:i]
+	}
+	return name
+}
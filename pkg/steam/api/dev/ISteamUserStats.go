@@ -1,12 +1,16 @@
 package dev
 
 import (
+	"context"
 	"net/url"
+	"sync"
 
 	"github.com/GoFurry/gf-steam-sdk/internal/api"
 	"github.com/GoFurry/gf-steam-sdk/internal/client"
 	"github.com/GoFurry/gf-steam-sdk/pkg/models"
+	"github.com/GoFurry/gf-steam-sdk/pkg/option"
 	"github.com/GoFurry/gf-steam-sdk/pkg/util"
+	"github.com/GoFurry/gf-steam-sdk/pkg/util/errors"
 )
 
 const (
@@ -19,8 +23,67 @@ const (
 //   - steamID: Player SteamID
 //   - appID: Game AppID
 //   - lang: Language (e.g. zh/en)
-func (s *DevService) GetPlayerAchievementsRawBytes(steamID string, appID uint64, lang string) (respBytes []byte, err error) {
-	return api.GetRawBytes(s.buildPlayerAchievements(steamID, appID, lang))
+func (s *DevService) GetPlayerAchievementsRawBytes(steamID string, appID uint64, lang string, opts ...option.RequestOption) (respBytes []byte, err error) {
+	return s.GetPlayerAchievementsRawBytesCtx(context.Background(), steamID, appID, lang, opts...)
+}
+
+// GetPlayerAchievementsRawBytesCtx is the context-aware variant of GetPlayerAchievementsRawBytes
+func (s *DevService) GetPlayerAchievementsRawBytesCtx(ctx context.Context, steamID string, appID uint64, lang string, opts ...option.RequestOption) (respBytes []byte, err error) {
+	c, method, reqPath, params := s.buildPlayerAchievements(steamID, appID, lang)
+	return api.GetRawBytesCtx(ctx, c, method, reqPath, params, opts...)
+}
+
+// GetSchemaForGameRawBytes get game's achievement and stat definitions 获取游戏成就与统计项定义
+//   - appID: Game AppID
+//   - lang: Language of names and descriptions (nil for English)
+func (s *DevService) GetSchemaForGameRawBytes(appID uint64, lang *string, opts ...option.RequestOption) (respBytes []byte, err error) {
+	return s.GetSchemaForGameRawBytesCtx(context.Background(), appID, lang, opts...)
+}
+
+// GetSchemaForGameRawBytesCtx is the context-aware variant of GetSchemaForGameRawBytes
+func (s *DevService) GetSchemaForGameRawBytesCtx(ctx context.Context, appID uint64, lang *string, opts ...option.RequestOption) (respBytes []byte, err error) {
+	c, method, reqPath, params := s.buildSchemaForGame(appID, lang)
+	return api.GetRawBytesCtx(ctx, c, method, reqPath, params, opts...)
+}
+
+// GetUserStatsForGameRawBytes get player's game stats 获取玩家单游戏统计数据
+//   - steamID: Player SteamID
+//   - appID: Game AppID
+func (s *DevService) GetUserStatsForGameRawBytes(steamID string, appID uint64, opts ...option.RequestOption) (respBytes []byte, err error) {
+	return s.GetUserStatsForGameRawBytesCtx(context.Background(), steamID, appID, opts...)
+}
+
+// GetUserStatsForGameRawBytesCtx is the context-aware variant of GetUserStatsForGameRawBytes
+func (s *DevService) GetUserStatsForGameRawBytesCtx(ctx context.Context, steamID string, appID uint64, opts ...option.RequestOption) (respBytes []byte, err error) {
+	if steamID == "" {
+		return respBytes, errors.ErrInvalidSteamID
+	}
+	c, method, reqPath, params := s.buildUserStatsForGame(steamID, appID)
+	return api.GetRawBytesCtx(ctx, c, method, reqPath, params, opts...)
+}
+
+// GetGlobalAchievementPercentagesForAppRawBytes get global achievement unlock percentages 获取成就全球完成率
+//   - appID: Game AppID
+func (s *DevService) GetGlobalAchievementPercentagesForAppRawBytes(appID uint64, opts ...option.RequestOption) (respBytes []byte, err error) {
+	return s.GetGlobalAchievementPercentagesForAppRawBytesCtx(context.Background(), appID, opts...)
+}
+
+// GetGlobalAchievementPercentagesForAppRawBytesCtx is the context-aware variant of GetGlobalAchievementPercentagesForAppRawBytes
+func (s *DevService) GetGlobalAchievementPercentagesForAppRawBytesCtx(ctx context.Context, appID uint64, opts ...option.RequestOption) (respBytes []byte, err error) {
+	c, method, reqPath, params := s.buildGlobalAchievementPercentages(appID)
+	return api.GetRawBytesCtx(ctx, c, method, reqPath, params, opts...)
+}
+
+// GetNumberOfCurrentPlayersRawBytes get game's current player count 获取游戏当前在线人数
+//   - appID: Game AppID
+func (s *DevService) GetNumberOfCurrentPlayersRawBytes(appID uint64, opts ...option.RequestOption) (respBytes []byte, err error) {
+	return s.GetNumberOfCurrentPlayersRawBytesCtx(context.Background(), appID, opts...)
+}
+
+// GetNumberOfCurrentPlayersRawBytesCtx is the context-aware variant of GetNumberOfCurrentPlayersRawBytes
+func (s *DevService) GetNumberOfCurrentPlayersRawBytesCtx(ctx context.Context, appID uint64, opts ...option.RequestOption) (respBytes []byte, err error) {
+	c, method, reqPath, params := s.buildNumberOfCurrentPlayers(appID)
+	return api.GetRawBytesCtx(ctx, c, method, reqPath, params, opts...)
 }
 
 // ============================ 结构化原始模型接口 ============================
@@ -29,8 +92,69 @@ func (s *DevService) GetPlayerAchievementsRawBytes(steamID string, appID uint64,
 //   - steamID: Player SteamID
 //   - appID: Game AppID
 //   - lang: Language (e.g. zh/en)
-func (s *DevService) GetPlayerAchievementsRawModel(steamID string, appID uint64, lang string) (models.SteamPlayerAchievementsResponse, error) {
-	return api.GetRawModel[models.SteamPlayerAchievementsResponse](s.buildPlayerAchievements(steamID, appID, lang))
+func (s *DevService) GetPlayerAchievementsRawModel(steamID string, appID uint64, lang string, opts ...option.RequestOption) (models.SteamPlayerAchievementsResponse, error) {
+	return s.GetPlayerAchievementsRawModelCtx(context.Background(), steamID, appID, lang, opts...)
+}
+
+// GetPlayerAchievementsRawModelCtx is the context-aware variant of GetPlayerAchievementsRawModel
+func (s *DevService) GetPlayerAchievementsRawModelCtx(ctx context.Context, steamID string, appID uint64, lang string, opts ...option.RequestOption) (models.SteamPlayerAchievementsResponse, error) {
+	c, method, reqPath, params := s.buildPlayerAchievements(steamID, appID, lang)
+	return api.GetRawModelCtx[models.SteamPlayerAchievementsResponse](ctx, c, method, reqPath, params, opts...)
+}
+
+// GetSchemaForGameRawModel get game's achievement and stat definitions 获取游戏成就与统计项定义
+// 游戏没有成就与统计项时返回 errors.ErrNoStats | Fails with errors.ErrNoStats when the app has no stats schema
+//   - appID: Game AppID
+//   - lang: Language of names and descriptions (nil for English)
+func (s *DevService) GetSchemaForGameRawModel(appID uint64, lang *string, opts ...option.RequestOption) (models.SteamGameSchemaResponse, error) {
+	return s.GetSchemaForGameRawModelCtx(context.Background(), appID, lang, opts...)
+}
+
+// GetSchemaForGameRawModelCtx is the context-aware variant of GetSchemaForGameRawModel
+func (s *DevService) GetSchemaForGameRawModelCtx(ctx context.Context, appID uint64, lang *string, opts ...option.RequestOption) (models.SteamGameSchemaResponse, error) {
+	c, method, reqPath, params := s.buildSchemaForGame(appID, lang)
+	return api.GetRawModelCtx[models.SteamGameSchemaResponse](ctx, c, method, reqPath, params, opts...)
+}
+
+// GetUserStatsForGameRawModel get player's game stats 获取玩家单游戏统计数据
+//   - steamID: Player SteamID
+//   - appID: Game AppID
+func (s *DevService) GetUserStatsForGameRawModel(steamID string, appID uint64, opts ...option.RequestOption) (models.SteamUserStatsResponse, error) {
+	return s.GetUserStatsForGameRawModelCtx(context.Background(), steamID, appID, opts...)
+}
+
+// GetUserStatsForGameRawModelCtx is the context-aware variant of GetUserStatsForGameRawModel
+func (s *DevService) GetUserStatsForGameRawModelCtx(ctx context.Context, steamID string, appID uint64, opts ...option.RequestOption) (models.SteamUserStatsResponse, error) {
+	if steamID == "" {
+		return models.SteamUserStatsResponse{}, errors.ErrInvalidSteamID
+	}
+	c, method, reqPath, params := s.buildUserStatsForGame(steamID, appID)
+	return api.GetRawModelCtx[models.SteamUserStatsResponse](ctx, c, method, reqPath, params, opts...)
+}
+
+// GetGlobalAchievementPercentagesForAppRawModel get global achievement unlock percentages 获取成就全球完成率
+//   - appID: Game AppID
+func (s *DevService) GetGlobalAchievementPercentagesForAppRawModel(appID uint64, opts ...option.RequestOption) (models.SteamGlobalAchievementPercentagesResponse, error) {
+	return s.GetGlobalAchievementPercentagesForAppRawModelCtx(context.Background(), appID, opts...)
+}
+
+// GetGlobalAchievementPercentagesForAppRawModelCtx is the context-aware variant of GetGlobalAchievementPercentagesForAppRawModel
+func (s *DevService) GetGlobalAchievementPercentagesForAppRawModelCtx(ctx context.Context, appID uint64, opts ...option.RequestOption) (models.SteamGlobalAchievementPercentagesResponse, error) {
+	c, method, reqPath, params := s.buildGlobalAchievementPercentages(appID)
+	return api.GetRawModelCtx[models.SteamGlobalAchievementPercentagesResponse](ctx, c, method, reqPath, params, opts...)
+}
+
+// GetNumberOfCurrentPlayersRawModel get game's current player count 获取游戏当前在线人数
+// 应用不存在时返回 errors.ErrAppNotFound | Fails with errors.ErrAppNotFound for unknown apps
+//   - appID: Game AppID
+func (s *DevService) GetNumberOfCurrentPlayersRawModel(appID uint64, opts ...option.RequestOption) (models.SteamCurrentPlayersResponse, error) {
+	return s.GetNumberOfCurrentPlayersRawModelCtx(context.Background(), appID, opts...)
+}
+
+// GetNumberOfCurrentPlayersRawModelCtx is the context-aware variant of GetNumberOfCurrentPlayersRawModel
+func (s *DevService) GetNumberOfCurrentPlayersRawModelCtx(ctx context.Context, appID uint64, opts ...option.RequestOption) (models.SteamCurrentPlayersResponse, error) {
+	c, method, reqPath, params := s.buildNumberOfCurrentPlayers(appID)
+	return api.GetRawModelCtx[models.SteamCurrentPlayersResponse](ctx, c, method, reqPath, params, opts...)
 }
 
 // ============================ Brief Model 精简模型接口 ============================
@@ -39,9 +163,14 @@ func (s *DevService) GetPlayerAchievementsRawModel(steamID string, appID uint64,
 //   - steamID: Player SteamID
 //   - appID: Game AppID
 //   - lang: Language (e.g. zh/en)
-func (s *DevService) GetPlayerAchievementsBrief(steamID string, appID uint64, lang string) ([]models.PlayerAchievement, error) {
+func (s *DevService) GetPlayerAchievementsBrief(steamID string, appID uint64, lang string, opts ...option.RequestOption) ([]models.PlayerAchievement, error) {
+	return s.GetPlayerAchievementsBriefCtx(context.Background(), steamID, appID, lang, opts...)
+}
+
+// GetPlayerAchievementsBriefCtx is the context-aware variant of GetPlayerAchievementsBrief
+func (s *DevService) GetPlayerAchievementsBriefCtx(ctx context.Context, steamID string, appID uint64, lang string, opts ...option.RequestOption) ([]models.PlayerAchievement, error) {
 	// 获取原始结构化模型 | Get raw structured model
-	rawStats, err := s.GetPlayerAchievementsRawModel(steamID, appID, lang)
+	rawStats, err := s.GetPlayerAchievementsRawModelCtx(ctx, steamID, appID, lang, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -65,14 +194,288 @@ func (s *DevService) GetPlayerAchievementsBrief(steamID string, appID uint64, la
 	return achievements, nil
 }
 
+// GetSchemaForGameBrief get game's achievement and stat definitions 获取游戏成就与统计项定义
+//   - appID: Game AppID
+//   - lang: Language of names and descriptions (nil for English)
+func (s *DevService) GetSchemaForGameBrief(appID uint64, lang *string, opts ...option.RequestOption) (models.GameSchema, error) {
+	return s.GetSchemaForGameBriefCtx(context.Background(), appID, lang, opts...)
+}
+
+// GetSchemaForGameBriefCtx is the context-aware variant of GetSchemaForGameBrief
+func (s *DevService) GetSchemaForGameBriefCtx(ctx context.Context, appID uint64, lang *string, opts ...option.RequestOption) (models.GameSchema, error) {
+	rawSchema, err := s.GetSchemaForGameRawModelCtx(ctx, appID, lang, opts...)
+	if err != nil {
+		return models.GameSchema{}, err
+	}
+
+	game := rawSchema.Game
+	schema := models.GameSchema{
+		AppID:        appID,
+		GameName:     game.GameName,
+		GameVersion:  game.GameVersion,
+		Achievements: make([]models.SchemaAchievement, 0, len(game.AvailableGameStats.Achievements)),
+		Stats:        make([]models.SchemaStat, 0, len(game.AvailableGameStats.Stats)),
+	}
+	for _, a := range game.AvailableGameStats.Achievements {
+		schema.Achievements = append(schema.Achievements, models.SchemaAchievement{
+			APIName:     a.Name,
+			DisplayName: a.DisplayName,
+			Description: a.Description,
+			Hidden:      a.Hidden == 1, // 布尔化隐藏状态 | Booleanize hidden flag
+			IconURL:     a.Icon,
+			IconGrayURL: a.IconGray,
+		})
+	}
+	for _, st := range game.AvailableGameStats.Stats {
+		schema.Stats = append(schema.Stats, models.SchemaStat{
+			Name:         st.Name,
+			DisplayName:  st.DisplayName,
+			DefaultValue: st.DefaultValue,
+		})
+	}
+	return schema, nil
+}
+
+// GetUserStatsForGameBrief get player's game stats 获取玩家单游戏统计数据
+//   - steamID: Player SteamID
+//   - appID: Game AppID
+func (s *DevService) GetUserStatsForGameBrief(steamID string, appID uint64, opts ...option.RequestOption) (models.UserGameStats, error) {
+	return s.GetUserStatsForGameBriefCtx(context.Background(), steamID, appID, opts...)
+}
+
+// GetUserStatsForGameBriefCtx is the context-aware variant of GetUserStatsForGameBrief
+func (s *DevService) GetUserStatsForGameBriefCtx(ctx context.Context, steamID string, appID uint64, opts ...option.RequestOption) (models.UserGameStats, error) {
+	rawStats, err := s.GetUserStatsForGameRawModelCtx(ctx, steamID, appID, opts...)
+	if err != nil {
+		return models.UserGameStats{}, err
+	}
+
+	ps := rawStats.PlayerStats
+	stats := models.UserGameStats{
+		SteamID:      ps.SteamID,
+		GameName:     ps.GameName,
+		AppID:        appID,
+		Stats:        make(map[string]float64, len(ps.Stats)),
+		Achievements: make([]string, 0, len(ps.Achievements)),
+	}
+	for _, st := range ps.Stats {
+		stats.Stats[st.Name] = st.Value
+	}
+	for _, a := range ps.Achievements {
+		if a.Achieved == 1 {
+			stats.Achievements = append(stats.Achievements, a.Name)
+		}
+	}
+	return stats, nil
+}
+
+// GetGlobalAchievementPercentagesForAppBrief get global achievement unlock percentages 获取成就全球完成率
+//   - appID: Game AppID
+func (s *DevService) GetGlobalAchievementPercentagesForAppBrief(appID uint64, opts ...option.RequestOption) ([]models.GlobalAchievementPercent, error) {
+	return s.GetGlobalAchievementPercentagesForAppBriefCtx(context.Background(), appID, opts...)
+}
+
+// GetGlobalAchievementPercentagesForAppBriefCtx is the context-aware variant of GetGlobalAchievementPercentagesForAppBrief
+func (s *DevService) GetGlobalAchievementPercentagesForAppBriefCtx(ctx context.Context, appID uint64, opts ...option.RequestOption) ([]models.GlobalAchievementPercent, error) {
+	rawPercentages, err := s.GetGlobalAchievementPercentagesForAppRawModelCtx(ctx, appID, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	raw := rawPercentages.AchievementPercentages.Achievements
+	percentages := make([]models.GlobalAchievementPercent, 0, len(raw))
+	for _, a := range raw {
+		percentages = append(percentages, models.GlobalAchievementPercent{
+			APIName: a.Name,
+			Percent: float64(a.Percent),
+		})
+	}
+	return percentages, nil
+}
+
+// GetNumberOfCurrentPlayersBrief get game's current player count 获取游戏当前在线人数
+//   - appID: Game AppID
+func (s *DevService) GetNumberOfCurrentPlayersBrief(appID uint64, opts ...option.RequestOption) (int, error) {
+	return s.GetNumberOfCurrentPlayersBriefCtx(context.Background(), appID, opts...)
+}
+
+// GetNumberOfCurrentPlayersBriefCtx is the context-aware variant of GetNumberOfCurrentPlayersBrief
+func (s *DevService) GetNumberOfCurrentPlayersBriefCtx(ctx context.Context, appID uint64, opts ...option.RequestOption) (int, error) {
+	rawCount, err := s.GetNumberOfCurrentPlayersRawModelCtx(ctx, appID, opts...)
+	if err != nil {
+		return 0, err
+	}
+	return rawCount.Response.PlayerCount, nil
+}
+
+// GetAchievementReportBrief get player's achievement report 获取玩家单游戏成就报告
+// 并发请求玩家成就、成就定义与全球完成率, 按成就定义顺序合并为一份报告
+// Fetches player achievements, the schema and global percentages concurrently and joins them in schema order
+//   - steamID: Player SteamID
+//   - appID: Game AppID
+//   - lang: Language (e.g. zh/en)
+func (s *DevService) GetAchievementReportBrief(steamID string, appID uint64, lang string, opts ...option.RequestOption) (models.AchievementReport, error) {
+	return s.GetAchievementReportBriefCtx(context.Background(), steamID, appID, lang, opts...)
+}
+
+// GetAchievementReportBriefCtx is the context-aware variant of GetAchievementReportBrief
+func (s *DevService) GetAchievementReportBriefCtx(ctx context.Context, steamID string, appID uint64, lang string, opts ...option.RequestOption) (models.AchievementReport, error) {
+	var (
+		wg                              sync.WaitGroup
+		player                          models.SteamPlayerAchievementsResponse
+		schema                          models.SteamGameSchemaResponse
+		global                          models.SteamGlobalAchievementPercentagesResponse
+		playerErr, schemaErr, globalErr error
+	)
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		player, playerErr = s.GetPlayerAchievementsRawModelCtx(ctx, steamID, appID, lang, opts...)
+	}()
+	go func() {
+		defer wg.Done()
+		schema, schemaErr = s.GetSchemaForGameRawModelCtx(ctx, appID, &lang, opts...)
+	}()
+	go func() {
+		defer wg.Done()
+		global, globalErr = s.GetGlobalAchievementPercentagesForAppRawModelCtx(ctx, appID, opts...)
+	}()
+	wg.Wait()
+	for _, err := range []error{playerErr, schemaErr, globalErr} {
+		if err != nil {
+			return models.AchievementReport{}, err
+		}
+	}
+
+	// 按成就唯一标识索引玩家解锁状态与全球完成率 | Index unlocks and global percentages by API name
+	type unlock struct {
+		achieved    bool
+		unlockTime  int64
+		name        string
+		description string
+	}
+	unlocks := make(map[string]unlock, len(player.PlayerStats.Achievements))
+	for _, a := range player.PlayerStats.Achievements {
+		unlocks[a.APIName] = unlock{a.Achieved == 1, a.UnlockTime, a.Name, a.Description}
+	}
+	percents := make(map[string]float64, len(global.AchievementPercentages.Achievements))
+	for _, a := range global.AchievementPercentages.Achievements {
+		percents[a.Name] = float64(a.Percent)
+	}
+
+	defs := schema.Game.AvailableGameStats.Achievements
+	report := models.AchievementReport{
+		SteamID:      player.PlayerStats.SteamID,
+		GameName:     player.PlayerStats.GameName,
+		AppID:        appID,
+		Total:        len(defs),
+		Achievements: make([]models.AchievementReportItem, 0, len(defs)),
+	}
+	for _, def := range defs {
+		u := unlocks[def.Name]
+		item := models.AchievementReportItem{
+			APIName:       def.Name,
+			Name:          def.DisplayName,
+			Description:   def.Description,
+			Hidden:        def.Hidden == 1,
+			Achieved:      u.achieved,
+			UnlockTime:    u.unlockTime,
+			UnlockTimeStr: util.TimeUnix2String(u.unlockTime),
+			IconURL:       def.IconGray,
+			IconGrayURL:   def.IconGray,
+			GlobalPercent: percents[def.Name],
+		}
+		// 隐藏成就的定义不含描述, 以玩家成就中的文本补全 | Hidden definitions omit the description, fill it from the player's achievements
+		if u.name != "" {
+			item.Name = u.name
+		}
+		if u.description != "" {
+			item.Description = u.description
+		}
+		if u.achieved {
+			item.IconURL = def.Icon
+			report.Unlocked++
+		}
+		report.Achievements = append(report.Achievements, item)
+	}
+	if report.Total > 0 {
+		report.CompletionRate = float64(report.Unlocked) * 100 / float64(report.Total)
+	}
+	return report, nil
+}
+
 // ============================ Default Interface 默认接口 ============================
 
 // GetPlayerAchievements get player's game achievements 获取玩家单游戏成就
 //   - steamID: Player SteamID
 //   - appID: Game AppID
 //   - lang: Language (e.g. zh/en)
-func (s *DevService) GetPlayerAchievements(steamID string, appID uint64, lang string) ([]models.PlayerAchievement, error) {
-	return s.GetPlayerAchievementsBrief(steamID, appID, lang)
+func (s *DevService) GetPlayerAchievements(steamID string, appID uint64, lang string, opts ...option.RequestOption) ([]models.PlayerAchievement, error) {
+	return s.GetPlayerAchievementsBrief(steamID, appID, lang, opts...)
+}
+
+// GetPlayerAchievementsCtx is the context-aware variant of GetPlayerAchievements
+func (s *DevService) GetPlayerAchievementsCtx(ctx context.Context, steamID string, appID uint64, lang string, opts ...option.RequestOption) ([]models.PlayerAchievement, error) {
+	return s.GetPlayerAchievementsBriefCtx(ctx, steamID, appID, lang, opts...)
+}
+
+// GetSchemaForGame get game's achievement and stat definitions 获取游戏成就与统计项定义
+//   - appID: Game AppID
+//   - lang: Language of names and descriptions (nil for English)
+func (s *DevService) GetSchemaForGame(appID uint64, lang *string, opts ...option.RequestOption) (models.GameSchema, error) {
+	return s.GetSchemaForGameBrief(appID, lang, opts...)
+}
+
+// GetSchemaForGameCtx is the context-aware variant of GetSchemaForGame
+func (s *DevService) GetSchemaForGameCtx(ctx context.Context, appID uint64, lang *string, opts ...option.RequestOption) (models.GameSchema, error) {
+	return s.GetSchemaForGameBriefCtx(ctx, appID, lang, opts...)
+}
+
+// GetUserStatsForGame get player's game stats 获取玩家单游戏统计数据
+//   - steamID: Player SteamID
+//   - appID: Game AppID
+func (s *DevService) GetUserStatsForGame(steamID string, appID uint64, opts ...option.RequestOption) (models.UserGameStats, error) {
+	return s.GetUserStatsForGameBrief(steamID, appID, opts...)
+}
+
+// GetUserStatsForGameCtx is the context-aware variant of GetUserStatsForGame
+func (s *DevService) GetUserStatsForGameCtx(ctx context.Context, steamID string, appID uint64, opts ...option.RequestOption) (models.UserGameStats, error) {
+	return s.GetUserStatsForGameBriefCtx(ctx, steamID, appID, opts...)
+}
+
+// GetGlobalAchievementPercentagesForApp get global achievement unlock percentages 获取成就全球完成率
+//   - appID: Game AppID
+func (s *DevService) GetGlobalAchievementPercentagesForApp(appID uint64, opts ...option.RequestOption) ([]models.GlobalAchievementPercent, error) {
+	return s.GetGlobalAchievementPercentagesForAppBrief(appID, opts...)
+}
+
+// GetGlobalAchievementPercentagesForAppCtx is the context-aware variant of GetGlobalAchievementPercentagesForApp
+func (s *DevService) GetGlobalAchievementPercentagesForAppCtx(ctx context.Context, appID uint64, opts ...option.RequestOption) ([]models.GlobalAchievementPercent, error) {
+	return s.GetGlobalAchievementPercentagesForAppBriefCtx(ctx, appID, opts...)
+}
+
+// GetNumberOfCurrentPlayers get game's current player count 获取游戏当前在线人数
+//   - appID: Game AppID
+func (s *DevService) GetNumberOfCurrentPlayers(appID uint64, opts ...option.RequestOption) (int, error) {
+	return s.GetNumberOfCurrentPlayersBrief(appID, opts...)
+}
+
+// GetNumberOfCurrentPlayersCtx is the context-aware variant of GetNumberOfCurrentPlayers
+func (s *DevService) GetNumberOfCurrentPlayersCtx(ctx context.Context, appID uint64, opts ...option.RequestOption) (int, error) {
+	return s.GetNumberOfCurrentPlayersBriefCtx(ctx, appID, opts...)
+}
+
+// GetAchievementReport get player's achievement report with icons and global rarity 获取玩家单游戏成就报告(含图标与全球稀有度)
+//   - steamID: Player SteamID
+//   - appID: Game AppID
+//   - lang: Language (e.g. zh/en)
+func (s *DevService) GetAchievementReport(steamID string, appID uint64, lang string, opts ...option.RequestOption) (models.AchievementReport, error) {
+	return s.GetAchievementReportBrief(steamID, appID, lang, opts...)
+}
+
+// GetAchievementReportCtx is the context-aware variant of GetAchievementReport
+func (s *DevService) GetAchievementReportCtx(ctx context.Context, steamID string, appID uint64, lang string, opts ...option.RequestOption) (models.AchievementReport, error) {
+	return s.GetAchievementReportBriefCtx(ctx, steamID, appID, lang, opts...)
 }
 
 // ============================ Build 构造入参 ============================
@@ -89,3 +492,51 @@ func (s *DevService) buildPlayerAchievements(steamID string, appID uint64, lang
 	params.Set("l", lang)
 	return s.client, "GET", ISteamUserStats + "/GetPlayerAchievements/v1/", params
 }
+
+// buildSchemaForGame builds input params.
+func (s *DevService) buildSchemaForGame(appID uint64, lang *string) (
+	c *client.Client,
+	method, reqPath string,
+	params url.Values,
+) {
+	params = url.Values{}
+	params.Set("appid", util.Uint642String(appID))
+	if lang != nil {
+		params.Set("l", *lang)
+	}
+	return s.client, "GET", ISteamUserStats + "/GetSchemaForGame/v2/", params
+}
+
+// buildUserStatsForGame builds input params.
+func (s *DevService) buildUserStatsForGame(steamID string, appID uint64) (
+	c *client.Client,
+	method, reqPath string,
+	params url.Values,
+) {
+	params = url.Values{}
+	params.Set("steamid", steamID)
+	params.Set("appid", util.Uint642String(appID))
+	return s.client, "GET", ISteamUserStats + "/GetUserStatsForGame/v2/", params
+}
+
+// buildGlobalAchievementPercentages builds input params.
+func (s *DevService) buildGlobalAchievementPercentages(appID uint64) (
+	c *client.Client,
+	method, reqPath string,
+	params url.Values,
+) {
+	params = url.Values{}
+	params.Set("gameid", util.Uint642String(appID))
+	return s.client, "GET", ISteamUserStats + "/GetGlobalAchievementPercentagesForApp/v2/", params
+}
+
+// buildNumberOfCurrentPlayers builds input params.
+func (s *DevService) buildNumberOfCurrentPlayers(appID uint64) (
+	c *client.Client,
+	method, reqPath string,
+	params url.Values,
+) {
+	params = url.Values{}
+	params.Set("appid", util.Uint642String(appID))
+	return s.client, "GET", ISteamUserStats + "/GetNumberOfCurrentPlayers/v1/", params
+}
@@ -0,0 +1,390 @@
+package dev
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/GoFurry/gf-steam-sdk/internal/api"
+	"github.com/GoFurry/gf-steam-sdk/internal/client"
+	"github.com/GoFurry/gf-steam-sdk/pkg/models"
+	"github.com/GoFurry/gf-steam-sdk/pkg/option"
+	"github.com/GoFurry/gf-steam-sdk/pkg/util"
+	"github.com/bytedance/sonic"
+)
+
+const (
+	IStoreBrowseService = util.STEAM_API_BASE_URL + "IStoreBrowseService"
+)
+
+// StoreBrowseQuery IStoreBrowseService 可选参数, 字段为 nil 时不发送(使用 Steam 默认值)
+// StoreBrowseQuery holds the optional IStoreBrowseService params; nil fields are omitted (Steam defaults apply)
+type StoreBrowseQuery struct {
+	Lang        *string                 // 语言(如 english, schinese) | Language (e.g. english, schinese)
+	CC          *string                 // 国家/地区代码(如 US, CN) | Country code (e.g. US, CN)
+	DataRequest *StoreBrowseDataRequest // 返回的数据块(nil 时全部返回, 仅 GetItems 使用) | Data blocks to return (all if nil, GetItems only)
+}
+
+// StoreBrowseDataRequest GetItems 的 data_request 开关
+// StoreBrowseDataRequest holds the GetItems data_request flags
+type StoreBrowseDataRequest struct {
+	IncludeBasicInfo bool // 简介/开发商/发行商 | Description/developers/publishers
+	IncludeAssets    bool // 封面/图标素材 | Capsule/icon assets
+	IncludeRelease   bool // 发行信息 | Release info
+	IncludePlatforms bool // 平台与 Steam Deck 兼容性 | Platforms and Steam Deck compatibility
+	IncludeRatings   bool // 年龄分级 | Age ratings
+	IncludeTags      bool // 按权重排序的标签 | Weighted tags
+	IncludeReviews   bool // 评测汇总 | Review summary
+}
+
+// ============================ Raw Bytes 原始字节流接口 ============================
+
+// GetStoreItemsRawBytes get store metadata of apps 批量获取应用的商店元数据
+// 原始字节流接口只发送一次请求, 不自动分批 | The raw bytes variant sends a single request without chunking
+//   - appIDs: Game AppIDs (up to util.STORE_BROWSE_BATCH_SIZE)
+//   - query: Optional params (nil for defaults)
+func (s *DevService) GetStoreItemsRawBytes(appIDs []uint64, query *StoreBrowseQuery, opts ...option.RequestOption) (respBytes []byte, err error) {
+	return s.GetStoreItemsRawBytesCtx(context.Background(), appIDs, query, opts...)
+}
+
+// GetStoreItemsRawBytesCtx is the context-aware variant of GetStoreItemsRawBytes
+func (s *DevService) GetStoreItemsRawBytesCtx(ctx context.Context, appIDs []uint64, query *StoreBrowseQuery, opts ...option.RequestOption) (respBytes []byte, err error) {
+	c, method, reqPath, params := s.buildStoreItems(appIDs, query)
+	return api.GetRawBytesCtx(ctx, c, method, reqPath, params, opts...)
+}
+
+// GetDLCForAppsRawBytes get the DLC of apps 批量获取应用的 DLC
+// 原始字节流接口只发送一次请求, 不自动分批 | The raw bytes variant sends a single request without chunking
+//   - appIDs: Game AppIDs (up to util.STORE_BROWSE_BATCH_SIZE)
+//   - query: Optional params, DataRequest is ignored (nil for defaults)
+func (s *DevService) GetDLCForAppsRawBytes(appIDs []uint64, query *StoreBrowseQuery, opts ...option.RequestOption) (respBytes []byte, err error) {
+	return s.GetDLCForAppsRawBytesCtx(context.Background(), appIDs, query, opts...)
+}
+
+// GetDLCForAppsRawBytesCtx is the context-aware variant of GetDLCForAppsRawBytes
+func (s *DevService) GetDLCForAppsRawBytesCtx(ctx context.Context, appIDs []uint64, query *StoreBrowseQuery, opts ...option.RequestOption) (respBytes []byte, err error) {
+	c, method, reqPath, params := s.buildDLCForApps(appIDs, query)
+	return api.GetRawBytesCtx(ctx, c, method, reqPath, params, opts...)
+}
+
+// ============================ Structed Raw Model 结构化原始模型接口 ============================
+
+// GetStoreItemsRawModel get store metadata of apps 批量获取应用的商店元数据
+// 去重后按 util.STORE_BROWSE_BATCH_SIZE 分批请求并合并 store_items | IDs are deduplicated, requested in chunks of util.STORE_BROWSE_BATCH_SIZE and store_items are merged
+//   - appIDs: Game AppIDs
+//   - query: Optional params (nil for defaults)
+func (s *DevService) GetStoreItemsRawModel(appIDs []uint64, query *StoreBrowseQuery, opts ...option.RequestOption) (models.SteamStoreBrowseItemsResponse, error) {
+	return s.GetStoreItemsRawModelCtx(context.Background(), appIDs, query, opts...)
+}
+
+// GetStoreItemsRawModelCtx is the context-aware variant of GetStoreItemsRawModel
+func (s *DevService) GetStoreItemsRawModelCtx(ctx context.Context, appIDs []uint64, query *StoreBrowseQuery, opts ...option.RequestOption) (models.SteamStoreBrowseItemsResponse, error) {
+	var merged models.SteamStoreBrowseItemsResponse
+	for _, chunk := range chunkAppIDs(appIDs, util.STORE_BROWSE_BATCH_SIZE) {
+		c, method, reqPath, params := s.buildStoreItems(chunk, query)
+		rawItems, err := api.GetRawModelCtx[models.SteamStoreBrowseItemsResponse](ctx, c, method, reqPath, params, opts...)
+		if err != nil {
+			return models.SteamStoreBrowseItemsResponse{}, err
+		}
+		merged.Response.StoreItems = append(merged.Response.StoreItems, rawItems.Response.StoreItems...)
+	}
+	return merged, nil
+}
+
+// GetDLCForAppsRawModel get the DLC of apps 批量获取应用的 DLC
+// 去重后按 util.STORE_BROWSE_BATCH_SIZE 分批请求并合并 dlc_data | IDs are deduplicated, requested in chunks of util.STORE_BROWSE_BATCH_SIZE and dlc_data is merged
+//   - appIDs: Game AppIDs
+//   - query: Optional params, DataRequest is ignored (nil for defaults)
+func (s *DevService) GetDLCForAppsRawModel(appIDs []uint64, query *StoreBrowseQuery, opts ...option.RequestOption) (models.SteamDLCForAppsResponse, error) {
+	return s.GetDLCForAppsRawModelCtx(context.Background(), appIDs, query, opts...)
+}
+
+// GetDLCForAppsRawModelCtx is the context-aware variant of GetDLCForAppsRawModel
+func (s *DevService) GetDLCForAppsRawModelCtx(ctx context.Context, appIDs []uint64, query *StoreBrowseQuery, opts ...option.RequestOption) (models.SteamDLCForAppsResponse, error) {
+	var merged models.SteamDLCForAppsResponse
+	for _, chunk := range chunkAppIDs(appIDs, util.STORE_BROWSE_BATCH_SIZE) {
+		c, method, reqPath, params := s.buildDLCForApps(chunk, query)
+		rawDLC, err := api.GetRawModelCtx[models.SteamDLCForAppsResponse](ctx, c, method, reqPath, params, opts...)
+		if err != nil {
+			return models.SteamDLCForAppsResponse{}, err
+		}
+		merged.Response.DLCData = append(merged.Response.DLCData, rawDLC.Response.DLCData...)
+	}
+	return merged, nil
+}
+
+// ============================ Brief Model 精简模型接口 ============================
+
+// GetStoreItemsBrief get store metadata of apps 批量获取应用的商店元数据
+// 每个应用一条, 顺序与入参一致; 不存在或不可用的应用被跳过
+// One entry per app in input order; unknown or unavailable apps are skipped
+//   - appIDs: Game AppIDs
+//   - query: Optional params (nil for defaults)
+func (s *DevService) GetStoreItemsBrief(appIDs []uint64, query *StoreBrowseQuery, opts ...option.RequestOption) ([]models.StoreBrowseApp, error) {
+	return s.GetStoreItemsBriefCtx(context.Background(), appIDs, query, opts...)
+}
+
+// GetStoreItemsBriefCtx is the context-aware variant of GetStoreItemsBrief
+func (s *DevService) GetStoreItemsBriefCtx(ctx context.Context, appIDs []uint64, query *StoreBrowseQuery, opts ...option.RequestOption) ([]models.StoreBrowseApp, error) {
+	rawItems, err := s.GetStoreItemsRawModelCtx(ctx, appIDs, query, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[uint64]*models.SteamStoreBrowseItem, len(rawItems.Response.StoreItems))
+	for i := range rawItems.Response.StoreItems {
+		item := &rawItems.Response.StoreItems[i]
+		if item.Success == 1 {
+			byID[item.AppID] = item
+		}
+	}
+
+	// 转换为精简模型 | Convert to simplified model
+	endpoints := s.client.Endpoints()
+	apps := make([]models.StoreBrowseApp, 0, len(byID))
+	for _, appID := range dedupAppIDs(appIDs) {
+		item, ok := byID[appID]
+		if !ok {
+			continue
+		}
+		app := models.StoreBrowseApp{
+			AppID:      appID,
+			Name:       item.Name,
+			Type:       item.Type,
+			Visible:    item.Visible,
+			IsFree:     item.IsFree,
+			TagIDs:     item.TagIDs,
+			CapsuleURL: endpoints.CapsuleURL(appID), // 拼接封面URL | Splice capsule URL
+		}
+		if len(item.Tags) > 0 {
+			app.TagIDs = make([]int, 0, len(item.Tags))
+			for _, t := range item.Tags {
+				app.TagIDs = append(app.TagIDs, t.TagID)
+			}
+		}
+		if b := item.BasicInfo; b != nil {
+			app.ShortDescription = b.ShortDescription
+			for _, d := range b.Developers {
+				app.Developers = append(app.Developers, d.Name)
+			}
+			for _, p := range b.Publishers {
+				app.Publishers = append(app.Publishers, p.Name)
+			}
+			for _, f := range b.Franchises {
+				app.Franchises = append(app.Franchises, f.Name)
+			}
+		}
+		if r := item.Release; r != nil {
+			app.ReleaseDate = r.SteamReleaseDate
+			app.ReleaseDateStr = util.TimeUnix2String(r.SteamReleaseDate)
+			app.ComingSoon = r.IsComingSoon
+			app.EarlyAccess = r.IsEarlyAccess
+		}
+		if p := item.Platforms; p != nil {
+			for _, platform := range [...]struct {
+				ok   bool
+				name string
+			}{{p.Windows, "windows"}, {p.Mac, "mac"}, {p.SteamOSLinux, "linux"}} {
+				if platform.ok {
+					app.Platforms = append(app.Platforms, platform.name)
+				}
+			}
+			app.SteamDeckCompat = p.SteamDeckCompatCategory
+		}
+		if r := item.Reviews; r != nil && r.SummaryFiltered != nil {
+			app.ReviewCount = r.SummaryFiltered.ReviewCount
+			app.PercentPositive = r.SummaryFiltered.PercentPositive
+			app.ReviewScoreDesc = r.SummaryFiltered.ReviewScoreLabel
+		}
+		for _, r := range item.Ratings {
+			app.Ratings = append(app.Ratings, models.StoreBrowseRating{Type: r.RatingType, Rating: r.Rating, RequiredAge: r.RequiredAge})
+		}
+		if a := item.Assets; a != nil {
+			if a.AssetURLFormat != "" && a.MainCapsule != "" {
+				app.MainCapsuleURL = endpoints.StoreItemAssetURL(a.AssetURLFormat, a.MainCapsule)
+			}
+			if a.CommunityIcon != "" {
+				app.Icon = endpoints.IconURL(appID, a.CommunityIcon)
+			}
+		}
+		apps = append(apps, app)
+	}
+	return apps, nil
+}
+
+// GetDLCForAppsBrief get the DLC of apps 批量获取应用的 DLC
+// 每个应用一条(无 DLC 时列表为空), 顺序与入参一致 | One entry per app in input order (empty list if it has no DLC)
+//   - appIDs: Game AppIDs
+//   - query: Optional params, DataRequest is ignored (nil for defaults)
+func (s *DevService) GetDLCForAppsBrief(appIDs []uint64, query *StoreBrowseQuery, opts ...option.RequestOption) ([]models.AppDLC, error) {
+	return s.GetDLCForAppsBriefCtx(context.Background(), appIDs, query, opts...)
+}
+
+// GetDLCForAppsBriefCtx is the context-aware variant of GetDLCForAppsBrief
+func (s *DevService) GetDLCForAppsBriefCtx(ctx context.Context, appIDs []uint64, query *StoreBrowseQuery, opts ...option.RequestOption) ([]models.AppDLC, error) {
+	rawDLC, err := s.GetDLCForAppsRawModelCtx(ctx, appIDs, query, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	// 按所属应用分组 | Group by parent app
+	endpoints := s.client.Endpoints()
+	byParent := make(map[uint64][]models.DLCItem)
+	for _, d := range rawDLC.Response.DLCData {
+		byParent[d.ParentAppID] = append(byParent[d.ParentAppID], models.DLCItem{
+			AppID:           d.AppID,
+			ReleaseDate:     d.ReleaseDate,
+			ReleaseDateStr:  util.TimeUnix2String(d.ReleaseDate),
+			ComingSoon:      d.ComingSoon,
+			Free:            d.Free,
+			Price:           int64(d.Price),
+			DiscountPercent: d.Discount,
+			CapsuleURL:      endpoints.CapsuleURL(d.AppID), // 拼接封面URL | Splice capsule URL
+		})
+	}
+
+	ids := dedupAppIDs(appIDs)
+	apps := make([]models.AppDLC, 0, len(ids))
+	for _, appID := range ids {
+		dlc := byParent[appID]
+		if dlc == nil {
+			dlc = []models.DLCItem{}
+		}
+		apps = append(apps, models.AppDLC{AppID: appID, DLC: dlc})
+	}
+	return apps, nil
+}
+
+// ============================ Default Interface 默认接口 ============================
+
+// GetStoreItems get store metadata of apps 批量获取应用的商店元数据
+//   - appIDs: Game AppIDs
+//   - query: Optional params (nil for defaults)
+func (s *DevService) GetStoreItems(appIDs []uint64, query *StoreBrowseQuery, opts ...option.RequestOption) ([]models.StoreBrowseApp, error) {
+	return s.GetStoreItemsBrief(appIDs, query, opts...)
+}
+
+// GetStoreItemsCtx is the context-aware variant of GetStoreItems
+func (s *DevService) GetStoreItemsCtx(ctx context.Context, appIDs []uint64, query *StoreBrowseQuery, opts ...option.RequestOption) ([]models.StoreBrowseApp, error) {
+	return s.GetStoreItemsBriefCtx(ctx, appIDs, query, opts...)
+}
+
+// GetDLCForApps get the DLC of apps 批量获取应用的 DLC
+//   - appIDs: Game AppIDs
+//   - query: Optional params, DataRequest is ignored (nil for defaults)
+func (s *DevService) GetDLCForApps(appIDs []uint64, query *StoreBrowseQuery, opts ...option.RequestOption) ([]models.AppDLC, error) {
+	return s.GetDLCForAppsBrief(appIDs, query, opts...)
+}
+
+// GetDLCForAppsCtx is the context-aware variant of GetDLCForApps
+func (s *DevService) GetDLCForAppsCtx(ctx context.Context, appIDs []uint64, query *StoreBrowseQuery, opts ...option.RequestOption) ([]models.AppDLC, error) {
+	return s.GetDLCForAppsBriefCtx(ctx, appIDs, query, opts...)
+}
+
+// ============================ Build 构造入参 ============================
+
+// buildStoreItems builds input params.
+// ids/context/data_request 为嵌套消息, 统一以 input_json 发送
+// ids/context/data_request are nested messages, so the request is sent as input_json
+func (s *DevService) buildStoreItems(appIDs []uint64, query *StoreBrowseQuery) (
+	c *client.Client,
+	method, reqPath string,
+	params url.Values,
+) {
+	if query == nil {
+		query = &StoreBrowseQuery{}
+	}
+	ids := make([]map[string]uint64, 0, len(appIDs))
+	for _, appID := range appIDs {
+		ids = append(ids, map[string]uint64{"appid": appID})
+	}
+	dr := query.DataRequest
+	if dr == nil {
+		dr = &StoreBrowseDataRequest{
+			IncludeBasicInfo: true, IncludeAssets: true, IncludeRelease: true, IncludePlatforms: true,
+			IncludeRatings: true, IncludeTags: true, IncludeReviews: true,
+		}
+	}
+	dataRequest := map[string]any{
+		"include_basic_info": dr.IncludeBasicInfo,
+		"include_assets":     dr.IncludeAssets,
+		"include_release":    dr.IncludeRelease,
+		"include_platforms":  dr.IncludePlatforms,
+		"include_ratings":    dr.IncludeRatings,
+		"include_reviews":    dr.IncludeReviews,
+	}
+	if dr.IncludeTags {
+		dataRequest["include_tag_count"] = util.STORE_BROWSE_TAG_COUNT
+	}
+	input := map[string]any{"ids": ids, "data_request": dataRequest}
+	if storeContext := storeBrowseContext(query.CC, query.Lang); storeContext != nil {
+		input["context"] = storeContext
+	}
+	return s.client, "GET", IStoreBrowseService + "/GetItems/v1/", inputJSONParams(input)
+}
+
+// buildDLCForApps builds input params.
+func (s *DevService) buildDLCForApps(appIDs []uint64, query *StoreBrowseQuery) (
+	c *client.Client,
+	method, reqPath string,
+	params url.Values,
+) {
+	if query == nil {
+		query = &StoreBrowseQuery{}
+	}
+	input := map[string]any{"appids": appIDs}
+	if storeContext := storeBrowseContext(query.CC, query.Lang); storeContext != nil {
+		input["context"] = storeContext
+	}
+	return s.client, "GET", IStoreBrowseService + "/GetDLCForApps/v1/", inputJSONParams(input)
+}
+
+// ============================ 工具方法 ============================
+
+// storeBrowseContext 构造 StoreBrowseContext(国家/地区与语言), 均未设置时返回 nil
+// storeBrowseContext builds the StoreBrowseContext (country and language), nil if neither is set
+func storeBrowseContext(cc, lang *string) map[string]string {
+	storeContext := map[string]string{}
+	if cc != nil {
+		storeContext["country_code"] = *cc
+	}
+	if lang != nil {
+		storeContext["language"] = *lang
+	}
+	if len(storeContext) == 0 {
+		return nil
+	}
+	return storeContext
+}
+
+// inputJSONParams 将服务接口入参序列化为 input_json | Serialize service method input as input_json
+func inputJSONParams(input map[string]any) url.Values {
+	params := url.Values{}
+	// 入参均为基础类型, 序列化不会失败 | Inputs are plain values, marshalling cannot fail
+	inputJSON, _ := sonic.MarshalString(input)
+	params.Set("input_json", inputJSON)
+	return params
+}
+
+// dedupAppIDs 去除重复的应用ID并保持顺序 | Remove duplicate AppIDs, keeping order
+func dedupAppIDs(appIDs []uint64) []uint64 {
+	seen := make(map[uint64]struct{}, len(appIDs))
+	ids := make([]uint64, 0, len(appIDs))
+	for _, appID := range appIDs {
+		if _, ok := seen[appID]; ok {
+			continue
+		}
+		seen[appID] = struct{}{}
+		ids = append(ids, appID)
+	}
+	return ids
+}
+
+// chunkAppIDs 去重后按 size 切分应用ID | Deduplicate AppIDs and split them into chunks of size
+func chunkAppIDs(appIDs []uint64, size int) [][]uint64 {
+	ids := dedupAppIDs(appIDs)
+	chunks := make([][]uint64, 0, (len(ids)+size-1)/size)
+	for start := 0; start < len(ids); start += size {
+		chunks = append(chunks, ids[start:min(start+size, len(ids))])
+	}
+	return chunks
+}
@@ -0,0 +1,48 @@
+package dev
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDedupAppIDs(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []uint64
+		want []uint64
+	}{
+		{"empty", nil, []uint64{}},
+		{"unique", []uint64{620, 570, 440}, []uint64{620, 570, 440}},
+		{"keeps first occurrence order", []uint64{620, 570, 620, 440, 570}, []uint64{620, 570, 440}},
+		{"all duplicates", []uint64{620, 620, 620}, []uint64{620}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := dedupAppIDs(tt.in); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("dedupAppIDs(%v) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChunkAppIDs(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []uint64
+		size int
+		want [][]uint64
+	}{
+		{"empty", nil, 2, [][]uint64{}},
+		{"single chunk", []uint64{1, 2}, 2, [][]uint64{{1, 2}}},
+		{"remainder", []uint64{1, 2, 3, 4, 5}, 2, [][]uint64{{1, 2}, {3, 4}, {5}}},
+		{"dedup before chunking", []uint64{1, 1, 2, 2, 3}, 2, [][]uint64{{1, 2}, {3}}},
+		{"size one", []uint64{3, 1, 3}, 1, [][]uint64{{3}, {1}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := chunkAppIDs(tt.in, tt.size); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("chunkAppIDs(%v, %d) = %v, want %v", tt.in, tt.size, got, tt.want)
+			}
+		})
+	}
+}
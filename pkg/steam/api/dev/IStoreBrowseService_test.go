@@ -0,0 +1,103 @@
+package dev_test
+
+import (
+	"testing"
+
+	"github.com/GoFurry/gf-steam-sdk/pkg/steamtest"
+	"github.com/GoFurry/gf-steam-sdk/pkg/util"
+	"github.com/bytedance/sonic"
+)
+
+// requestedAppIDs 解析各批请求 input_json 中的应用ID | Decode the AppIDs of each batch from input_json
+func requestedAppIDs(t *testing.T, srv *steamtest.Server, endpoint string) [][]uint64 {
+	t.Helper()
+	var batches [][]uint64
+	for _, r := range srv.Requests() {
+		if r.Endpoint != endpoint {
+			continue
+		}
+		var input struct {
+			IDs []struct {
+				AppID uint64 `json:"appid"`
+			} `json:"ids"` // GetItems
+			AppIDs []uint64 `json:"appids"` // GetDLCForApps
+		}
+		if err := sonic.UnmarshalString(r.Query.Get("input_json"), &input); err != nil {
+			t.Fatalf("decode input_json: %v", err)
+		}
+		batch := input.AppIDs
+		for _, id := range input.IDs {
+			batch = append(batch, id.AppID)
+		}
+		batches = append(batches, batch)
+	}
+	return batches
+}
+
+func TestStoreBrowseBatching(t *testing.T) {
+	size := util.STORE_BROWSE_BATCH_SIZE
+	many := make([]uint64, 0, 2*size+10)
+	for i := 1; i <= 2*size+5; i++ {
+		many = append(many, uint64(i))
+	}
+	many = append(many, 1, 2, 3, 4, 5) // 重复ID不单独成批 | Duplicates do not form their own batch
+
+	tests := []struct {
+		name     string
+		endpoint string
+		call     func(srv *steamtest.Server, ids []uint64) error
+		ids      []uint64
+		want     []int // 每批应用数 | Apps per batch
+	}{
+		{"items empty", "IStoreBrowseService/GetItems", getItems, nil, nil},
+		{"items one batch", "IStoreBrowseService/GetItems", getItems, []uint64{620, 570, 620}, []int{2}},
+		{"items many batches", "IStoreBrowseService/GetItems", getItems, many, []int{size, size, 5}},
+		{"dlc many batches", "IStoreBrowseService/GetDLCForApps", getDLC, many, []int{size, size, 5}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := steamtest.NewServer()
+			defer srv.Close()
+			if err := tt.call(srv, tt.ids); err != nil {
+				t.Fatalf("call: %v", err)
+			}
+
+			batches := requestedAppIDs(t, srv, tt.endpoint)
+			if len(batches) != len(tt.want) {
+				t.Fatalf("want %d requests, got %d", len(tt.want), len(batches))
+			}
+			seen := map[uint64]bool{}
+			for i, batch := range batches {
+				if len(batch) != tt.want[i] {
+					t.Fatalf("batch %d has %d apps, want %d", i, len(batch), tt.want[i])
+				}
+				for _, id := range batch {
+					if seen[id] {
+						t.Fatalf("app %d requested twice", id)
+					}
+					seen[id] = true
+				}
+			}
+		})
+	}
+}
+
+func getItems(srv *steamtest.Server, ids []uint64) error {
+	sdk, err := srv.NewSDK(nil)
+	if err != nil {
+		return err
+	}
+	defer sdk.Close()
+	_, err = sdk.Develop.GetStoreItems(ids, nil)
+	return err
+}
+
+func getDLC(srv *steamtest.Server, ids []uint64) error {
+	sdk, err := srv.NewSDK(nil)
+	if err != nil {
+		return err
+	}
+	defer sdk.Close()
+	_, err = sdk.Develop.GetDLCForApps(ids, nil)
+	return err
+}
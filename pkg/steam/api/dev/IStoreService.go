@@ -0,0 +1,186 @@
+package dev
+
+import (
+	"context"
+	"iter"
+	"net/url"
+
+	"github.com/GoFurry/gf-steam-sdk/internal/api"
+	"github.com/GoFurry/gf-steam-sdk/internal/client"
+	"github.com/GoFurry/gf-steam-sdk/pkg/models"
+	"github.com/GoFurry/gf-steam-sdk/pkg/option"
+	"github.com/GoFurry/gf-steam-sdk/pkg/util"
+)
+
+const (
+	IStoreService = util.STEAM_API_BASE_URL + "IStoreService"
+)
+
+// StoreAppListQuery IStoreService/GetAppList 可选参数, 字段为 nil 时不发送(使用 Steam 默认值)
+// StoreAppListQuery holds the optional IStoreService/GetAppList params; nil fields are omitted (Steam defaults apply)
+type StoreAppListQuery struct {
+	LastAppID       *uint64 // 从该应用ID之后开始返回 | Return apps after this AppID
+	IfModifiedSince *int64  // 仅返回该时间戳之后修改过的应用 | Only apps modified after this timestamp
+	IncludeGames    *bool   // 包含游戏(Steam 默认 true) | Include games (Steam default true)
+	IncludeDLC      *bool   // 包含 DLC(Steam 默认 false) | Include DLC (Steam default false)
+	IncludeSoftware *bool   // 包含软件(Steam 默认 false) | Include software (Steam default false)
+	IncludeVideos   *bool   // 包含视频(Steam 默认 false) | Include videos (Steam default false)
+	IncludeHardware *bool   // 包含硬件(Steam 默认 false) | Include hardware (Steam default false)
+	MaxResults      *int    // 每页条数(Steam 默认 10000, 最大 50000) | Page size (Steam default 10000, max 50000)
+}
+
+// ============================ Raw Bytes 原始字节流接口 ============================
+
+// GetStoreAppListRawBytes get one page of store apps 获取一页商店应用
+//   - query: Optional params (nil for defaults)
+func (s *DevService) GetStoreAppListRawBytes(query *StoreAppListQuery, opts ...option.RequestOption) (respBytes []byte, err error) {
+	return s.GetStoreAppListRawBytesCtx(context.Background(), query, opts...)
+}
+
+// GetStoreAppListRawBytesCtx is the context-aware variant of GetStoreAppListRawBytes
+func (s *DevService) GetStoreAppListRawBytesCtx(ctx context.Context, query *StoreAppListQuery, opts ...option.RequestOption) (respBytes []byte, err error) {
+	c, method, reqPath, params := s.buildStoreAppList(query)
+	return api.GetRawBytesCtx(ctx, c, method, reqPath, params, opts...)
+}
+
+// ============================ Structed Raw Model 结构化原始模型接口 ============================
+
+// GetStoreAppListRawModel get one page of store apps 获取一页商店应用
+//   - query: Optional params (nil for defaults)
+func (s *DevService) GetStoreAppListRawModel(query *StoreAppListQuery, opts ...option.RequestOption) (models.SteamStoreAppListResponse, error) {
+	return s.GetStoreAppListRawModelCtx(context.Background(), query, opts...)
+}
+
+// GetStoreAppListRawModelCtx is the context-aware variant of GetStoreAppListRawModel
+func (s *DevService) GetStoreAppListRawModelCtx(ctx context.Context, query *StoreAppListQuery, opts ...option.RequestOption) (models.SteamStoreAppListResponse, error) {
+	c, method, reqPath, params := s.buildStoreAppList(query)
+	return api.GetRawModelCtx[models.SteamStoreAppListResponse](ctx, c, method, reqPath, params, opts...)
+}
+
+// ============================ Brief Model 精简模型接口 ============================
+
+// GetStoreAppListBrief get one page of store apps 获取一页商店应用
+//   - query: Optional params (nil for defaults)
+func (s *DevService) GetStoreAppListBrief(query *StoreAppListQuery, opts ...option.RequestOption) (models.StoreAppPage, error) {
+	return s.GetStoreAppListBriefCtx(context.Background(), query, opts...)
+}
+
+// GetStoreAppListBriefCtx is the context-aware variant of GetStoreAppListBrief
+func (s *DevService) GetStoreAppListBriefCtx(ctx context.Context, query *StoreAppListQuery, opts ...option.RequestOption) (models.StoreAppPage, error) {
+	rawList, err := s.GetStoreAppListRawModelCtx(ctx, query, opts...)
+	if err != nil {
+		return models.StoreAppPage{}, err
+	}
+
+	return models.StoreAppPage{
+		Apps:            rawList.Response.Apps,
+		HaveMoreResults: rawList.Response.HaveMoreResults,
+		LastAppID:       rawList.Response.LastAppID,
+	}, nil
+}
+
+// ============================ Default Interface 默认接口 ============================
+
+// GetStoreAppList get one page of store apps 获取一页商店应用
+//   - query: Optional params (nil for defaults)
+func (s *DevService) GetStoreAppList(query *StoreAppListQuery, opts ...option.RequestOption) (models.StoreAppPage, error) {
+	return s.GetStoreAppListBrief(query, opts...)
+}
+
+// GetStoreAppListCtx is the context-aware variant of GetStoreAppList
+func (s *DevService) GetStoreAppListCtx(ctx context.Context, query *StoreAppListQuery, opts ...option.RequestOption) (models.StoreAppPage, error) {
+	return s.GetStoreAppListBriefCtx(ctx, query, opts...)
+}
+
+// StoreApps iterate every store app across pages 跨页遍历全部商店应用
+// 按 have_more_results/last_appid 自动翻页, 每页请求都经过客户端限流; 请求失败时产出一次错误后结束
+// Pages are followed through have_more_results/last_appid, each page request goes through the client's rate limiter;
+// a failed request yields the error once and ends the iteration
+//   - query: Optional params, LastAppID is the starting point (nil for defaults)
+func (s *DevService) StoreApps(query *StoreAppListQuery, opts ...option.RequestOption) iter.Seq2[models.StoreApp, error] {
+	return s.StoreAppsCtx(context.Background(), query, opts...)
+}
+
+// StoreAppsCtx is the context-aware variant of StoreApps
+func (s *DevService) StoreAppsCtx(ctx context.Context, query *StoreAppListQuery, opts ...option.RequestOption) iter.Seq2[models.StoreApp, error] {
+	return func(yield func(models.StoreApp, error) bool) {
+		page := StoreAppListQuery{}
+		if query != nil {
+			page = *query
+		}
+		for {
+			list, err := s.GetStoreAppListBriefCtx(ctx, &page, opts...)
+			if err != nil {
+				yield(models.StoreApp{}, err)
+				return
+			}
+			for _, app := range list.Apps {
+				if !yield(app, nil) {
+					return
+				}
+			}
+			// last_appid 未前进时停止, 避免重复请求同一页 | Stop when last_appid does not advance to avoid refetching a page
+			if !list.HaveMoreResults || (page.LastAppID != nil && list.LastAppID <= *page.LastAppID) {
+				return
+			}
+			lastAppID := list.LastAppID
+			page.LastAppID = &lastAppID
+		}
+	}
+}
+
+// StoreAppsChangedSince iterate store apps modified after a timestamp 遍历指定时间之后修改过的商店应用
+// 适用于每日增量同步: 记录本次同步开始时间, 下次以其作为 since
+// Suited to nightly delta syncs: record when this sync started and pass it as since next time
+//   - since: Unix timestamp, only apps modified after it are returned
+//   - query: Optional params, IfModifiedSince is overridden by since (nil for defaults)
+func (s *DevService) StoreAppsChangedSince(since int64, query *StoreAppListQuery, opts ...option.RequestOption) iter.Seq2[models.StoreApp, error] {
+	return s.StoreAppsChangedSinceCtx(context.Background(), since, query, opts...)
+}
+
+// StoreAppsChangedSinceCtx is the context-aware variant of StoreAppsChangedSince
+func (s *DevService) StoreAppsChangedSinceCtx(ctx context.Context, since int64, query *StoreAppListQuery, opts ...option.RequestOption) iter.Seq2[models.StoreApp, error] {
+	page := StoreAppListQuery{}
+	if query != nil {
+		page = *query
+	}
+	page.IfModifiedSince = &since
+	return s.StoreAppsCtx(ctx, &page, opts...)
+}
+
+// ============================ Build 构造入参 ============================
+
+// buildStoreAppList builds input params.
+func (s *DevService) buildStoreAppList(query *StoreAppListQuery) (
+	c *client.Client,
+	method, reqPath string,
+	params url.Values,
+) {
+	params = url.Values{}
+	if query != nil {
+		if query.LastAppID != nil {
+			params.Set("last_appid", util.Uint642String(*query.LastAppID))
+		}
+		if query.IfModifiedSince != nil {
+			params.Set("if_modified_since", util.Int642String(*query.IfModifiedSince))
+		}
+		setBoolParam(params, "include_games", query.IncludeGames)
+		setBoolParam(params, "include_dlc", query.IncludeDLC)
+		setBoolParam(params, "include_software", query.IncludeSoftware)
+		setBoolParam(params, "include_videos", query.IncludeVideos)
+		setBoolParam(params, "include_hardware", query.IncludeHardware)
+		if query.MaxResults != nil {
+			params.Set("max_results", util.Int2String(*query.MaxResults))
+		}
+	}
+	return s.client, "GET", IStoreService + "/GetAppList/v1/", params
+}
+
+// ============================ 工具方法 ============================
+
+// setBoolParam 设置非 nil 的布尔参数(1/0) | Set a non-nil bool param (1/0)
+func setBoolParam(params url.Values, key string, v *bool) {
+	if v != nil {
+		params.Set(key, util.Int2String(util.B2i(*v)))
+	}
+}
@@ -0,0 +1,317 @@
+package dev
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/GoFurry/gf-steam-sdk/internal/api"
+	"github.com/GoFurry/gf-steam-sdk/internal/client"
+	"github.com/GoFurry/gf-steam-sdk/pkg/models"
+	"github.com/GoFurry/gf-steam-sdk/pkg/option"
+	"github.com/GoFurry/gf-steam-sdk/pkg/util"
+)
+
+const (
+	IWishlistService = util.STEAM_API_BASE_URL + "IWishlistService"
+)
+
+// WishlistSortedFilteredQuery IWishlistService/GetWishlistSortedFiltered 可选参数, 字段为 nil 时不发送(使用 Steam 默认值)
+// WishlistSortedFilteredQuery holds the optional IWishlistService/GetWishlistSortedFiltered params; nil fields are omitted (Steam defaults apply)
+type WishlistSortedFilteredQuery struct {
+	CC                 *string // 国家/地区代码, 决定价格与货币(如 us, cn) | Country code deciding price and currency (e.g. us, cn)
+	Lang               *string // 语言(如 english, schinese) | Language (e.g. english, schinese)
+	SortOrder          *string // 排序(如 priority, date_added, name, price, discount) | Sort order (e.g. priority, date_added, name, price, discount)
+	ExcludeEarlyAccess *bool   // 排除抢先体验 | Exclude early access
+	ExcludeComingSoon  *bool   // 排除即将推出 | Exclude coming soon
+	ExcludeVROnly      *bool   // 排除仅 VR | Exclude VR only
+	StartIndex         *int    // 起始偏移(默认 0) | Start offset (default 0)
+	PageSize           *int    // 每页条数(Steam 默认 100) | Page size (Steam default 100)
+}
+
+// ============================ Raw Bytes 原始字节流接口 ============================
+
+// GetWishlistRawBytes get a user's wishlist 获取用户愿望单
+//   - steamID: User SteamID (wishlist must be public unless it is the access token owner)
+func (s *DevService) GetWishlistRawBytes(steamID string, opts ...option.RequestOption) (respBytes []byte, err error) {
+	return s.GetWishlistRawBytesCtx(context.Background(), steamID, opts...)
+}
+
+// GetWishlistRawBytesCtx is the context-aware variant of GetWishlistRawBytes
+func (s *DevService) GetWishlistRawBytesCtx(ctx context.Context, steamID string, opts ...option.RequestOption) (respBytes []byte, err error) {
+	c, method, reqPath, params := s.buildWishlist(steamID)
+	return api.GetRawBytesCtx(ctx, c, method, reqPath, params, opts...)
+}
+
+// GetWishlistItemCountRawBytes get the number of wishlist items 获取愿望单条目数
+//   - steamID: User SteamID
+func (s *DevService) GetWishlistItemCountRawBytes(steamID string, opts ...option.RequestOption) (respBytes []byte, err error) {
+	return s.GetWishlistItemCountRawBytesCtx(context.Background(), steamID, opts...)
+}
+
+// GetWishlistItemCountRawBytesCtx is the context-aware variant of GetWishlistItemCountRawBytes
+func (s *DevService) GetWishlistItemCountRawBytesCtx(ctx context.Context, steamID string, opts ...option.RequestOption) (respBytes []byte, err error) {
+	c, method, reqPath, params := s.buildWishlistItemCount(steamID)
+	return api.GetRawBytesCtx(ctx, c, method, reqPath, params, opts...)
+}
+
+// GetWishlistSortedFilteredRawBytes get a sorted and filtered wishlist page with store data 获取排序筛选后的一页愿望单(附带商店数据)
+//   - steamID: User SteamID
+//   - query: Optional params (nil for defaults)
+func (s *DevService) GetWishlistSortedFilteredRawBytes(steamID string, query *WishlistSortedFilteredQuery, opts ...option.RequestOption) (respBytes []byte, err error) {
+	return s.GetWishlistSortedFilteredRawBytesCtx(context.Background(), steamID, query, opts...)
+}
+
+// GetWishlistSortedFilteredRawBytesCtx is the context-aware variant of GetWishlistSortedFilteredRawBytes
+func (s *DevService) GetWishlistSortedFilteredRawBytesCtx(ctx context.Context, steamID string, query *WishlistSortedFilteredQuery, opts ...option.RequestOption) (respBytes []byte, err error) {
+	c, method, reqPath, params := s.buildWishlistSortedFiltered(steamID, query)
+	return api.GetRawBytesCtx(ctx, c, method, reqPath, params, opts...)
+}
+
+// ============================ Structed Raw Model 结构化原始模型接口 ============================
+
+// GetWishlistRawModel get a user's wishlist 获取用户愿望单
+//   - steamID: User SteamID (wishlist must be public unless it is the access token owner)
+func (s *DevService) GetWishlistRawModel(steamID string, opts ...option.RequestOption) (models.SteamWishlistResponse, error) {
+	return s.GetWishlistRawModelCtx(context.Background(), steamID, opts...)
+}
+
+// GetWishlistRawModelCtx is the context-aware variant of GetWishlistRawModel
+func (s *DevService) GetWishlistRawModelCtx(ctx context.Context, steamID string, opts ...option.RequestOption) (models.SteamWishlistResponse, error) {
+	c, method, reqPath, params := s.buildWishlist(steamID)
+	return api.GetRawModelCtx[models.SteamWishlistResponse](ctx, c, method, reqPath, params, opts...)
+}
+
+// GetWishlistItemCountRawModel get the number of wishlist items 获取愿望单条目数
+//   - steamID: User SteamID
+func (s *DevService) GetWishlistItemCountRawModel(steamID string, opts ...option.RequestOption) (models.SteamWishlistItemCountResponse, error) {
+	return s.GetWishlistItemCountRawModelCtx(context.Background(), steamID, opts...)
+}
+
+// GetWishlistItemCountRawModelCtx is the context-aware variant of GetWishlistItemCountRawModel
+func (s *DevService) GetWishlistItemCountRawModelCtx(ctx context.Context, steamID string, opts ...option.RequestOption) (models.SteamWishlistItemCountResponse, error) {
+	c, method, reqPath, params := s.buildWishlistItemCount(steamID)
+	return api.GetRawModelCtx[models.SteamWishlistItemCountResponse](ctx, c, method, reqPath, params, opts...)
+}
+
+// GetWishlistSortedFilteredRawModel get a sorted and filtered wishlist page with store data 获取排序筛选后的一页愿望单(附带商店数据)
+//   - steamID: User SteamID
+//   - query: Optional params (nil for defaults)
+func (s *DevService) GetWishlistSortedFilteredRawModel(steamID string, query *WishlistSortedFilteredQuery, opts ...option.RequestOption) (models.SteamWishlistSortedFilteredResponse, error) {
+	return s.GetWishlistSortedFilteredRawModelCtx(context.Background(), steamID, query, opts...)
+}
+
+// GetWishlistSortedFilteredRawModelCtx is the context-aware variant of GetWishlistSortedFilteredRawModel
+func (s *DevService) GetWishlistSortedFilteredRawModelCtx(ctx context.Context, steamID string, query *WishlistSortedFilteredQuery, opts ...option.RequestOption) (models.SteamWishlistSortedFilteredResponse, error) {
+	c, method, reqPath, params := s.buildWishlistSortedFiltered(steamID, query)
+	return api.GetRawModelCtx[models.SteamWishlistSortedFilteredResponse](ctx, c, method, reqPath, params, opts...)
+}
+
+// ============================ Brief Model 精简模型接口 ============================
+
+// GetWishlistBrief get a user's wishlist 获取用户愿望单
+//   - steamID: User SteamID (wishlist must be public unless it is the access token owner)
+func (s *DevService) GetWishlistBrief(steamID string, opts ...option.RequestOption) ([]models.WishlistItem, error) {
+	return s.GetWishlistBriefCtx(context.Background(), steamID, opts...)
+}
+
+// GetWishlistBriefCtx is the context-aware variant of GetWishlistBrief
+func (s *DevService) GetWishlistBriefCtx(ctx context.Context, steamID string, opts ...option.RequestOption) ([]models.WishlistItem, error) {
+	rawWishlist, err := s.GetWishlistRawModelCtx(ctx, steamID, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	// 转换为精简模型 | Convert to simplified model
+	items := make([]models.WishlistItem, 0, len(rawWishlist.Response.Items))
+	endpoints := s.client.Endpoints()
+	for _, item := range rawWishlist.Response.Items {
+		items = append(items, models.WishlistItem{
+			AppID:        item.AppID,
+			Priority:     item.Priority,
+			DateAdded:    item.DateAdded,
+			DateAddedStr: util.TimeUnix2String(item.DateAdded),
+			CapsuleURL:   endpoints.CapsuleURL(item.AppID), // 拼接封面URL | Splice capsule URL
+		})
+	}
+	return items, nil
+}
+
+// GetWishlistItemCountBrief get the number of wishlist items 获取愿望单条目数
+//   - steamID: User SteamID
+func (s *DevService) GetWishlistItemCountBrief(steamID string, opts ...option.RequestOption) (int, error) {
+	return s.GetWishlistItemCountBriefCtx(context.Background(), steamID, opts...)
+}
+
+// GetWishlistItemCountBriefCtx is the context-aware variant of GetWishlistItemCountBrief
+func (s *DevService) GetWishlistItemCountBriefCtx(ctx context.Context, steamID string, opts ...option.RequestOption) (int, error) {
+	rawCount, err := s.GetWishlistItemCountRawModelCtx(ctx, steamID, opts...)
+	if err != nil {
+		return 0, err
+	}
+	return rawCount.Response.Count, nil
+}
+
+// GetWishlistSortedFilteredBrief get a sorted and filtered wishlist page with store data 获取排序筛选后的一页愿望单(附带商店数据)
+//   - steamID: User SteamID
+//   - query: Optional params (nil for defaults)
+func (s *DevService) GetWishlistSortedFilteredBrief(steamID string, query *WishlistSortedFilteredQuery, opts ...option.RequestOption) ([]models.WishlistEntry, error) {
+	return s.GetWishlistSortedFilteredBriefCtx(context.Background(), steamID, query, opts...)
+}
+
+// GetWishlistSortedFilteredBriefCtx is the context-aware variant of GetWishlistSortedFilteredBrief
+func (s *DevService) GetWishlistSortedFilteredBriefCtx(ctx context.Context, steamID string, query *WishlistSortedFilteredQuery, opts ...option.RequestOption) ([]models.WishlistEntry, error) {
+	rawWishlist, err := s.GetWishlistSortedFilteredRawModelCtx(ctx, steamID, query, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	// 转换为精简模型 | Convert to simplified model
+	entries := make([]models.WishlistEntry, 0, len(rawWishlist.Response.Items))
+	endpoints := s.client.Endpoints()
+	for _, item := range rawWishlist.Response.Items {
+		entry := models.WishlistEntry{
+			AppID:        item.AppID,
+			Priority:     item.Priority,
+			DateAdded:    item.DateAdded,
+			DateAddedStr: util.TimeUnix2String(item.DateAdded),
+			CapsuleURL:   endpoints.CapsuleURL(item.AppID), // 拼接封面URL | Splice capsule URL
+		}
+		if si := item.StoreItem; si != nil {
+			entry.Name = si.Name
+			entry.IsFree = si.IsFree
+			if r := si.Release; r != nil {
+				entry.ComingSoon = r.IsComingSoon
+				entry.ReleaseDate = r.SteamReleaseDate
+				entry.ReleaseDateStr = util.TimeUnix2String(r.SteamReleaseDate)
+			}
+			if o := si.BestPurchaseOption; o != nil && !si.IsFree {
+				final, initial := int64(o.FinalPriceInCents), int64(o.OriginalPriceInCents)
+				if initial == 0 {
+					initial = final // 未打折时不返回原价 | The original price is omitted without a discount
+				}
+				entry.Price = &models.StorePrice{
+					Initial:         initial,
+					Final:           final,
+					InitialAmount:   float64(initial) / util.STORE_PRICE_SCALE,
+					FinalAmount:     float64(final) / util.STORE_PRICE_SCALE,
+					DiscountPercent: o.DiscountPct,
+					FinalFormatted:  o.FormattedFinalPrice,
+				}
+				for _, d := range o.ActiveDiscounts {
+					if d.DiscountEndDate > entry.DiscountEndDate {
+						entry.DiscountEndDate = d.DiscountEndDate
+					}
+				}
+				entry.DiscountEndDateStr = util.TimeUnix2String(entry.DiscountEndDate)
+			}
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// ============================ Default Interface 默认接口 ============================
+
+// GetWishlist get a user's wishlist 获取用户愿望单
+//   - steamID: User SteamID (wishlist must be public unless it is the access token owner)
+func (s *DevService) GetWishlist(steamID string, opts ...option.RequestOption) ([]models.WishlistItem, error) {
+	return s.GetWishlistBrief(steamID, opts...)
+}
+
+// GetWishlistCtx is the context-aware variant of GetWishlist
+func (s *DevService) GetWishlistCtx(ctx context.Context, steamID string, opts ...option.RequestOption) ([]models.WishlistItem, error) {
+	return s.GetWishlistBriefCtx(ctx, steamID, opts...)
+}
+
+// GetWishlistItemCount get the number of wishlist items 获取愿望单条目数
+//   - steamID: User SteamID
+func (s *DevService) GetWishlistItemCount(steamID string, opts ...option.RequestOption) (int, error) {
+	return s.GetWishlistItemCountBrief(steamID, opts...)
+}
+
+// GetWishlistItemCountCtx is the context-aware variant of GetWishlistItemCount
+func (s *DevService) GetWishlistItemCountCtx(ctx context.Context, steamID string, opts ...option.RequestOption) (int, error) {
+	return s.GetWishlistItemCountBriefCtx(ctx, steamID, opts...)
+}
+
+// GetWishlistSortedFiltered get a sorted and filtered wishlist page with store data 获取排序筛选后的一页愿望单(附带商店数据)
+//   - steamID: User SteamID
+//   - query: Optional params (nil for defaults)
+func (s *DevService) GetWishlistSortedFiltered(steamID string, query *WishlistSortedFilteredQuery, opts ...option.RequestOption) ([]models.WishlistEntry, error) {
+	return s.GetWishlistSortedFilteredBrief(steamID, query, opts...)
+}
+
+// GetWishlistSortedFilteredCtx is the context-aware variant of GetWishlistSortedFiltered
+func (s *DevService) GetWishlistSortedFilteredCtx(ctx context.Context, steamID string, query *WishlistSortedFilteredQuery, opts ...option.RequestOption) ([]models.WishlistEntry, error) {
+	return s.GetWishlistSortedFilteredBriefCtx(ctx, steamID, query, opts...)
+}
+
+// ============================ Build 构造入参 ============================
+
+// buildWishlist builds input params.
+func (s *DevService) buildWishlist(steamID string) (
+	c *client.Client,
+	method, reqPath string,
+	params url.Values,
+) {
+	params = url.Values{}
+	params.Set("steamid", steamID)
+	return s.client, "GET", IWishlistService + "/GetWishlist/v1/", params
+}
+
+// buildWishlistItemCount builds input params.
+func (s *DevService) buildWishlistItemCount(steamID string) (
+	c *client.Client,
+	method, reqPath string,
+	params url.Values,
+) {
+	params = url.Values{}
+	params.Set("steamid", steamID)
+	return s.client, "GET", IWishlistService + "/GetWishlistItemCount/v1/", params
+}
+
+// buildWishlistSortedFiltered builds input params.
+// 该接口的 context/data_request/filters 为嵌套消息, 统一以 input_json 发送
+// The context/data_request/filters fields are nested messages, so the request is sent as input_json
+func (s *DevService) buildWishlistSortedFiltered(steamID string, query *WishlistSortedFilteredQuery) (
+	c *client.Client,
+	method, reqPath string,
+	params url.Values,
+) {
+	if query == nil {
+		query = &WishlistSortedFilteredQuery{}
+	}
+	input := map[string]any{
+		"steamid": steamID,
+		"data_request": map[string]bool{
+			"include_basic_info": true, // 名称/类型 | Name/type
+			"include_release":    true, // 发行信息 | Release info
+		},
+	}
+	if storeContext := storeBrowseContext(query.CC, query.Lang); storeContext != nil {
+		input["context"] = storeContext
+	}
+	if query.SortOrder != nil {
+		input["sort_order"] = *query.SortOrder
+	}
+	filters := map[string]bool{}
+	for key, v := range map[string]*bool{
+		"exclude_early_access": query.ExcludeEarlyAccess,
+		"exclude_coming_soon":  query.ExcludeComingSoon,
+		"exclude_vr_only":      query.ExcludeVROnly,
+	} {
+		if v != nil {
+			filters[key] = *v
+		}
+	}
+	if len(filters) > 0 {
+		input["filters"] = filters
+	}
+	if query.StartIndex != nil {
+		input["start_index"] = *query.StartIndex
+	}
+	if query.PageSize != nil {
+		input["page_size"] = *query.PageSize
+	}
+	return s.client, "GET", IWishlistService + "/GetWishlistSortedFiltered/v1/", inputJSONParams(input)
+}
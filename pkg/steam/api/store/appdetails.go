@@ -0,0 +1,264 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/GoFurry/gf-steam-sdk/internal/api"
+	"github.com/GoFurry/gf-steam-sdk/internal/client"
+	"github.com/GoFurry/gf-steam-sdk/pkg/models"
+	"github.com/GoFurry/gf-steam-sdk/pkg/option"
+	"github.com/GoFurry/gf-steam-sdk/pkg/util"
+	ue "github.com/GoFurry/gf-steam-sdk/pkg/util/errors"
+)
+
+const (
+	StoreAPI = util.STEAM_STORE_BASE_URL + "api"
+)
+
+// DetailsQuery appdetails/packagedetails 可选参数, 字段为 nil 或空时不发送
+// DetailsQuery holds the optional appdetails/packagedetails params; nil or empty fields are omitted
+type DetailsQuery struct {
+	CC      *string  // 国家/地区代码, 决定价格与货币(如 us, cn) | Country code deciding price and currency (e.g. us, cn)
+	Lang    *string  // 语言(如 english, schinese) | Language (e.g. english, schinese)
+	Filters []string // 仅返回指定字段(如 basic, price_overview), 仅 appdetails 支持 | Only return these fields (e.g. basic, price_overview), appdetails only
+}
+
+// ============================ Raw Bytes 原始字节流接口 ============================
+
+// GetAppDetailsRawBytes get app details from the store 获取商店应用详情
+//   - appID: Game AppID
+//   - query: Optional params (nil for defaults)
+func (s *StoreService) GetAppDetailsRawBytes(appID uint64, query *DetailsQuery, opts ...option.RequestOption) (respBytes []byte, err error) {
+	return s.GetAppDetailsRawBytesCtx(context.Background(), appID, query, opts...)
+}
+
+// GetAppDetailsRawBytesCtx is the context-aware variant of GetAppDetailsRawBytes
+func (s *StoreService) GetAppDetailsRawBytesCtx(ctx context.Context, appID uint64, query *DetailsQuery, opts ...option.RequestOption) (respBytes []byte, err error) {
+	c, method, reqPath, params := s.buildAppDetails(appID, query)
+	return api.GetRawBytesCtx(ctx, c, method, reqPath, params, opts...)
+}
+
+// ============================ Structed Raw Model 结构化原始模型接口 ============================
+
+// GetAppDetailsRawModel get app details from the store 获取商店应用详情
+// 应用不存在或当前地区不可用时返回 errors.ErrAppNotFound | Fails with errors.ErrAppNotFound for unknown or region-locked apps
+//   - appID: Game AppID
+//   - query: Optional params (nil for defaults)
+func (s *StoreService) GetAppDetailsRawModel(appID uint64, query *DetailsQuery, opts ...option.RequestOption) (models.SteamAppDetailsResponse, error) {
+	return s.GetAppDetailsRawModelCtx(context.Background(), appID, query, opts...)
+}
+
+// GetAppDetailsRawModelCtx is the context-aware variant of GetAppDetailsRawModel
+func (s *StoreService) GetAppDetailsRawModelCtx(ctx context.Context, appID uint64, query *DetailsQuery, opts ...option.RequestOption) (models.SteamAppDetailsResponse, error) {
+	c, method, reqPath, params := s.buildAppDetails(appID, query)
+	return api.GetRawModelCtx[models.SteamAppDetailsResponse](ctx, c, method, reqPath, params, opts...)
+}
+
+// ============================ Brief Model 精简模型接口 ============================
+
+// GetAppDetailsBrief get app details from the store 获取商店应用详情
+//   - appID: Game AppID
+//   - query: Optional params (nil for defaults)
+func (s *StoreService) GetAppDetailsBrief(appID uint64, query *DetailsQuery, opts ...option.RequestOption) (models.AppDetails, error) {
+	return s.GetAppDetailsBriefCtx(context.Background(), appID, query, opts...)
+}
+
+// GetAppDetailsBriefCtx is the context-aware variant of GetAppDetailsBrief
+func (s *StoreService) GetAppDetailsBriefCtx(ctx context.Context, appID uint64, query *DetailsQuery, opts ...option.RequestOption) (models.AppDetails, error) {
+	rawDetails, err := s.GetAppDetailsRawModelCtx(ctx, appID, query, opts...)
+	if err != nil {
+		return models.AppDetails{}, err
+	}
+	entry, ok := rawDetails[util.Uint642String(appID)]
+	if !ok || !entry.Success {
+		return models.AppDetails{}, ue.ErrAppNotFound
+	}
+
+	d := entry.Data
+	details := models.AppDetails{
+		AppID:            appID,
+		Type:             d.Type,
+		Name:             d.Name,
+		IsFree:           d.IsFree,
+		RequiredAge:      int(d.RequiredAge),
+		ShortDescription: d.ShortDescription,
+		HeaderImage:      d.HeaderImage,
+		Website:          d.Website,
+		Developers:       d.Developers,
+		Publishers:       d.Publishers,
+		Price:            normalizePrice(d.PriceOverview),
+		Platforms:        platformNames(d.Platforms),
+		ReleaseDate:      d.ReleaseDate.Date,
+		ComingSoon:       d.ReleaseDate.ComingSoon,
+		DLC:              d.DLC,
+		Packages:         d.Packages,
+	}
+	if d.Metacritic != nil {
+		details.MetacriticScore = d.Metacritic.Score
+	}
+	if d.Recommendations != nil {
+		details.Recommendations = d.Recommendations.Total
+	}
+	for _, c := range d.Categories {
+		details.Categories = append(details.Categories, c.Description)
+	}
+	for _, g := range d.Genres {
+		details.Genres = append(details.Genres, g.Description)
+	}
+	for _, shot := range d.Screenshots {
+		details.Screenshots = append(details.Screenshots, shot.PathFull)
+	}
+	return details, nil
+}
+
+// ============================ Default Interface 默认接口 ============================
+
+// GetAppDetails get app details from the store 获取商店应用详情
+//   - appID: Game AppID
+//   - query: Optional params (nil for defaults)
+func (s *StoreService) GetAppDetails(appID uint64, query *DetailsQuery, opts ...option.RequestOption) (models.AppDetails, error) {
+	return s.GetAppDetailsBrief(appID, query, opts...)
+}
+
+// GetAppDetailsCtx is the context-aware variant of GetAppDetails
+func (s *StoreService) GetAppDetailsCtx(ctx context.Context, appID uint64, query *DetailsQuery, opts ...option.RequestOption) (models.AppDetails, error) {
+	return s.GetAppDetailsBriefCtx(ctx, appID, query, opts...)
+}
+
+// GetAppPricesAcrossRegions get app prices in several regions 查询应用在多个地区的价格
+// 每个地区单独请求(filters=price_overview), 以有限并发发出并经过客户端限流, 结果顺序与入参一致;
+// 应用在某地区不可用时该地区 Available 为 false 而不是错误
+// Each region is a separate request (filters=price_overview), sent with bounded concurrency under the client's rate limiter,
+// and results follow the input order; a region where the app is unavailable gets Available=false instead of an error
+//   - appID: Game AppID
+//   - countryCodes: Country codes (e.g. []string{"us", "cn", "jp"})
+//
+// 返回值:
+//   - []models.RegionPrice: 各地区价格(与 countryCodes 一一对应) | Price per region (one-to-one with countryCodes)
+//   - []error: 各地区的请求错误 | Request error per region
+//   - error: 全局错误(如上下文取消) | Global error (e.g. context canceled)
+func (s *StoreService) GetAppPricesAcrossRegions(appID uint64, countryCodes []string, opts ...option.RequestOption) ([]models.RegionPrice, []error, error) {
+	return s.GetAppPricesAcrossRegionsCtx(context.Background(), appID, countryCodes, opts...)
+}
+
+// GetAppPricesAcrossRegionsCtx is the context-aware variant of GetAppPricesAcrossRegions
+func (s *StoreService) GetAppPricesAcrossRegionsCtx(ctx context.Context, appID uint64, countryCodes []string, opts ...option.RequestOption) ([]models.RegionPrice, []error, error) {
+	results := make([]models.RegionPrice, len(countryCodes))
+	errs := make([]error, len(countryCodes))
+	var (
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, util.STORE_REGION_CONCURRENCY) // 限制同时进行的请求数 | Bound in-flight requests
+	)
+	for idx, cc := range countryCodes {
+		wg.Add(1)
+		go func(index int, cc string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			results[index].CountryCode = cc
+			rawDetails, err := s.GetAppDetailsRawModelCtx(ctx, appID, &DetailsQuery{
+				CC:      &cc,
+				Filters: []string{util.STORE_PRICE_FILTER},
+			}, opts...)
+			switch {
+			case errors.Is(err, ue.ErrAppNotFound):
+				// 该地区不可用 | Not available in this region
+			case err != nil:
+				errs[index] = err
+			default:
+				entry := rawDetails[util.Uint642String(appID)]
+				results[index].Available = entry.Success
+				results[index].Price = normalizePrice(entry.Data.PriceOverview)
+			}
+		}(idx, cc)
+	}
+	wg.Wait()
+
+	if ctx.Err() != nil {
+		return results, errs, ue.NewWithType(ue.ErrTypeRequest, "region price query canceled", ctx.Err())
+	}
+	return results, errs, nil
+}
+
+// ============================ Build 构造入参 ============================
+
+// buildAppDetails builds input params.
+func (s *StoreService) buildAppDetails(appID uint64, query *DetailsQuery) (
+	c *client.Client,
+	method, reqPath string,
+	params url.Values,
+) {
+	params = url.Values{}
+	params.Set("appids", util.Uint642String(appID))
+	setDetailsQuery(params, query)
+	return s.client, "GET", StoreAPI + "/appdetails", params
+}
+
+// ============================ 工具方法 ============================
+
+// setDetailsQuery 写入 DetailsQuery 中的参数 | Write the DetailsQuery params
+func setDetailsQuery(params url.Values, query *DetailsQuery) {
+	if query == nil {
+		return
+	}
+	if query.CC != nil {
+		params.Set("cc", *query.CC)
+	}
+	if query.Lang != nil {
+		params.Set("l", *query.Lang)
+	}
+	if len(query.Filters) > 0 {
+		params.Set("filters", strings.Join(query.Filters, ","))
+	}
+}
+
+// normalizePrice 将应用价格转换为统一价格模型 | Convert the app price to the normalized price model
+func normalizePrice(p *models.StorePriceOverview) *models.StorePrice {
+	if p == nil {
+		return nil
+	}
+	return &models.StorePrice{
+		Currency:        p.Currency,
+		Initial:         p.Initial,
+		Final:           p.Final,
+		InitialAmount:   float64(p.Initial) / util.STORE_PRICE_SCALE,
+		FinalAmount:     float64(p.Final) / util.STORE_PRICE_SCALE,
+		DiscountPercent: p.DiscountPercent,
+		FinalFormatted:  p.FinalFormatted,
+	}
+}
+
+// newStorePrice 由原价与现价构造统一价格模型 | Build the normalized price model from initial and final amounts
+func newStorePrice(currency string, initial, final int64) *models.StorePrice {
+	price := &models.StorePrice{
+		Currency:      currency,
+		Initial:       initial,
+		Final:         final,
+		InitialAmount: float64(initial) / util.STORE_PRICE_SCALE,
+		FinalAmount:   float64(final) / util.STORE_PRICE_SCALE,
+	}
+	if initial > 0 && final < initial {
+		price.DiscountPercent = int((initial - final) * 100 / initial)
+	}
+	return price
+}
+
+// platformNames 列出支持的平台 | List the supported platforms
+func platformNames(p models.StorePlatforms) []string {
+	var names []string
+	if p.Windows {
+		names = append(names, "windows")
+	}
+	if p.Mac {
+		names = append(names, "mac")
+	}
+	if p.Linux {
+		names = append(names, "linux")
+	}
+	return names
+}
@@ -0,0 +1,313 @@
+package store
+
+import (
+	"context"
+	"iter"
+	"net/url"
+	"time"
+
+	"github.com/GoFurry/gf-steam-sdk/internal/api"
+	"github.com/GoFurry/gf-steam-sdk/internal/client"
+	"github.com/GoFurry/gf-steam-sdk/pkg/models"
+	"github.com/GoFurry/gf-steam-sdk/pkg/option"
+	"github.com/GoFurry/gf-steam-sdk/pkg/util"
+)
+
+const (
+	StoreAppReviews = util.STEAM_STORE_BASE_URL + "appreviews/"
+)
+
+// ReviewQuery appreviews 可选参数, 字段为 nil 时使用商店默认值
+// ReviewQuery holds the optional appreviews params; nil fields use the store defaults
+type ReviewQuery struct {
+	Filter       *string // 排序: recent/updated/all(默认 all, 按有用度) | Order: recent/updated/all (default all, by helpfulness)
+	Language     *string // 语言, all 为全部语言(如 english, schinese) | Language, all for every language (e.g. english, schinese)
+	ReviewType   *string // 评测类型: all/positive/negative | Review type: all/positive/negative
+	PurchaseType *string // 购买类型: all/non_steam_purchase/steam | Purchase type: all/non_steam_purchase/steam
+	DayRange     *int    // 最近多少天(仅 filter=all 生效, 最大 365) | Last N days (filter=all only, max 365)
+	NumPerPage   *int    // 每页条数(默认 20, 最大 100) | Page size (default 20, max 100)
+	Cursor       *string // 游标, 首页为 "*" | Cursor, "*" for the first page
+}
+
+// ============================ Raw Bytes 原始字节流接口 ============================
+
+// GetAppReviewsRawBytes get one page of app reviews 获取一页应用评测
+//   - appID: Game AppID
+//   - query: Optional params (nil for defaults)
+func (s *StoreService) GetAppReviewsRawBytes(appID uint64, query *ReviewQuery, opts ...option.RequestOption) (respBytes []byte, err error) {
+	return s.GetAppReviewsRawBytesCtx(context.Background(), appID, query, opts...)
+}
+
+// GetAppReviewsRawBytesCtx is the context-aware variant of GetAppReviewsRawBytes
+func (s *StoreService) GetAppReviewsRawBytesCtx(ctx context.Context, appID uint64, query *ReviewQuery, opts ...option.RequestOption) (respBytes []byte, err error) {
+	c, method, reqPath, params := s.buildAppReviews(appID, query)
+	return api.GetRawBytesCtx(ctx, c, method, reqPath, params, opts...)
+}
+
+// ============================ Structed Raw Model 结构化原始模型接口 ============================
+
+// GetAppReviewsRawModel get one page of app reviews 获取一页应用评测
+//   - appID: Game AppID
+//   - query: Optional params (nil for defaults)
+func (s *StoreService) GetAppReviewsRawModel(appID uint64, query *ReviewQuery, opts ...option.RequestOption) (models.SteamAppReviewsResponse, error) {
+	return s.GetAppReviewsRawModelCtx(context.Background(), appID, query, opts...)
+}
+
+// GetAppReviewsRawModelCtx is the context-aware variant of GetAppReviewsRawModel
+func (s *StoreService) GetAppReviewsRawModelCtx(ctx context.Context, appID uint64, query *ReviewQuery, opts ...option.RequestOption) (models.SteamAppReviewsResponse, error) {
+	c, method, reqPath, params := s.buildAppReviews(appID, query)
+	return api.GetRawModelCtx[models.SteamAppReviewsResponse](ctx, c, method, reqPath, params, opts...)
+}
+
+// ============================ Brief Model 精简模型接口 ============================
+
+// GetAppReviewsBrief get one page of app reviews 获取一页应用评测
+//   - appID: Game AppID
+//   - query: Optional params (nil for defaults)
+func (s *StoreService) GetAppReviewsBrief(appID uint64, query *ReviewQuery, opts ...option.RequestOption) (models.AppReviewPage, error) {
+	return s.GetAppReviewsBriefCtx(context.Background(), appID, query, opts...)
+}
+
+// GetAppReviewsBriefCtx is the context-aware variant of GetAppReviewsBrief
+func (s *StoreService) GetAppReviewsBriefCtx(ctx context.Context, appID uint64, query *ReviewQuery, opts ...option.RequestOption) (models.AppReviewPage, error) {
+	rawReviews, err := s.GetAppReviewsRawModelCtx(ctx, appID, query, opts...)
+	if err != nil {
+		return models.AppReviewPage{}, err
+	}
+
+	page := models.AppReviewPage{
+		Reviews: make([]models.AppReview, 0, len(rawReviews.Reviews)),
+		Cursor:  rawReviews.Cursor,
+	}
+	for _, r := range rawReviews.Reviews {
+		page.Reviews = append(page.Reviews, models.AppReview{
+			RecommendationID:         r.RecommendationID,
+			AuthorSteamID:            r.Author.SteamID,
+			PlaytimeAtReview:         r.Author.PlaytimeAtReview,
+			PlaytimeForever:          r.Author.PlaytimeForever,
+			Language:                 r.Language,
+			Review:                   r.Review,
+			VotedUp:                  r.VotedUp,
+			VotesUp:                  r.VotesUp,
+			VotesFunny:               r.VotesFunny,
+			WeightedVoteScore:        float64(r.WeightedVoteScore),
+			SteamPurchase:            r.SteamPurchase,
+			ReceivedForFree:          r.ReceivedForFree,
+			WrittenDuringEarlyAccess: r.WrittenDuringEarlyAccess,
+			Created:                  r.TimestampCreated,
+			CreatedStr:               util.TimeUnix2String(r.TimestampCreated), // 格式化创建时间 | Format creation time
+			Updated:                  r.TimestampUpdated,
+		})
+	}
+	return page, nil
+}
+
+// GetReviewSummary get the review score and totals 获取评测评分与总数
+// 仅首页(cursor=*)返回总数, 因此固定请求首页且不返回评测正文
+// Totals are only returned on the first page (cursor=*), so the first page is always requested without review bodies
+//   - appID: Game AppID
+//   - query: Optional filters, Cursor and NumPerPage are ignored (nil for defaults)
+func (s *StoreService) GetReviewSummary(appID uint64, query *ReviewQuery, opts ...option.RequestOption) (models.ReviewSummary, error) {
+	return s.GetReviewSummaryCtx(context.Background(), appID, query, opts...)
+}
+
+// GetReviewSummaryCtx is the context-aware variant of GetReviewSummary
+func (s *StoreService) GetReviewSummaryCtx(ctx context.Context, appID uint64, query *ReviewQuery, opts ...option.RequestOption) (models.ReviewSummary, error) {
+	page := ReviewQuery{}
+	if query != nil {
+		page = *query
+	}
+	cursor, size := util.REVIEW_FIRST_CURSOR, 0
+	page.Cursor, page.NumPerPage = &cursor, &size
+
+	rawReviews, err := s.GetAppReviewsRawModelCtx(ctx, appID, &page, opts...)
+	if err != nil {
+		return models.ReviewSummary{}, err
+	}
+	sum := rawReviews.QuerySummary
+	return models.ReviewSummary{
+		ReviewScore:     sum.ReviewScore,
+		ReviewScoreDesc: sum.ReviewScoreDesc,
+		TotalPositive:   sum.TotalPositive,
+		TotalNegative:   sum.TotalNegative,
+		TotalReviews:    sum.TotalReviews,
+		PositiveRatio:   positiveRatio(sum.TotalPositive, sum.TotalPositive+sum.TotalNegative),
+	}, nil
+}
+
+// ============================ Default Interface 默认接口 ============================
+
+// GetAppReviews get one page of app reviews 获取一页应用评测
+//   - appID: Game AppID
+//   - query: Optional params (nil for defaults)
+func (s *StoreService) GetAppReviews(appID uint64, query *ReviewQuery, opts ...option.RequestOption) (models.AppReviewPage, error) {
+	return s.GetAppReviewsBrief(appID, query, opts...)
+}
+
+// GetAppReviewsCtx is the context-aware variant of GetAppReviews
+func (s *StoreService) GetAppReviewsCtx(ctx context.Context, appID uint64, query *ReviewQuery, opts ...option.RequestOption) (models.AppReviewPage, error) {
+	return s.GetAppReviewsBriefCtx(ctx, appID, query, opts...)
+}
+
+// AppReviews iterate app reviews across pages 跨页遍历应用评测
+// 按 cursor 翻页直到没有更多评测; 商店有时重复返回已请求过的 cursor, 此时结束遍历, 重复的评测按 ID 去重;
+// 请求失败时产出一次错误后结束
+// Pages are followed through cursor until exhausted; the store sometimes hands back a cursor already requested,
+// which ends the iteration, and repeated reviews are dropped by ID; a failed request yields the error once and ends the iteration
+//   - appID: Game AppID
+//   - query: Optional params, Cursor is the starting point (nil for defaults)
+func (s *StoreService) AppReviews(appID uint64, query *ReviewQuery, opts ...option.RequestOption) iter.Seq2[models.AppReview, error] {
+	return s.AppReviewsCtx(context.Background(), appID, query, opts...)
+}
+
+// AppReviewsCtx is the context-aware variant of AppReviews
+func (s *StoreService) AppReviewsCtx(ctx context.Context, appID uint64, query *ReviewQuery, opts ...option.RequestOption) iter.Seq2[models.AppReview, error] {
+	return func(yield func(models.AppReview, error) bool) {
+		page := ReviewQuery{}
+		if query != nil {
+			page = *query
+		}
+		cursor := util.REVIEW_FIRST_CURSOR
+		if page.Cursor != nil {
+			cursor = *page.Cursor
+		}
+		requested := map[string]bool{}
+		seen := map[string]bool{}
+		for {
+			requested[cursor] = true
+			page.Cursor = &cursor
+			list, err := s.GetAppReviewsBriefCtx(ctx, appID, &page, opts...)
+			if err != nil {
+				yield(models.AppReview{}, err)
+				return
+			}
+			for _, review := range list.Reviews {
+				if seen[review.RecommendationID] {
+					continue
+				}
+				seen[review.RecommendationID] = true
+				if !yield(review, nil) {
+					return
+				}
+			}
+			if len(list.Reviews) == 0 || list.Cursor == "" || requested[list.Cursor] {
+				return
+			}
+			cursor = list.Cursor
+		}
+	}
+}
+
+// GetReviewRatioWindows get the positive ratio of recent reviews over trailing windows 统计近期评测在各时间窗口内的好评率
+// 按创建时间倒序(filter=recent)遍历评测, 超出最长窗口后停止请求
+// Reviews are walked newest first (filter=recent) and paging stops once they fall outside the longest window
+//   - appID: Game AppID
+//   - windows: Trailing windows up to now (e.g. 7*24*time.Hour, 30*24*time.Hour)
+//   - query: Optional params, Filter and Cursor are overridden (nil for defaults)
+func (s *StoreService) GetReviewRatioWindows(appID uint64, windows []time.Duration, query *ReviewQuery, opts ...option.RequestOption) ([]models.ReviewWindow, error) {
+	return s.GetReviewRatioWindowsCtx(context.Background(), appID, windows, query, opts...)
+}
+
+// GetReviewRatioWindowsCtx is the context-aware variant of GetReviewRatioWindows
+func (s *StoreService) GetReviewRatioWindowsCtx(ctx context.Context, appID uint64, windows []time.Duration, query *ReviewQuery, opts ...option.RequestOption) ([]models.ReviewWindow, error) {
+	now := time.Now()
+	longest := time.Duration(0)
+	for _, w := range windows {
+		longest = max(longest, w)
+	}
+
+	page := ReviewQuery{}
+	if query != nil {
+		page = *query
+	}
+	filter, size := util.REVIEW_FILTER_RECENT, util.REVIEW_PAGE_SIZE_MAX
+	page.Filter, page.Cursor = &filter, nil
+	if page.NumPerPage == nil {
+		page.NumPerPage = &size
+	}
+
+	oldest := now.Add(-longest).Unix()
+	var reviews []models.AppReview
+	for review, err := range s.AppReviewsCtx(ctx, appID, &page, opts...) {
+		if err != nil {
+			return nil, err
+		}
+		if review.Created < oldest {
+			break
+		}
+		reviews = append(reviews, review)
+	}
+	return ReviewRatioWindows(reviews, windows, now), nil
+}
+
+// ============================ Build 构造入参 ============================
+
+// buildAppReviews builds input params.
+func (s *StoreService) buildAppReviews(appID uint64, query *ReviewQuery) (
+	c *client.Client,
+	method, reqPath string,
+	params url.Values,
+) {
+	params = url.Values{}
+	params.Set("json", "1")
+	if query != nil {
+		setStringParam(params, "filter", query.Filter)
+		setStringParam(params, "language", query.Language)
+		setStringParam(params, "review_type", query.ReviewType)
+		setStringParam(params, "purchase_type", query.PurchaseType)
+		setStringParam(params, "cursor", query.Cursor)
+		if query.DayRange != nil {
+			params.Set("day_range", util.Int2String(*query.DayRange))
+		}
+		if query.NumPerPage != nil {
+			params.Set("num_per_page", util.Int2String(*query.NumPerPage))
+		}
+	}
+	return s.client, "GET", StoreAppReviews + util.Uint642String(appID), params
+}
+
+// ============================ 工具方法 ============================
+
+// ReviewRatioWindows 统计评测在截至 now 的各时间窗口内的好评率, 结果顺序与 windows 一致
+// ReviewRatioWindows computes the positive ratio of reviews within each trailing window ending at now, in the order of windows
+//   - reviews: Reviews to count (any order)
+//   - windows: Trailing window lengths
+//   - now: End of every window
+func ReviewRatioWindows(reviews []models.AppReview, windows []time.Duration, now time.Time) []models.ReviewWindow {
+	out := make([]models.ReviewWindow, len(windows))
+	for i, w := range windows {
+		out[i] = models.ReviewWindow{Window: w, Since: now.Add(-w).Unix()}
+	}
+	for _, r := range reviews {
+		for i := range out {
+			if r.Created < out[i].Since || r.Created > now.Unix() {
+				continue
+			}
+			out[i].Total++
+			if r.VotedUp {
+				out[i].Positive++
+			} else {
+				out[i].Negative++
+			}
+		}
+	}
+	for i := range out {
+		out[i].PositiveRatio = positiveRatio(out[i].Positive, out[i].Total)
+	}
+	return out
+}
+
+// positiveRatio 计算好评率, 无评测时为 0 | Positive ratio, 0 without reviews
+func positiveRatio(positive, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(positive) / float64(total)
+}
+
+// setStringParam 设置非 nil 的字符串参数 | Set a non-nil string param
+func setStringParam(params url.Values, key string, v *string) {
+	if v != nil {
+		params.Set(key, *v)
+	}
+}
@@ -0,0 +1,134 @@
+package store_test
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/GoFurry/gf-steam-sdk/pkg/steam/api/store"
+	"github.com/GoFurry/gf-steam-sdk/pkg/steamtest"
+	ue "github.com/GoFurry/gf-steam-sdk/pkg/util/errors"
+)
+
+const appReviews = "appreviews"
+
+// reviewPage 构造一页评测响应 | Build one page of the reviews response
+func reviewPage(cursor string, ids ...string) steamtest.Failure {
+	reviews := make([]string, len(ids))
+	for i, id := range ids {
+		reviews[i] = fmt.Sprintf(`{"recommendationid":%q,"voted_up":true}`, id)
+	}
+	return steamtest.Failure{
+		Status: http.StatusOK,
+		Body:   []byte(fmt.Sprintf(`{"success":1,"reviews":[%s],"cursor":%q}`, strings.Join(reviews, ","), cursor)),
+	}
+}
+
+func TestAppReviewsCursorPaging(t *testing.T) {
+	tests := []struct {
+		name    string
+		pages   []steamtest.Failure
+		limit   int // >0 时读取该数量后停止 | Stop after this many reviews when >0
+		want    []string
+		cursors []string
+		wantErr bool // 是否以一次参数错误结束 | Whether the walk ends with one param error
+	}{
+		{
+			name:    "dedupes reviews and stops on a repeated cursor",
+			pages:   []steamtest.Failure{reviewPage("c2", "1", "2"), reviewPage("c3", "2", "3"), reviewPage("c2", "3", "4")},
+			want:    []string{"1", "2", "3", "4"},
+			cursors: []string{"*", "c2", "c3"},
+		},
+		{
+			name:    "stops on the first page's own cursor",
+			pages:   []steamtest.Failure{reviewPage("*", "1", "2")},
+			want:    []string{"1", "2"},
+			cursors: []string{"*"},
+		},
+		{
+			name:    "stops on an empty page",
+			pages:   []steamtest.Failure{reviewPage("c2", "1"), reviewPage("c3")},
+			want:    []string{"1"},
+			cursors: []string{"*", "c2"},
+		},
+		{
+			name:    "stops on an empty cursor",
+			pages:   []steamtest.Failure{reviewPage("", "1")},
+			want:    []string{"1"},
+			cursors: []string{"*"},
+		},
+		{
+			name:    "stops requesting when the caller breaks",
+			pages:   []steamtest.Failure{reviewPage("c2", "1", "2"), reviewPage("c3", "3")},
+			limit:   1,
+			want:    []string{"1"},
+			cursors: []string{"*"},
+		},
+		{
+			name:    "yields a failed page once",
+			pages:   []steamtest.Failure{reviewPage("c2", "1"), {Status: http.StatusOK, Body: []byte(`{"success":2}`)}},
+			want:    []string{"1"},
+			cursors: []string{"*", "c2"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sdk, srv := steamtest.NewSDK(t)
+			for _, page := range tt.pages {
+				srv.Fail(appReviews, page, 1)
+			}
+
+			var got []string
+			var errs []error
+			for review, err := range sdk.Store.AppReviews(steamtest.AppID, nil) {
+				if err != nil {
+					errs = append(errs, err)
+					continue
+				}
+				got = append(got, review.RecommendationID)
+				if tt.limit > 0 && len(got) == tt.limit {
+					break
+				}
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("want reviews %v, got %v", tt.want, got)
+			}
+			var cursors []string
+			for _, r := range srv.Requests() {
+				cursors = append(cursors, r.Query.Get("cursor"))
+			}
+			if !reflect.DeepEqual(cursors, tt.cursors) {
+				t.Fatalf("want cursors %v, got %v", tt.cursors, cursors)
+			}
+			switch {
+			case !tt.wantErr && len(errs) != 0:
+				t.Fatalf("unexpected errors: %v", errs)
+			case tt.wantErr && (len(errs) != 1 || ue.GetType(errs[0]) != ue.ErrTypeParam):
+				t.Fatalf("want one param error, got %v", errs)
+			}
+		})
+	}
+}
+
+func TestAppReviewsStartCursor(t *testing.T) {
+	sdk, srv := steamtest.NewSDK(t)
+	srv.Fail(appReviews, reviewPage("", "9"), 1)
+
+	start := "resume-here"
+	query := &store.ReviewQuery{Cursor: &start}
+	for _, err := range sdk.Store.AppReviews(steamtest.AppID, query) {
+		if err != nil {
+			t.Fatalf("walk: %v", err)
+		}
+	}
+	if reqs := srv.Requests(); len(reqs) != 1 || reqs[0].Query.Get("cursor") != start {
+		t.Fatalf("want one request from cursor %q, got %+v", start, reqs)
+	}
+	if *query.Cursor != start {
+		t.Fatal("AppReviews modified the caller's query")
+	}
+}
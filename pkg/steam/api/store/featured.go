@@ -0,0 +1,196 @@
+package store
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/GoFurry/gf-steam-sdk/internal/api"
+	"github.com/GoFurry/gf-steam-sdk/internal/client"
+	"github.com/GoFurry/gf-steam-sdk/pkg/config"
+	"github.com/GoFurry/gf-steam-sdk/pkg/models"
+	"github.com/GoFurry/gf-steam-sdk/pkg/option"
+	"github.com/GoFurry/gf-steam-sdk/pkg/util"
+)
+
+// ============================ Raw Bytes 原始字节流接口 ============================
+
+// GetFeaturedRawBytes get the store front page featured items 获取商店首页推荐
+//   - cc: Country code ("" for store default)
+//   - lang: Language ("" for store default)
+func (s *StoreService) GetFeaturedRawBytes(cc, lang string, opts ...option.RequestOption) (respBytes []byte, err error) {
+	return s.GetFeaturedRawBytesCtx(context.Background(), cc, lang, opts...)
+}
+
+// GetFeaturedRawBytesCtx is the context-aware variant of GetFeaturedRawBytes
+func (s *StoreService) GetFeaturedRawBytesCtx(ctx context.Context, cc, lang string, opts ...option.RequestOption) (respBytes []byte, err error) {
+	c, method, reqPath, params := s.buildFeatured("/featured/", cc, lang)
+	return api.GetRawBytesCtx(ctx, c, method, reqPath, params, opts...)
+}
+
+// GetFeaturedCategoriesRawBytes get the store featured categories 获取商店推荐分类
+//   - cc: Country code ("" for store default)
+//   - lang: Language ("" for store default)
+func (s *StoreService) GetFeaturedCategoriesRawBytes(cc, lang string, opts ...option.RequestOption) (respBytes []byte, err error) {
+	return s.GetFeaturedCategoriesRawBytesCtx(context.Background(), cc, lang, opts...)
+}
+
+// GetFeaturedCategoriesRawBytesCtx is the context-aware variant of GetFeaturedCategoriesRawBytes
+func (s *StoreService) GetFeaturedCategoriesRawBytesCtx(ctx context.Context, cc, lang string, opts ...option.RequestOption) (respBytes []byte, err error) {
+	c, method, reqPath, params := s.buildFeatured("/featuredcategories/", cc, lang)
+	return api.GetRawBytesCtx(ctx, c, method, reqPath, params, opts...)
+}
+
+// ============================ Structed Raw Model 结构化原始模型接口 ============================
+
+// GetFeaturedRawModel get the store front page featured items 获取商店首页推荐
+//   - cc: Country code ("" for store default)
+//   - lang: Language ("" for store default)
+func (s *StoreService) GetFeaturedRawModel(cc, lang string, opts ...option.RequestOption) (models.SteamFeaturedResponse, error) {
+	return s.GetFeaturedRawModelCtx(context.Background(), cc, lang, opts...)
+}
+
+// GetFeaturedRawModelCtx is the context-aware variant of GetFeaturedRawModel
+func (s *StoreService) GetFeaturedRawModelCtx(ctx context.Context, cc, lang string, opts ...option.RequestOption) (models.SteamFeaturedResponse, error) {
+	c, method, reqPath, params := s.buildFeatured("/featured/", cc, lang)
+	return api.GetRawModelCtx[models.SteamFeaturedResponse](ctx, c, method, reqPath, params, opts...)
+}
+
+// GetFeaturedCategoriesRawModel get the store featured categories 获取商店推荐分类
+//   - cc: Country code ("" for store default)
+//   - lang: Language ("" for store default)
+func (s *StoreService) GetFeaturedCategoriesRawModel(cc, lang string, opts ...option.RequestOption) (models.SteamFeaturedCategoriesResponse, error) {
+	return s.GetFeaturedCategoriesRawModelCtx(context.Background(), cc, lang, opts...)
+}
+
+// GetFeaturedCategoriesRawModelCtx is the context-aware variant of GetFeaturedCategoriesRawModel
+func (s *StoreService) GetFeaturedCategoriesRawModelCtx(ctx context.Context, cc, lang string, opts ...option.RequestOption) (models.SteamFeaturedCategoriesResponse, error) {
+	c, method, reqPath, params := s.buildFeatured("/featuredcategories/", cc, lang)
+	return api.GetRawModelCtx[models.SteamFeaturedCategoriesResponse](ctx, c, method, reqPath, params, opts...)
+}
+
+// ============================ Brief Model 精简模型接口 ============================
+
+// GetFeaturedBrief get the store front page featured items 获取商店首页推荐
+//   - cc: Country code ("" for store default)
+//   - lang: Language ("" for store default)
+func (s *StoreService) GetFeaturedBrief(cc, lang string, opts ...option.RequestOption) (models.Featured, error) {
+	return s.GetFeaturedBriefCtx(context.Background(), cc, lang, opts...)
+}
+
+// GetFeaturedBriefCtx is the context-aware variant of GetFeaturedBrief
+func (s *StoreService) GetFeaturedBriefCtx(ctx context.Context, cc, lang string, opts ...option.RequestOption) (models.Featured, error) {
+	rawFeatured, err := s.GetFeaturedRawModelCtx(ctx, cc, lang, opts...)
+	if err != nil {
+		return models.Featured{}, err
+	}
+
+	endpoints := s.client.Endpoints()
+	return models.Featured{
+		LargeCapsules: featuredApps(rawFeatured.LargeCapsules, endpoints),
+		Windows:       featuredApps(rawFeatured.FeaturedWin, endpoints),
+		Mac:           featuredApps(rawFeatured.FeaturedMac, endpoints),
+		Linux:         featuredApps(rawFeatured.FeaturedLinux, endpoints),
+	}, nil
+}
+
+// GetFeaturedCategoriesBrief get the store featured categories 获取商店推荐分类
+//   - cc: Country code ("" for store default)
+//   - lang: Language ("" for store default)
+func (s *StoreService) GetFeaturedCategoriesBrief(cc, lang string, opts ...option.RequestOption) (models.FeaturedCategories, error) {
+	return s.GetFeaturedCategoriesBriefCtx(context.Background(), cc, lang, opts...)
+}
+
+// GetFeaturedCategoriesBriefCtx is the context-aware variant of GetFeaturedCategoriesBrief
+func (s *StoreService) GetFeaturedCategoriesBriefCtx(ctx context.Context, cc, lang string, opts ...option.RequestOption) (models.FeaturedCategories, error) {
+	rawCategories, err := s.GetFeaturedCategoriesRawModelCtx(ctx, cc, lang, opts...)
+	if err != nil {
+		return models.FeaturedCategories{}, err
+	}
+
+	endpoints := s.client.Endpoints()
+	return models.FeaturedCategories{
+		Specials:    featuredApps(rawCategories.Specials.Items, endpoints),
+		TopSellers:  featuredApps(rawCategories.TopSellers.Items, endpoints),
+		NewReleases: featuredApps(rawCategories.NewReleases.Items, endpoints),
+		ComingSoon:  featuredApps(rawCategories.ComingSoon.Items, endpoints),
+	}, nil
+}
+
+// ============================ Default Interface 默认接口 ============================
+
+// GetFeatured get the store front page featured items 获取商店首页推荐
+//   - cc: Country code ("" for store default)
+//   - lang: Language ("" for store default)
+func (s *StoreService) GetFeatured(cc, lang string, opts ...option.RequestOption) (models.Featured, error) {
+	return s.GetFeaturedBrief(cc, lang, opts...)
+}
+
+// GetFeaturedCtx is the context-aware variant of GetFeatured
+func (s *StoreService) GetFeaturedCtx(ctx context.Context, cc, lang string, opts ...option.RequestOption) (models.Featured, error) {
+	return s.GetFeaturedBriefCtx(ctx, cc, lang, opts...)
+}
+
+// GetFeaturedCategories get the store featured categories 获取商店推荐分类
+// 包含特惠、热销、新品与即将推出 | Covers specials, top sellers, new releases and coming soon
+//   - cc: Country code ("" for store default)
+//   - lang: Language ("" for store default)
+func (s *StoreService) GetFeaturedCategories(cc, lang string, opts ...option.RequestOption) (models.FeaturedCategories, error) {
+	return s.GetFeaturedCategoriesBrief(cc, lang, opts...)
+}
+
+// GetFeaturedCategoriesCtx is the context-aware variant of GetFeaturedCategories
+func (s *StoreService) GetFeaturedCategoriesCtx(ctx context.Context, cc, lang string, opts ...option.RequestOption) (models.FeaturedCategories, error) {
+	return s.GetFeaturedCategoriesBriefCtx(ctx, cc, lang, opts...)
+}
+
+// ============================ Build 构造入参 ============================
+
+// buildFeatured builds input params.
+func (s *StoreService) buildFeatured(feed, cc, lang string) (
+	c *client.Client,
+	method, reqPath string,
+	params url.Values,
+) {
+	params = url.Values{}
+	setLocaleParams(params, cc, lang)
+	return s.client, "GET", StoreAPI + feed, params
+}
+
+// ============================ 工具方法 ============================
+
+// featuredApps 将推荐商品转换为精简模型 | Convert featured items to the brief model
+func featuredApps(items []models.SteamFeaturedItem, endpoints config.Endpoints) []models.FeaturedApp {
+	apps := make([]models.FeaturedApp, 0, len(items))
+	for _, item := range items {
+		app := models.FeaturedApp{
+			ID:                    item.ID,
+			Type:                  item.Type,
+			Name:                  item.Name,
+			Discounted:            item.Discounted,
+			DiscountExpiration:    item.DiscountExpiration,
+			DiscountExpirationStr: util.TimeUnix2String(item.DiscountExpiration),
+			Platforms: platformNames(models.StorePlatforms{
+				Windows: item.WindowsAvailable,
+				Mac:     item.MacAvailable,
+				Linux:   item.LinuxAvailable,
+			}),
+		}
+		if item.Currency != "" {
+			initial := item.FinalPrice
+			if item.OriginalPrice != nil {
+				initial = *item.OriginalPrice
+			}
+			app.Price = newStorePrice(item.Currency, initial, item.FinalPrice)
+			app.Price.DiscountPercent = item.DiscountPercent
+		}
+		// 应用使用与 OwnedGame 相同的封面地址, 礼包使用接口返回的头图
+		// Apps get the same capsule URL as OwnedGame, packages keep the header image from the response
+		if item.Type == 0 {
+			app.CapsuleURL = endpoints.CapsuleURL(item.ID)
+		} else {
+			app.CapsuleURL = item.HeaderImage
+		}
+		apps = append(apps, app)
+	}
+	return apps
+}
@@ -0,0 +1,119 @@
+package store
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/GoFurry/gf-steam-sdk/internal/api"
+	"github.com/GoFurry/gf-steam-sdk/internal/client"
+	"github.com/GoFurry/gf-steam-sdk/pkg/models"
+	"github.com/GoFurry/gf-steam-sdk/pkg/option"
+	"github.com/GoFurry/gf-steam-sdk/pkg/util"
+	"github.com/GoFurry/gf-steam-sdk/pkg/util/errors"
+)
+
+// ============================ Raw Bytes 原始字节流接口 ============================
+
+// GetPackageDetailsRawBytes get package details from the store 获取商店礼包详情
+//   - packageID: Package (sub) ID
+//   - query: Optional params, Filters is ignored (nil for defaults)
+func (s *StoreService) GetPackageDetailsRawBytes(packageID uint64, query *DetailsQuery, opts ...option.RequestOption) (respBytes []byte, err error) {
+	return s.GetPackageDetailsRawBytesCtx(context.Background(), packageID, query, opts...)
+}
+
+// GetPackageDetailsRawBytesCtx is the context-aware variant of GetPackageDetailsRawBytes
+func (s *StoreService) GetPackageDetailsRawBytesCtx(ctx context.Context, packageID uint64, query *DetailsQuery, opts ...option.RequestOption) (respBytes []byte, err error) {
+	c, method, reqPath, params := s.buildPackageDetails(packageID, query)
+	return api.GetRawBytesCtx(ctx, c, method, reqPath, params, opts...)
+}
+
+// ============================ Structed Raw Model 结构化原始模型接口 ============================
+
+// GetPackageDetailsRawModel get package details from the store 获取商店礼包详情
+// 礼包不存在或当前地区不可用时返回 errors.ErrPackageNotFound | Fails with errors.ErrPackageNotFound for unknown or region-locked packages
+//   - packageID: Package (sub) ID
+//   - query: Optional params, Filters is ignored (nil for defaults)
+func (s *StoreService) GetPackageDetailsRawModel(packageID uint64, query *DetailsQuery, opts ...option.RequestOption) (models.SteamPackageDetailsResponse, error) {
+	return s.GetPackageDetailsRawModelCtx(context.Background(), packageID, query, opts...)
+}
+
+// GetPackageDetailsRawModelCtx is the context-aware variant of GetPackageDetailsRawModel
+func (s *StoreService) GetPackageDetailsRawModelCtx(ctx context.Context, packageID uint64, query *DetailsQuery, opts ...option.RequestOption) (models.SteamPackageDetailsResponse, error) {
+	c, method, reqPath, params := s.buildPackageDetails(packageID, query)
+	return api.GetRawModelCtx[models.SteamPackageDetailsResponse](ctx, c, method, reqPath, params, opts...)
+}
+
+// ============================ Brief Model 精简模型接口 ============================
+
+// GetPackageDetailsBrief get package details from the store 获取商店礼包详情
+//   - packageID: Package (sub) ID
+//   - query: Optional params, Filters is ignored (nil for defaults)
+func (s *StoreService) GetPackageDetailsBrief(packageID uint64, query *DetailsQuery, opts ...option.RequestOption) (models.PackageDetails, error) {
+	return s.GetPackageDetailsBriefCtx(context.Background(), packageID, query, opts...)
+}
+
+// GetPackageDetailsBriefCtx is the context-aware variant of GetPackageDetailsBrief
+func (s *StoreService) GetPackageDetailsBriefCtx(ctx context.Context, packageID uint64, query *DetailsQuery, opts ...option.RequestOption) (models.PackageDetails, error) {
+	rawDetails, err := s.GetPackageDetailsRawModelCtx(ctx, packageID, query, opts...)
+	if err != nil {
+		return models.PackageDetails{}, err
+	}
+	entry, ok := rawDetails[util.Uint642String(packageID)]
+	if !ok || !entry.Success {
+		return models.PackageDetails{}, errors.ErrPackageNotFound
+	}
+
+	d := entry.Data
+	details := models.PackageDetails{
+		PackageID:   packageID,
+		Name:        d.Name,
+		HeaderImage: d.HeaderImage,
+		Apps:        d.Apps,
+		Platforms:   platformNames(d.Platforms),
+		ReleaseDate: d.ReleaseDate.Date,
+		ComingSoon:  d.ReleaseDate.ComingSoon,
+	}
+	if p := d.Price; p != nil {
+		details.Price = &models.StorePrice{
+			Currency:        p.Currency,
+			Initial:         p.Initial,
+			Final:           p.Final,
+			InitialAmount:   float64(p.Initial) / util.STORE_PRICE_SCALE,
+			FinalAmount:     float64(p.Final) / util.STORE_PRICE_SCALE,
+			DiscountPercent: p.DiscountPercent,
+		}
+		details.Individual = p.Individual
+	}
+	return details, nil
+}
+
+// ============================ Default Interface 默认接口 ============================
+
+// GetPackageDetails get package details from the store 获取商店礼包详情
+//   - packageID: Package (sub) ID
+//   - query: Optional params, Filters is ignored (nil for defaults)
+func (s *StoreService) GetPackageDetails(packageID uint64, query *DetailsQuery, opts ...option.RequestOption) (models.PackageDetails, error) {
+	return s.GetPackageDetailsBrief(packageID, query, opts...)
+}
+
+// GetPackageDetailsCtx is the context-aware variant of GetPackageDetails
+func (s *StoreService) GetPackageDetailsCtx(ctx context.Context, packageID uint64, query *DetailsQuery, opts ...option.RequestOption) (models.PackageDetails, error) {
+	return s.GetPackageDetailsBriefCtx(ctx, packageID, query, opts...)
+}
+
+// ============================ Build 构造入参 ============================
+
+// buildPackageDetails builds input params.
+func (s *StoreService) buildPackageDetails(packageID uint64, query *DetailsQuery) (
+	c *client.Client,
+	method, reqPath string,
+	params url.Values,
+) {
+	params = url.Values{}
+	params.Set("packageids", util.Uint642String(packageID))
+	if query != nil {
+		// packagedetails 不支持 filters | packagedetails does not support filters
+		setDetailsQuery(params, &DetailsQuery{CC: query.CC, Lang: query.Lang})
+	}
+	return s.client, "GET", StoreAPI + "/packagedetails", params
+}
@@ -0,0 +1,427 @@
+package store
+
+import (
+	"context"
+	"iter"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/GoFurry/gf-steam-sdk/internal/api"
+	"github.com/GoFurry/gf-steam-sdk/internal/client"
+	"github.com/GoFurry/gf-steam-sdk/pkg/config"
+	"github.com/GoFurry/gf-steam-sdk/pkg/models"
+	"github.com/GoFurry/gf-steam-sdk/pkg/option"
+	"github.com/GoFurry/gf-steam-sdk/pkg/util"
+	"github.com/GoFurry/gf-steam-sdk/pkg/util/errors"
+	"github.com/PuerkitoBio/goquery"
+)
+
+const (
+	StoreSearch = util.STEAM_STORE_BASE_URL + "search"
+)
+
+// SearchQuery search/results 可选参数, 字段为 nil 或空时不发送
+// SearchQuery holds the optional search/results params; nil or empty fields are omitted
+type SearchQuery struct {
+	Term       *string  // 搜索词 | Search term
+	Tags       []int    // 标签ID(如 19 动作, 492 独立) | Tag IDs (e.g. 19 Action, 492 Indie)
+	Types      []int    // 商品类型 category1(如 998 游戏, 21 DLC) | Item types, category1 (e.g. 998 games, 21 DLC)
+	Categories []int    // 功能分类 category2(如 2 单人, 9 合作) | Feature categories, category2 (e.g. 2 single-player, 9 co-op)
+	OS         []string // 操作系统: win/mac/linux | Operating systems: win/mac/linux
+	MaxPrice   *string  // 最高价格, free 为仅免费(如 "10", "free") | Max price, free for free only (e.g. "10", "free")
+	Specials   *bool    // 仅特惠 | Specials only
+	SortBy     *string  // 排序(如 Released_DESC, Price_ASC, Reviews_DESC) | Sort order (e.g. Released_DESC, Price_ASC, Reviews_DESC)
+	CC         *string  // 国家/地区代码 | Country code
+	Lang       *string  // 语言 | Language
+	Start      *int     // 起始偏移(默认 0) | Start offset (default 0)
+	Count      *int     // 每页条数(默认 50, 最大 100) | Page size (default 50, max 100)
+}
+
+// ============================ Raw Bytes 原始字节流接口 ============================
+
+// SearchRawBytes search the store by term 按关键词搜索商店
+//   - term: Search term
+//   - cc: Country code ("" for store default)
+//   - lang: Language ("" for store default)
+func (s *StoreService) SearchRawBytes(term, cc, lang string, opts ...option.RequestOption) (respBytes []byte, err error) {
+	return s.SearchRawBytesCtx(context.Background(), term, cc, lang, opts...)
+}
+
+// SearchRawBytesCtx is the context-aware variant of SearchRawBytes
+func (s *StoreService) SearchRawBytesCtx(ctx context.Context, term, cc, lang string, opts ...option.RequestOption) (respBytes []byte, err error) {
+	c, method, reqPath, params := s.buildStoreSearch(term, cc, lang)
+	return api.GetRawBytesCtx(ctx, c, method, reqPath, params, opts...)
+}
+
+// SearchSuggestRawBytes get search suggestions 获取搜索建议
+//   - term: Search term
+//   - cc: Country code ("" for store default)
+//   - lang: Language ("" for store default)
+func (s *StoreService) SearchSuggestRawBytes(term, cc, lang string, opts ...option.RequestOption) (respBytes []byte, err error) {
+	return s.SearchSuggestRawBytesCtx(context.Background(), term, cc, lang, opts...)
+}
+
+// SearchSuggestRawBytesCtx is the context-aware variant of SearchSuggestRawBytes
+func (s *StoreService) SearchSuggestRawBytesCtx(ctx context.Context, term, cc, lang string, opts ...option.RequestOption) (respBytes []byte, err error) {
+	c, method, reqPath, params := s.buildSearchSuggest(term, cc, lang)
+	return api.GetRawBytesCtx(ctx, c, method, reqPath, params, opts...)
+}
+
+// GetSearchResultsRawBytes get one page of filtered search results 获取一页筛选搜索结果
+//   - query: Optional params (nil for defaults)
+func (s *StoreService) GetSearchResultsRawBytes(query *SearchQuery, opts ...option.RequestOption) (respBytes []byte, err error) {
+	return s.GetSearchResultsRawBytesCtx(context.Background(), query, opts...)
+}
+
+// GetSearchResultsRawBytesCtx is the context-aware variant of GetSearchResultsRawBytes
+func (s *StoreService) GetSearchResultsRawBytesCtx(ctx context.Context, query *SearchQuery, opts ...option.RequestOption) (respBytes []byte, err error) {
+	c, method, reqPath, params := s.buildSearchResults(query)
+	return api.GetRawBytesCtx(ctx, c, method, reqPath, params, opts...)
+}
+
+// ============================ Structed Raw Model 结构化原始模型接口 ============================
+
+// SearchRawModel search the store by term 按关键词搜索商店
+//   - term: Search term
+//   - cc: Country code ("" for store default)
+//   - lang: Language ("" for store default)
+func (s *StoreService) SearchRawModel(term, cc, lang string, opts ...option.RequestOption) (models.SteamStoreSearchResponse, error) {
+	return s.SearchRawModelCtx(context.Background(), term, cc, lang, opts...)
+}
+
+// SearchRawModelCtx is the context-aware variant of SearchRawModel
+func (s *StoreService) SearchRawModelCtx(ctx context.Context, term, cc, lang string, opts ...option.RequestOption) (models.SteamStoreSearchResponse, error) {
+	c, method, reqPath, params := s.buildStoreSearch(term, cc, lang)
+	return api.GetRawModelCtx[models.SteamStoreSearchResponse](ctx, c, method, reqPath, params, opts...)
+}
+
+// SearchSuggestRawModel get search suggestions 获取搜索建议
+//   - term: Search term
+//   - cc: Country code ("" for store default)
+//   - lang: Language ("" for store default)
+func (s *StoreService) SearchSuggestRawModel(term, cc, lang string, opts ...option.RequestOption) ([]models.SteamSearchSuggestItem, error) {
+	return s.SearchSuggestRawModelCtx(context.Background(), term, cc, lang, opts...)
+}
+
+// SearchSuggestRawModelCtx is the context-aware variant of SearchSuggestRawModel
+func (s *StoreService) SearchSuggestRawModelCtx(ctx context.Context, term, cc, lang string, opts ...option.RequestOption) ([]models.SteamSearchSuggestItem, error) {
+	c, method, reqPath, params := s.buildSearchSuggest(term, cc, lang)
+	return api.GetRawModelCtx[[]models.SteamSearchSuggestItem](ctx, c, method, reqPath, params, opts...)
+}
+
+// GetSearchResultsRawModel get one page of filtered search results 获取一页筛选搜索结果
+//   - query: Optional params (nil for defaults)
+func (s *StoreService) GetSearchResultsRawModel(query *SearchQuery, opts ...option.RequestOption) (models.SteamSearchResultsResponse, error) {
+	return s.GetSearchResultsRawModelCtx(context.Background(), query, opts...)
+}
+
+// GetSearchResultsRawModelCtx is the context-aware variant of GetSearchResultsRawModel
+func (s *StoreService) GetSearchResultsRawModelCtx(ctx context.Context, query *SearchQuery, opts ...option.RequestOption) (models.SteamSearchResultsResponse, error) {
+	c, method, reqPath, params := s.buildSearchResults(query)
+	return api.GetRawModelCtx[models.SteamSearchResultsResponse](ctx, c, method, reqPath, params, opts...)
+}
+
+// ============================ Brief Model 精简模型接口 ============================
+
+// SearchBrief search the store by term 按关键词搜索商店
+//   - term: Search term
+//   - cc: Country code ("" for store default)
+//   - lang: Language ("" for store default)
+func (s *StoreService) SearchBrief(term, cc, lang string, opts ...option.RequestOption) ([]models.StoreSearchItem, error) {
+	return s.SearchBriefCtx(context.Background(), term, cc, lang, opts...)
+}
+
+// SearchBriefCtx is the context-aware variant of SearchBrief
+func (s *StoreService) SearchBriefCtx(ctx context.Context, term, cc, lang string, opts ...option.RequestOption) ([]models.StoreSearchItem, error) {
+	rawSearch, err := s.SearchRawModelCtx(ctx, term, cc, lang, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]models.StoreSearchItem, 0, len(rawSearch.Items))
+	endpoints := s.client.Endpoints()
+	for _, item := range rawSearch.Items {
+		brief := models.StoreSearchItem{
+			AppID:      item.ID,
+			Type:       item.Type,
+			Name:       item.Name,
+			Metascore:  int(item.Metascore),
+			Platforms:  platformNames(item.Platforms),
+			CapsuleURL: endpoints.CapsuleURL(item.ID), // 拼接封面URL | Splice capsule URL
+		}
+		if p := item.Price; p != nil {
+			brief.Price = newStorePrice(p.Currency, p.Initial, p.Final)
+		}
+		items = append(items, brief)
+	}
+	return items, nil
+}
+
+// SearchSuggestBrief get search suggestions 获取搜索建议
+//   - term: Search term
+//   - cc: Country code ("" for store default)
+//   - lang: Language ("" for store default)
+func (s *StoreService) SearchSuggestBrief(term, cc, lang string, opts ...option.RequestOption) ([]models.SearchSuggestion, error) {
+	return s.SearchSuggestBriefCtx(context.Background(), term, cc, lang, opts...)
+}
+
+// SearchSuggestBriefCtx is the context-aware variant of SearchSuggestBrief
+func (s *StoreService) SearchSuggestBriefCtx(ctx context.Context, term, cc, lang string, opts ...option.RequestOption) ([]models.SearchSuggestion, error) {
+	rawSuggest, err := s.SearchSuggestRawModelCtx(ctx, term, cc, lang, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	suggestions := make([]models.SearchSuggestion, 0, len(rawSuggest))
+	endpoints := s.client.Endpoints()
+	for _, item := range rawSuggest {
+		appID, _ := strconv.ParseUint(item.ID, 10, 64)
+		suggestions = append(suggestions, models.SearchSuggestion{
+			AppID:      appID,
+			Type:       item.Type,
+			Name:       item.Name,
+			Price:      item.Price,
+			CapsuleURL: endpoints.CapsuleURL(appID), // 拼接封面URL | Splice capsule URL
+		})
+	}
+	return suggestions, nil
+}
+
+// GetSearchResultsBrief get one page of filtered search results 获取一页筛选搜索结果
+// 结果由 results_html 片段解析 | Results are parsed from the results_html fragment
+//   - query: Optional params (nil for defaults)
+func (s *StoreService) GetSearchResultsBrief(query *SearchQuery, opts ...option.RequestOption) (models.SearchResultPage, error) {
+	return s.GetSearchResultsBriefCtx(context.Background(), query, opts...)
+}
+
+// GetSearchResultsBriefCtx is the context-aware variant of GetSearchResultsBrief
+func (s *StoreService) GetSearchResultsBriefCtx(ctx context.Context, query *SearchQuery, opts ...option.RequestOption) (models.SearchResultPage, error) {
+	rawResults, err := s.GetSearchResultsRawModelCtx(ctx, query, opts...)
+	if err != nil {
+		return models.SearchResultPage{}, err
+	}
+
+	results, err := parseSearchResults(rawResults.ResultsHTML, s.client.Endpoints())
+	if err != nil {
+		return models.SearchResultPage{}, errors.NewWithType(errors.ErrTypeParse, "parse search results_html failed", err)
+	}
+	return models.SearchResultPage{
+		Results:    results,
+		Start:      rawResults.Start,
+		TotalCount: rawResults.TotalCount,
+	}, nil
+}
+
+// ============================ Default Interface 默认接口 ============================
+
+// Search search the store by term 按关键词搜索商店
+//   - term: Search term
+//   - cc: Country code ("" for store default)
+//   - lang: Language ("" for store default)
+func (s *StoreService) Search(term, cc, lang string, opts ...option.RequestOption) ([]models.StoreSearchItem, error) {
+	return s.SearchBrief(term, cc, lang, opts...)
+}
+
+// SearchCtx is the context-aware variant of Search
+func (s *StoreService) SearchCtx(ctx context.Context, term, cc, lang string, opts ...option.RequestOption) ([]models.StoreSearchItem, error) {
+	return s.SearchBriefCtx(ctx, term, cc, lang, opts...)
+}
+
+// SearchSuggest get search suggestions 获取搜索建议
+//   - term: Search term
+//   - cc: Country code ("" for store default)
+//   - lang: Language ("" for store default)
+func (s *StoreService) SearchSuggest(term, cc, lang string, opts ...option.RequestOption) ([]models.SearchSuggestion, error) {
+	return s.SearchSuggestBrief(term, cc, lang, opts...)
+}
+
+// SearchSuggestCtx is the context-aware variant of SearchSuggest
+func (s *StoreService) SearchSuggestCtx(ctx context.Context, term, cc, lang string, opts ...option.RequestOption) ([]models.SearchSuggestion, error) {
+	return s.SearchSuggestBriefCtx(ctx, term, cc, lang, opts...)
+}
+
+// GetSearchResults get one page of filtered search results 获取一页筛选搜索结果
+//   - query: Optional params (nil for defaults)
+func (s *StoreService) GetSearchResults(query *SearchQuery, opts ...option.RequestOption) (models.SearchResultPage, error) {
+	return s.GetSearchResultsBrief(query, opts...)
+}
+
+// GetSearchResultsCtx is the context-aware variant of GetSearchResults
+func (s *StoreService) GetSearchResultsCtx(ctx context.Context, query *SearchQuery, opts ...option.RequestOption) (models.SearchResultPage, error) {
+	return s.GetSearchResultsBriefCtx(ctx, query, opts...)
+}
+
+// SearchResults iterate filtered search results across pages 跨页遍历筛选搜索结果
+// 按 start/total_count 翻页, 空页或到达总数时结束; 请求失败时产出一次错误后结束
+// Pages are followed through start/total_count and the iteration ends on an empty page or at the total;
+// a failed request yields the error once and ends the iteration
+//   - query: Optional params, Start is the starting offset (nil for defaults)
+func (s *StoreService) SearchResults(query *SearchQuery, opts ...option.RequestOption) iter.Seq2[models.SearchResult, error] {
+	return s.SearchResultsCtx(context.Background(), query, opts...)
+}
+
+// SearchResultsCtx is the context-aware variant of SearchResults
+func (s *StoreService) SearchResultsCtx(ctx context.Context, query *SearchQuery, opts ...option.RequestOption) iter.Seq2[models.SearchResult, error] {
+	return func(yield func(models.SearchResult, error) bool) {
+		page := SearchQuery{}
+		if query != nil {
+			page = *query
+		}
+		start := 0
+		if page.Start != nil {
+			start = *page.Start
+		}
+		for {
+			page.Start = &start
+			list, err := s.GetSearchResultsBriefCtx(ctx, &page, opts...)
+			if err != nil {
+				yield(models.SearchResult{}, err)
+				return
+			}
+			for _, result := range list.Results {
+				if !yield(result, nil) {
+					return
+				}
+			}
+			start += len(list.Results)
+			if len(list.Results) == 0 || start >= list.TotalCount {
+				return
+			}
+		}
+	}
+}
+
+// ============================ Build 构造入参 ============================
+
+// buildStoreSearch builds input params.
+func (s *StoreService) buildStoreSearch(term, cc, lang string) (
+	c *client.Client,
+	method, reqPath string,
+	params url.Values,
+) {
+	params = url.Values{}
+	params.Set("term", term)
+	setLocaleParams(params, cc, lang)
+	return s.client, "GET", StoreAPI + "/storesearch/", params
+}
+
+// buildSearchSuggest builds input params.
+func (s *StoreService) buildSearchSuggest(term, cc, lang string) (
+	c *client.Client,
+	method, reqPath string,
+	params url.Values,
+) {
+	params = url.Values{}
+	params.Set("term", term)
+	params.Set("f", "json") // JSON 格式(默认返回 HTML) | JSON format (HTML by default)
+	setLocaleParams(params, cc, lang)
+	return s.client, "GET", StoreSearch + "/suggest", params
+}
+
+// buildSearchResults builds input params.
+func (s *StoreService) buildSearchResults(query *SearchQuery) (
+	c *client.Client,
+	method, reqPath string,
+	params url.Values,
+) {
+	if query == nil {
+		query = &SearchQuery{}
+	}
+	params = url.Values{}
+	params.Set("infinite", "1") // JSON 分页模式 | JSON paging mode
+	start, count := 0, util.SEARCH_PAGE_SIZE
+	if query.Start != nil {
+		start = *query.Start
+	}
+	if query.Count != nil {
+		count = *query.Count
+	}
+	params.Set("start", util.Int2String(start))
+	params.Set("count", util.Int2String(count))
+	setStringParam(params, "term", query.Term)
+	setIntListParam(params, "tags", query.Tags)
+	setIntListParam(params, "category1", query.Types)
+	setIntListParam(params, "category2", query.Categories)
+	if len(query.OS) > 0 {
+		params.Set("os", strings.Join(query.OS, ","))
+	}
+	setStringParam(params, "maxprice", query.MaxPrice)
+	if query.Specials != nil && *query.Specials {
+		params.Set("specials", "1")
+	}
+	setStringParam(params, "sort_by", query.SortBy)
+	setStringParam(params, "cc", query.CC)
+	setStringParam(params, "l", query.Lang)
+	return s.client, "GET", StoreSearch + "/results/", params
+}
+
+// ============================ 工具方法 ============================
+
+// setLocaleParams 设置非空的 cc 与 l 参数 | Set the cc and l params when not empty
+func setLocaleParams(params url.Values, cc, lang string) {
+	if cc != "" {
+		params.Set("cc", cc)
+	}
+	if lang != "" {
+		params.Set("l", lang)
+	}
+}
+
+// setIntListParam 以逗号拼接整数列表参数 | Join an int list param with commas
+func setIntListParam(params url.Values, key string, values []int) {
+	if len(values) == 0 {
+		return
+	}
+	parts := make([]string, 0, len(values))
+	for _, v := range values {
+		parts = append(parts, strconv.Itoa(v))
+	}
+	params.Set(key, strings.Join(parts, ","))
+}
+
+// parseSearchResults 解析 results_html 中的搜索结果行 | Parse the result rows in results_html
+func parseSearchResults(resultsHTML string, endpoints config.Endpoints) ([]models.SearchResult, error) {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(resultsHTML))
+	if err != nil {
+		return nil, err
+	}
+
+	var results []models.SearchResult
+	doc.Find("a.search_result_row").Each(func(_ int, row *goquery.Selection) {
+		result := models.SearchResult{
+			ItemKey:        row.AttrOr("data-ds-itemkey", ""),
+			Name:           strings.TrimSpace(row.Find(".title").First().Text()),
+			URL:            row.AttrOr("href", ""),
+			ReleaseDate:    strings.TrimSpace(row.Find(".search_released").First().Text()),
+			FinalFormatted: strings.TrimSpace(row.Find(".discount_final_price").First().Text()),
+		}
+		// 礼包/捆绑包的 data-ds-appid 为逗号分隔列表, 取首个应用 | Packages and bundles list several apps, the first one is kept
+		appIDs := strings.Split(row.AttrOr("data-ds-appid", ""), ",")
+		result.AppID, _ = strconv.ParseUint(strings.TrimSpace(appIDs[0]), 10, 64)
+
+		// 评测摘要位于提示框 HTML 的第一行 | The review summary is the first line of the tooltip HTML
+		tooltip := row.Find(".search_review_summary").AttrOr("data-tooltip-html", "")
+		result.ReviewSummary = strings.TrimSpace(strings.SplitN(tooltip, "<br>", 2)[0])
+
+		price := row.Find("[data-price-final]").First()
+		result.FinalPrice, _ = strconv.ParseInt(price.AttrOr("data-price-final", ""), 10, 64)
+		result.DiscountPercent, _ = strconv.Atoi(row.Find("[data-discount]").First().AttrOr("data-discount", ""))
+
+		for _, platform := range [...]struct{ class, name string }{{"win", "windows"}, {"mac", "mac"}, {"linux", "linux"}} {
+			if row.Find(".platform_img."+platform.class).Length() > 0 {
+				result.Platforms = append(result.Platforms, platform.name)
+			}
+		}
+
+		// 应用使用与 OwnedGame 相同的封面地址, 礼包/捆绑包使用结果中的图片
+		// Apps get the same capsule URL as OwnedGame, packages and bundles keep the image from the result
+		if strings.HasPrefix(result.ItemKey, "App_") && result.AppID != 0 {
+			result.CapsuleURL = endpoints.CapsuleURL(result.AppID)
+		} else {
+			result.CapsuleURL = row.Find(".search_capsule img").AttrOr("src", "")
+		}
+		results = append(results, result)
+	})
+	return results, nil
+}
@@ -7,30 +7,39 @@ package crawler
 
 import (
 	"fmt"
+	"log/slog"
+	"net/http"
 
 	"github.com/GoFurry/gf-steam-sdk/internal/crawler"
 	"github.com/GoFurry/gf-steam-sdk/pkg/config"
+	"github.com/GoFurry/gf-steam-sdk/pkg/observe"
 	"github.com/gocolly/colly"
 	"github.com/gocolly/colly/extensions"
 )
 
+// 请求级 colly.Context 中保存结果的键 | Keys storing results inside the per-request colly.Context
+const (
+	ctxKeyHTML   = "gf_html"
+	ctxKeyErr    = "gf_err"
+	ctxKeyStatus = "gf_status"
+)
+
 // CrawlerService is the core structure of crawling service 爬虫服务核心结构体
 // Aggregates anti-crawl strategy, proxy rotation, parser, storage manager, supports chain config for custom crawling rules
 type CrawlerService struct {
-	cfg          *config.SteamConfig   // 全局配置 | Global config (chain config entry)
-	colly        *colly.Collector      // Colly 核心爬虫实例 | Core Colly crawler instance
-	antiCrawl    *crawler.AntiCrawl    // 内部反爬策略 | Internal anti-crawl strategy (delay/QPS limit/UA random)
-	parser       *crawler.Parser       // 内部解析器 | Internal parser (HTML structured parsing)
-	storage      *crawler.Storage      // 内部存储管理器 | Internal storage manager (HTML/data persistence)
-	proxyRotator *crawler.ProxyRotator // 代理轮换管理器 | Proxy rotation manager (dynamic proxy pool switching)
+	cfg          *config.SteamConfig       // 全局配置 | Global config (chain config entry)
+	colly        *colly.Collector          // Colly 核心爬虫实例 | Core Colly crawler instance
+	antiCrawl    *crawler.AntiCrawl        // 内部反爬策略 | Internal anti-crawl strategy (delay/QPS limit/UA random)
+	parser       *crawler.Parser           // 内部解析器 | Internal parser (HTML structured parsing)
+	storage      *crawler.Storage          // 内部存储管理器 | Internal storage manager (HTML/data persistence)
+	proxyRotator *crawler.ProxyRotator     // 代理轮换管理器 | Proxy rotation manager (dynamic proxy pool switching)
+	ctxTransport *crawler.ContextTransport // 调用方 ctx 传递 | Carries caller ctx into Colly requests
+	observer     observe.Observer          // 请求观察者 | Request observer
+	log          *slog.Logger              // 结构化日志(已脱敏) | Structured logger (redacting)
 }
 
 // NewCrawlerService Create crawler service instance 创建爬虫服务实例
 func NewCrawlerService(cfg *config.SteamConfig) *CrawlerService {
-	if cfg.IsDebug {
-		fmt.Printf("[Info] Start NewCrawlerService Init \n")
-	}
-
 	// 初始化 Colly 基础实例(基础爬虫配置) | Initialize Colly base instance (basic crawler config)
 	c := colly.NewCollector(
 		colly.UserAgent(cfg.CrawlerUserAgent), // 自定义User-Agent | Custom User-Agent
@@ -41,14 +50,38 @@ func NewCrawlerService(cfg *config.SteamConfig) *CrawlerService {
 
 	// 初始化代理轮换器 | Initialize proxy rotator (dynamic proxy pool switching)
 	proxyRotator := crawler.NewProxyRotator(cfg)
-	// 设置代理函数 | Set proxy function (chain-configured proxy strategy)
-	c.SetProxyFunc(proxyRotator.GetProxyFunc())
+	// 基于 http.DefaultTransport 的副本(保留拨号/TLS/空闲连接超时)设置代理函数, 包装中间件链, 最外层传递调用方 ctx
+	// Set the proxy function on a clone of http.DefaultTransport (keeping its dial/TLS/idle timeouts),
+	// wrap the middleware chain, carry caller ctx at the outermost layer
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = proxyRotator.GetProxyFunc()
+	ctxTransport := crawler.NewContextTransport(cfg.WrapTransport(transport))
+	c.WithTransport(ctxTransport)
 
 	// 基础反爬扩展 | Basic anti-crawl extensions
 	extensions.RandomUserAgent(c)    // 随机切换User-Agent | Randomize User-Agent (avoid UA risk control)
 	extensions.Referer(c)            // 设置合法Referer头 | Set valid Referer header (simulate real browser)
 	c.SetRequestTimeout(cfg.Timeout) // 请求超时配置 | Request timeout config (chain-config item)
 
+	// 记录每次请求的结果到请求级 Context(需先于反爬重试钩子注册)
+	// Record results into the per-request Context (must be registered before the anti-crawl retry hook)
+	c.OnResponse(func(r *colly.Response) {
+		r.Ctx.Put(ctxKeyHTML, r.Body)
+		r.Ctx.Put(ctxKeyErr, nil) // 重试成功后清除之前的错误 | Clear earlier error after a successful retry
+		r.Ctx.Put(ctxKeyStatus, r.StatusCode)
+	})
+	c.OnError(func(r *colly.Response, err error) {
+		if r == nil || r.Ctx == nil {
+			return
+		}
+		r.Ctx.Put(ctxKeyStatus, r.StatusCode)
+		if r.StatusCode != 0 {
+			r.Ctx.Put(ctxKeyErr, fmt.Errorf("response error (status: %d): %w", r.StatusCode, err))
+		} else {
+			r.Ctx.Put(ctxKeyErr, fmt.Errorf("request failed (no response): %w", err))
+		}
+	})
+
 	// 集成内部反爬策略 | Integrate internal anti-crawl strategy (delay/QPS limit/retry)
 	antiCrawl := crawler.NewAntiCrawl(cfg)
 	antiCrawl.Apply(c)
@@ -56,9 +89,8 @@ func NewCrawlerService(cfg *config.SteamConfig) *CrawlerService {
 	// 初始化内部工具 | Initialize internal tools
 	parser := crawler.NewParser() // HTML结构化解析器 | HTML structured parser
 
-	if cfg.IsDebug {
-		fmt.Printf("[Info] End NewCrawlerService Init \n")
-	}
+	logger := cfg.Log()
+	logger.Debug("crawler service initialized", "async", cfg.CrawlerAsync, "max_depth", cfg.CrawlerMaxDepth, "proxies", len(proxyRotator.Pool()))
 
 	return &CrawlerService{
 		cfg:          cfg,
@@ -67,6 +99,9 @@ func NewCrawlerService(cfg *config.SteamConfig) *CrawlerService {
 		parser:       parser,
 		storage:      &crawler.Storage{},
 		proxyRotator: proxyRotator,
+		ctxTransport: ctxTransport,
+		observer:     observe.OrNop(cfg.Observer),
+		log:          logger,
 	}
 }
 
@@ -0,0 +1,47 @@
+package crawler_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/GoFurry/gf-steam-sdk/pkg/config"
+	"github.com/GoFurry/gf-steam-sdk/pkg/steam/crawler"
+	"github.com/GoFurry/gf-steam-sdk/pkg/steamtest"
+)
+
+func TestCrawlerUsesProxyAndMiddlewares(t *testing.T) {
+	const target = "http://store.steam.invalid/app/620/"
+	var proxied atomic.Value
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied.Store(r.URL.String())
+		_, _ = io.WriteString(w, "<html><body>via proxy</body></html>")
+	}))
+	defer proxy.Close()
+
+	var seen atomic.Int32
+	srv := steamtest.NewServer()
+	defer srv.Close()
+	cfg := srv.Config().WithProxyPool([]string{proxy.URL}).WithMiddleware(func(next http.RoundTripper) http.RoundTripper {
+		return config.RoundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			seen.Add(1)
+			return next.RoundTrip(r)
+		})
+	})
+
+	html, err := crawler.NewCrawlerService(cfg).GetRawHTML(target)
+	if err != nil {
+		t.Fatalf("crawl: %v", err)
+	}
+	if string(html) != "<html><body>via proxy</body></html>" {
+		t.Fatalf("unexpected body %q", html)
+	}
+	if got := proxied.Load(); got != target {
+		t.Fatalf("want the proxy to receive %q, got %v", target, got)
+	}
+	if seen.Load() != 1 {
+		t.Fatalf("want the middleware to see 1 request, got %d", seen.Load())
+	}
+}
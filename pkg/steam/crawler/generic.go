@@ -1,58 +1,89 @@
 package crawler
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"net/url"
 	"strings"
 	"time"
 
 	"github.com/GoFurry/gf-steam-sdk/internal/crawler"
+	"github.com/GoFurry/gf-steam-sdk/pkg/observe"
 	"github.com/GoFurry/gf-steam-sdk/pkg/util/errors"
-	"github.com/gocolly/colly"
 )
 
 // ============================ Raw HTML 通用获取原始 HTML ============================
 
 // GetRawHTML crawl any address to get HTML 爬取任意地址的原始 HTML (通用爬取, 无任何跳过验证的策略)
 func (s *CrawlerService) GetRawHTML(targetURL string) ([]byte, error) {
+	return s.GetRawHTMLCtx(context.Background(), targetURL)
+}
+
+// GetRawHTMLCtx is the context-aware variant of GetRawHTML
+// ctx 会传递到爬虫限流等待、HTTP 请求和重试退避中 | ctx is carried into crawler rate-limit waits, HTTP requests and retry backoff
+func (s *CrawlerService) GetRawHTMLCtx(ctx context.Context, targetURL string) ([]byte, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	// 官方地址替换为配置的基础地址(反向代理/镜像等) | Rebase official URLs onto the configured endpoints (proxy, mirror, ...)
+	targetURL = s.cfg.Endpoints.Rebase(targetURL)
+
+	// 通知观察者(接口名为主机名, 避免路径中的 ID 造成指标基数膨胀)
+	// Notify the observer (endpoint is the host, so IDs in paths do not blow up metric cardinality)
+	info := observe.RequestInfo{Kind: observe.KindCrawler, Method: http.MethodGet, Target: targetURL}
+	if u, err := url.Parse(targetURL); err == nil {
+		info.Endpoint = u.Host
+		info.Target = u.Scheme + "://" + u.Host + u.Path
+	}
+	ctx = s.observer.RequestStart(ctx, info)
+	ev := observe.Event{RequestInfo: info}
+	startTime := time.Now()
+	html, err := s.getRawHTML(ctx, targetURL, &ev)
+	ev.Latency, ev.Bytes, ev.Err = time.Since(startTime), len(html), err
+	s.observer.RequestEnd(ctx, ev)
+	return html, err
+}
+
+// getRawHTML GetRawHTMLCtx 的实现, 同时填充观察事件中的状态码、尝试次数和限流等待
+// getRawHTML implements GetRawHTMLCtx, filling status, attempts and rate-limit wait into the observer event
+func (s *CrawlerService) getRawHTML(ctx context.Context, targetURL string, ev *observe.Event) ([]byte, error) {
 	// 参数校验 | Parameter validation
 	if targetURL == "" {
 		return nil, errors.NewWithType(errors.ErrTypeParam, "target URL is empty", nil)
 	}
-
-	// 初始化变量 | Initialize variables
-	var html []byte
-	var reqErr error
-
-	// 注册响应回调 | Register response callback (capture raw HTML)
-	s.colly.OnResponse(func(r *colly.Response) {
-		html = r.Body
-	})
-
-	// 注册错误回调 | Register error callback (capture request/response error)
-	s.colly.OnError(func(r *colly.Response, err error) {
-		if r != nil {
-			reqErr = fmt.Errorf("response error (status: %d): %w", r.StatusCode, err)
-		} else {
-			reqErr = fmt.Errorf("request failed (no response): %w", err)
-		}
-	})
-
-	if s.cfg.IsDebug {
-		fmt.Printf("[Info] Start colly.Visit: %s \n", targetURL)
+	if err := ctx.Err(); err != nil {
+		return nil, errors.NewWithType(errors.ErrTypeCrawler, "crawl URL canceled", err)
 	}
+
+	// 绑定调用方 ctx 到本次请求 | Bind caller ctx to this request
+	collyCtx, hdr, release := s.ctxTransport.Bind(ctx, s.colly.UserAgent)
+	defer release()
+
+	s.log.Debug("crawler visit", "endpoint", ev.Endpoint, "url", targetURL)
 	// 执行请求 | Execute request (auto trigger anti-crawl strategy)
-	if err := s.colly.Visit(targetURL); err != nil {
-		return nil, fmt.Errorf("%w: crawl URL failed: %v", errors.ErrTypeCrawler, err)
-	}
+	// 重试成功时 Colly 仍返回首次尝试的错误, 以请求级 Context 中记录的结果为准
+	// Colly still returns the first attempt's error after a successful retry, so the result recorded in the per-request Context wins
+	visitErr := s.colly.Request("GET", targetURL, nil, collyCtx, hdr)
 	s.colly.Wait() // 等待异步请求完成 | Wait for async requests to complete
+	ev.Status, _ = collyCtx.GetAny(ctxKeyStatus).(int)
+	ev.Attempts = crawler.AttemptsOf(collyCtx)
+	ev.RateLimitWait = crawler.RateLimitWaitOf(collyCtx)
 
 	// 错误检查 | Error check
-	if reqErr != nil {
-		return nil, fmt.Errorf("%w: %v", errors.ErrTypeCrawler, reqErr)
+	if err := ctx.Err(); err != nil {
+		return nil, errors.NewWithType(errors.ErrTypeCrawler, "crawl URL canceled", err)
+	}
+	if reqErr, ok := collyCtx.GetAny(ctxKeyErr).(error); ok && reqErr != nil {
+		return nil, errors.NewWithType(errors.ErrTypeCrawler, "crawl URL failed", reqErr)
+	}
+	html, _ := collyCtx.GetAny(ctxKeyHTML).([]byte)
+	if len(html) == 0 && visitErr != nil {
+		return nil, errors.NewWithType(errors.ErrTypeCrawler, "crawl URL failed", visitErr)
 	}
 	if len(html) == 0 {
-		return nil, fmt.Errorf("%w: empty html response for URL: %s", errors.ErrTypeCrawler, targetURL)
+		return nil, errors.NewWithType(errors.ErrTypeCrawler, "empty html response for URL: "+targetURL, nil)
 	}
 
 	return html, nil
@@ -64,9 +95,6 @@ func (s *CrawlerService) GetRawHTML(targetURL string) ([]byte, error) {
 //   - targetURL: Target URL
 //   - filename: Custom filename (auto-generate if empty)
 func (s *CrawlerService) SaveRawHTML(targetURL string, filename string) (string, error) {
-	if s.cfg.IsDebug {
-		fmt.Printf("[Info] Start GetRawHTML \n")
-	}
 	// 获取原始 HTML | Get raw HTML
 	html, err := s.GetRawHTML(targetURL)
 	if err != nil {
@@ -77,22 +105,13 @@ func (s *CrawlerService) SaveRawHTML(targetURL string, filename string) (string,
 	if filename == "" {
 		filename = generateFilenameFromURL(targetURL)
 	}
-	if s.cfg.IsDebug {
-		fmt.Printf("[Info] generateFilenameFromURL %s\n", filename)
-	}
 
-	if s.cfg.IsDebug {
-		fmt.Printf("[Info] NewStorage Init \n")
-	}
 	// 初始化存储管理器并保存 | Initialize storage manager and save
-	s.storage = crawler.NewStorage(s.cfg.CrawlerStorageDir)
-
-	if s.cfg.IsDebug {
-		fmt.Printf("[Info] Start SaveHTML \n")
-	}
+	s.storage = crawler.NewStorage(s.cfg.CrawlerStorageDir, s.log)
+	s.log.Debug("saving crawled html", "url", targetURL, "filename", filename, "dir", s.cfg.CrawlerStorageDir)
 	fullPath, err := s.storage.SaveHTML(filename, html)
 	if err != nil {
-		return "", fmt.Errorf("%w: save generic HTML failed: %v", errors.ErrTypeCrawler, err)
+		return "", errors.NewWithType(errors.ErrTypeCrawler, "save generic HTML failed", err)
 	}
 
 	return fullPath, nil
@@ -10,7 +10,17 @@ package server
 import (
 	"fmt"
 
+	"github.com/GoFurry/gf-steam-sdk/internal/api/cache"
 	"github.com/GoFurry/gf-steam-sdk/internal/client"
+	"github.com/GoFurry/gf-steam-sdk/pkg/observe"
+)
+
+// A2S 查询的接口名, 用于观察者上报; 规则查询的接口名同时是缓存 TTL 配置名
+// A2S endpoint names reported to the observer; the rules name doubles as the cache TTL name
+const (
+	a2sInfoEndpoint   = "A2S/Info"
+	a2sPlayerEndpoint = "A2S/Player"
+	a2sRulesCacheName = "A2S/Rules"
 )
 
 // ServerService is the core structure of Steam server query service Steam服务器查询服务核心结构体
@@ -39,3 +49,19 @@ func (s *ServerService) Close() error {
 func (s *ServerService) Client() *client.Client {
 	return s.client
 }
+
+// cacheManager 获取响应缓存管理器(未启用时返回 nil)
+func (s *ServerService) cacheManager() *cache.Manager {
+	if s.client == nil {
+		return nil
+	}
+	return s.client.Cache()
+}
+
+// observer 获取请求观察者(未配置时为空操作观察者)
+func (s *ServerService) observer() observe.Observer {
+	if s.client == nil {
+		return observe.Nop{}
+	}
+	return s.client.Observer()
+}
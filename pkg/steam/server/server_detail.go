@@ -3,12 +3,19 @@ package server
 import (
 	"context"
 	"fmt"
+	"net"
+	"reflect"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
+	"unsafe"
 
+	"github.com/GoFurry/gf-steam-sdk/internal/api/cache"
 	"github.com/GoFurry/gf-steam-sdk/pkg/models"
+	"github.com/GoFurry/gf-steam-sdk/pkg/observe"
 	"github.com/GoFurry/gf-steam-sdk/pkg/util/errors"
+	"github.com/bytedance/sonic"
 	"github.com/rumblefrog/go-a2s"
 	"golang.org/x/time/rate"
 )
@@ -17,16 +24,14 @@ import (
 // Parameters:
 //   - addr: Server address in the format of "ip:port"
 func (s *ServerService) QueryServerInfo(addr string) (a2s.ServerInfo, error) {
-	// 创建独立的A2S Client(每个请求独立client, 避免并发冲突)
-	// Create independent A2S Client (each request has its own client to avoid concurrent conflicts)
-	client, err := a2s.NewClient(addr)
-	if err != nil {
-		return a2s.ServerInfo{}, fmt.Errorf("%w: create a2s client failed: %v", errors.ErrRequestFailed, err)
-	}
-	defer client.Close() // 确保client资源释放(Ensure client resource release)
+	return s.QueryServerInfoCtx(context.Background(), addr)
+}
 
+// QueryServerInfoCtx is the context-aware variant of QueryServerInfo
+// The query is aborted as soon as ctx is canceled, and the ctx deadline is used as the UDP timeout
+func (s *ServerService) QueryServerInfoCtx(ctx context.Context, addr string) (a2s.ServerInfo, error) {
 	// 调用A2S_Info接口(Call A2S_Info interface)
-	info, err := client.QueryInfo()
+	info, err := observeA2S(ctx, s.observer(), a2sInfoEndpoint, addr, (*a2s.Client).QueryInfo)
 	if err != nil {
 		return a2s.ServerInfo{}, fmt.Errorf("%w: query server info failed: %v", errors.ErrRequestFailed, err)
 	}
@@ -40,15 +45,13 @@ func (s *ServerService) QueryServerInfo(addr string) (a2s.ServerInfo, error) {
 // Parameters:
 //   - addr: Server address in the format of "ip:port"
 func (s *ServerService) QueryServerPlayers(addr string) (a2s.PlayerInfo, error) {
-	// 创建独立的A2S Client(Create independent A2S Client)
-	client, err := a2s.NewClient(addr)
-	if err != nil {
-		return a2s.PlayerInfo{}, fmt.Errorf("%w: create a2s client failed: %v", errors.ErrRequestFailed, err)
-	}
-	defer client.Close() // 确保client资源释放(Ensure client resource release)
+	return s.QueryServerPlayersCtx(context.Background(), addr)
+}
 
+// QueryServerPlayersCtx is the context-aware variant of QueryServerPlayers
+func (s *ServerService) QueryServerPlayersCtx(ctx context.Context, addr string) (a2s.PlayerInfo, error) {
 	// 调用A2S_Player接口(Call A2S_Player interface)
-	players, err := client.QueryPlayer()
+	players, err := observeA2S(ctx, s.observer(), a2sPlayerEndpoint, addr, (*a2s.Client).QueryPlayer)
 	if err != nil {
 		return a2s.PlayerInfo{}, fmt.Errorf("%w: query server players failed: %v", errors.ErrRequestFailed, err)
 	}
@@ -61,18 +64,41 @@ func (s *ServerService) QueryServerPlayers(addr string) (a2s.PlayerInfo, error)
 // Parameters:
 //   - addr: Server address in the format of "ip:port"
 func (s *ServerService) QueryServerRules(addr string) (a2s.RulesInfo, error) {
-	// 创建独立的A2S Client(Create independent A2S Client)
-	client, err := a2s.NewClient(addr)
-	if err != nil {
-		return a2s.RulesInfo{}, fmt.Errorf("%w: create a2s client failed: %v", errors.ErrRequestFailed, err)
+	return s.QueryServerRulesCtx(context.Background(), addr)
+}
+
+// QueryServerRulesCtx is the context-aware variant of QueryServerRules
+func (s *ServerService) QueryServerRulesCtx(ctx context.Context, addr string) (a2s.RulesInfo, error) {
+	// 规则信息变化较少, 启用缓存时优先读取缓存(Rules rarely change, read from cache first when enabled)
+	var (
+		cacheKey string
+		cacheTTL time.Duration
+	)
+	if m := s.cacheManager(); m != nil {
+		if cacheTTL = m.TTL(a2sRulesCacheName); cacheTTL > 0 {
+			cacheKey = cache.Key(a2sRulesCacheName, addr)
+			if entry, fresh := m.Get(cacheKey); fresh {
+				var rules a2s.RulesInfo
+				if err := sonic.Unmarshal(entry.Body, &rules); err == nil {
+					obs, info := s.observer(), a2sRequestInfo(a2sRulesCacheName, addr)
+					obs.RequestEnd(obs.RequestStart(ctx, info), observe.Event{RequestInfo: info, Bytes: len(entry.Body), CacheHit: true})
+					return rules, nil
+				}
+			}
+		}
 	}
-	defer client.Close() // 确保client资源释放(Ensure client resource release)
 
 	// 调用A2S_Rules接口(Call A2S_Rules interface)
-	rules, err := client.QueryRules()
+	rules, err := observeA2S(ctx, s.observer(), a2sRulesCacheName, addr, (*a2s.Client).QueryRules)
 	if err != nil {
 		return a2s.RulesInfo{}, fmt.Errorf("%w: query server rules failed: %v", errors.ErrRequestFailed, err)
 	}
+
+	if cacheKey != "" {
+		if body, err := sonic.Marshal(rules); err == nil {
+			s.cacheManager().Put(cacheKey, &cache.Entry{Body: body}, cacheTTL)
+		}
+	}
 	return *rules, nil
 }
 
@@ -81,6 +107,11 @@ func (s *ServerService) QueryServerRules(addr string) (a2s.RulesInfo, error) {
 // Parameters:
 //   - addr: Server address in the format of "ip:port"
 func (s *ServerService) GetServerDetail(addr string) (models.SteamServerResponse, error) {
+	return s.GetServerDetailCtx(context.Background(), addr)
+}
+
+// GetServerDetailCtx is the context-aware variant of GetServerDetail
+func (s *ServerService) GetServerDetailCtx(ctx context.Context, addr string) (models.SteamServerResponse, error) {
 	var (
 		res   models.SteamServerResponse
 		errs  []string // 记录各子接口的错误信息(Record error info of each sub-interface)
@@ -89,7 +120,7 @@ func (s *ServerService) GetServerDetail(addr string) (models.SteamServerResponse
 
 	// 查询服务器基础信息(核心接口, 失败则直接返回)
 	// Query server basic info (core interface, return directly if failed)
-	info, err := s.QueryServerInfo(addr)
+	info, err := s.QueryServerInfoCtx(ctx, addr)
 	if err != nil {
 		errs = append(errs, fmt.Sprintf("info: %v", err))
 	} else {
@@ -98,7 +129,7 @@ func (s *ServerService) GetServerDetail(addr string) (models.SteamServerResponse
 
 	// 查询玩家信息(非核心, 失败仅记录错误)
 	// Query player info (non-core, only record error if failed)
-	players, err := s.QueryServerPlayers(addr)
+	players, err := s.QueryServerPlayersCtx(ctx, addr)
 	if err != nil {
 		mutex.Lock()
 		errs = append(errs, fmt.Sprintf("players: %v", err))
@@ -109,7 +140,7 @@ func (s *ServerService) GetServerDetail(addr string) (models.SteamServerResponse
 
 	// 查询服务器规则(非核心, 失败仅记录错误)
 	// Query server rules (non-core, only record error if failed)
-	rules, err := s.QueryServerRules(addr)
+	rules, err := s.QueryServerRulesCtx(ctx, addr)
 	if err != nil {
 		mutex.Lock()
 		errs = append(errs, fmt.Sprintf("rules: %v", err))
@@ -159,9 +190,22 @@ func (s *ServerService) QueryServerInfoList(
 	burst int,
 	timeout time.Duration,
 	retry int,
+) ([]a2s.ServerInfo, []error, error) {
+	return s.QueryServerInfoListCtx(context.Background(), addrs, qps, burst, timeout, retry)
+}
+
+// QueryServerInfoListCtx is the context-aware variant of QueryServerInfoList
+// timeout 叠加在调用方 ctx 之上 | timeout is applied on top of the caller ctx
+func (s *ServerService) QueryServerInfoListCtx(
+	parent context.Context,
+	addrs []string,
+	qps float64,
+	burst int,
+	timeout time.Duration,
+	retry int,
 ) ([]a2s.ServerInfo, []error, error) {
 	// 初始化上下文和限流器(Initialize context and rate limiter)
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	ctx, cancel := context.WithTimeout(parent, timeout)
 	defer cancel()
 	limiter := rate.NewLimiter(rate.Limit(qps), burst)
 
@@ -197,19 +241,19 @@ func (s *ServerService) QueryServerInfoList(
 				// 限流等待(Rate limit waiting)
 				if err = limiter.Wait(ctx); err != nil {
 					err = fmt.Errorf("rate limit exceeded (attempt %d): %v", attempt, err)
-					time.Sleep(time.Duration(attempt) * 100 * time.Millisecond) // 指数退避(Exponential backoff)
+					sleepCtx(ctx, time.Duration(attempt)*100*time.Millisecond) // 指数退避(Exponential backoff)
 					continue
 				}
 
 				// 调用独立接口(Call independent interface)
-				info, err = s.QueryServerInfo(address)
+				info, err = s.QueryServerInfoCtx(ctx, address)
 				if err == nil {
 					break // 成功则退出重试(Exit retry if successful)
 				}
 
 				// 非最后一次重试则等待(Wait if not the last retry)
 				if attempt < retry {
-					time.Sleep(time.Duration(attempt) * 100 * time.Millisecond)
+					sleepCtx(ctx, time.Duration(attempt)*100*time.Millisecond)
 				}
 			}
 
@@ -247,9 +291,22 @@ func (s *ServerService) QueryServerPlayersList(
 	burst int,
 	timeout time.Duration,
 	retry int,
+) ([]a2s.PlayerInfo, []error, error) {
+	return s.QueryServerPlayersListCtx(context.Background(), addrs, qps, burst, timeout, retry)
+}
+
+// QueryServerPlayersListCtx is the context-aware variant of QueryServerPlayersList
+// timeout 叠加在调用方 ctx 之上 | timeout is applied on top of the caller ctx
+func (s *ServerService) QueryServerPlayersListCtx(
+	parent context.Context,
+	addrs []string,
+	qps float64,
+	burst int,
+	timeout time.Duration,
+	retry int,
 ) ([]a2s.PlayerInfo, []error, error) {
 	// 初始化上下文和限流器(Initialize context and rate limiter)
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	ctx, cancel := context.WithTimeout(parent, timeout)
 	defer cancel()
 	limiter := rate.NewLimiter(rate.Limit(qps), burst)
 
@@ -284,19 +341,19 @@ func (s *ServerService) QueryServerPlayersList(
 				// 限流等待(Rate limit waiting)
 				if err = limiter.Wait(ctx); err != nil {
 					err = fmt.Errorf("rate limit exceeded (attempt %d): %v", attempt, err)
-					time.Sleep(time.Duration(attempt) * 100 * time.Millisecond) // 指数退避(Exponential backoff)
+					sleepCtx(ctx, time.Duration(attempt)*100*time.Millisecond) // 指数退避(Exponential backoff)
 					continue
 				}
 
 				// 调用独立接口(Call independent interface)
-				players, err = s.QueryServerPlayers(address)
+				players, err = s.QueryServerPlayersCtx(ctx, address)
 				if err == nil {
 					break // 成功则退出重试(Exit retry if successful)
 				}
 
 				// 非最后一次重试则等待(Wait if not the last retry)
 				if attempt < retry {
-					time.Sleep(time.Duration(attempt) * 100 * time.Millisecond)
+					sleepCtx(ctx, time.Duration(attempt)*100*time.Millisecond)
 				}
 			}
 
@@ -334,9 +391,22 @@ func (s *ServerService) QueryServerRulesList(
 	burst int,
 	timeout time.Duration,
 	retry int,
+) ([]a2s.RulesInfo, []error, error) {
+	return s.QueryServerRulesListCtx(context.Background(), addrs, qps, burst, timeout, retry)
+}
+
+// QueryServerRulesListCtx is the context-aware variant of QueryServerRulesList
+// timeout 叠加在调用方 ctx 之上 | timeout is applied on top of the caller ctx
+func (s *ServerService) QueryServerRulesListCtx(
+	parent context.Context,
+	addrs []string,
+	qps float64,
+	burst int,
+	timeout time.Duration,
+	retry int,
 ) ([]a2s.RulesInfo, []error, error) {
 	// 初始化上下文和限流器(Initialize context and rate limiter)
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	ctx, cancel := context.WithTimeout(parent, timeout)
 	defer cancel()
 	limiter := rate.NewLimiter(rate.Limit(qps), burst)
 
@@ -371,19 +441,19 @@ func (s *ServerService) QueryServerRulesList(
 				// 限流等待(Rate limit waiting)
 				if err = limiter.Wait(ctx); err != nil {
 					err = fmt.Errorf("rate limit exceeded (attempt %d): %v", attempt, err)
-					time.Sleep(time.Duration(attempt) * 100 * time.Millisecond) // 指数退避(Exponential backoff)
+					sleepCtx(ctx, time.Duration(attempt)*100*time.Millisecond) // 指数退避(Exponential backoff)
 					continue
 				}
 
 				// 调用独立接口(Call independent interface)
-				rules, err = s.QueryServerRules(address)
+				rules, err = s.QueryServerRulesCtx(ctx, address)
 				if err == nil {
 					break // 成功则退出重试(Exit retry if successful)
 				}
 
 				// 非最后一次重试则等待(Wait if not the last retry)
 				if attempt < retry {
-					time.Sleep(time.Duration(attempt) * 100 * time.Millisecond)
+					sleepCtx(ctx, time.Duration(attempt)*100*time.Millisecond)
 				}
 			}
 
@@ -444,9 +514,22 @@ func (s *ServerService) GetServerDetailList(
 	burst int,
 	timeout time.Duration,
 	retry int,
+) ([]models.SteamServerResponse, []error, error) {
+	return s.GetServerDetailListCtx(context.Background(), addrs, qps, burst, timeout, retry)
+}
+
+// GetServerDetailListCtx is the context-aware variant of GetServerDetailList
+// timeout 叠加在调用方 ctx 之上 | timeout is applied on top of the caller ctx
+func (s *ServerService) GetServerDetailListCtx(
+	parent context.Context,
+	addrs []string,
+	qps float64,
+	burst int,
+	timeout time.Duration,
+	retry int,
 ) ([]models.SteamServerResponse, []error, error) {
 	// 初始化上下文和限流器(Initialize context and rate limiter)
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	ctx, cancel := context.WithTimeout(parent, timeout)
 	defer cancel()
 	limiter := rate.NewLimiter(rate.Limit(qps), burst)
 
@@ -485,20 +568,20 @@ func (s *ServerService) GetServerDetailList(
 					err = fmt.Errorf("rate limit exceeded (attempt %d): %v", attempt, err)
 					// 指数退避: 重试次数越多, 等待时间越长
 					// Exponential backoff: the more retries, the longer the waiting time
-					time.Sleep(time.Duration(attempt) * 100 * time.Millisecond)
+					sleepCtx(ctx, time.Duration(attempt)*100*time.Millisecond)
 					continue
 				}
 
 				// 调用聚合接口(内部已调用三个独立方法)
 				// Call aggregation interface (internally calls three independent methods)
-				detail, err = s.GetServerDetail(address)
+				detail, err = s.GetServerDetailCtx(ctx, address)
 				if err == nil {
 					break // 成功则退出重试(Exit retry if successful)
 				}
 
 				// 非最后一次重试, 等待后继续(Wait and continue if not the last retry)
 				if attempt < retry {
-					time.Sleep(time.Duration(attempt) * 100 * time.Millisecond)
+					sleepCtx(ctx, time.Duration(attempt)*100*time.Millisecond)
 				}
 			}
 
@@ -524,3 +607,123 @@ func (s *ServerService) GetServerDetailList(
 
 	return results, errs, nil
 }
+
+// observeA2S 执行一次 A2S 查询并上报观察者(A2S 无限流与重试, 尝试次数固定为 1)
+// observeA2S runs one A2S query and reports it to the observer (A2S has no rate limiting or retries, so attempts is always 1)
+func observeA2S[T any](ctx context.Context, obs observe.Observer, endpoint, addr string, query func(*a2s.Client) (*T, error)) (*T, error) {
+	info := a2sRequestInfo(endpoint, addr)
+	ctx = obs.RequestStart(ctx, info)
+	startTime := time.Now()
+	val, n, err := queryA2S(ctx, addr, query)
+	obs.RequestEnd(ctx, observe.Event{RequestInfo: info, Attempts: 1, Latency: time.Since(startTime), Bytes: n, Err: err})
+	return val, err
+}
+
+// a2sRequestInfo 构建 A2S 查询的观察信息 | Observer info of an A2S query
+func a2sRequestInfo(endpoint, addr string) observe.RequestInfo {
+	return observe.RequestInfo{Kind: observe.KindA2S, Endpoint: endpoint, Method: "UDP", Target: addr}
+}
+
+// queryA2S 在独立的 A2S Client 上执行一次查询, 支持 context 取消, 同时返回收到的数据报总字节数(含挑战码与分包)
+// 每个请求独立 client, 避免并发冲突; ctx 截止时间作为 UDP 超时, ctx 取消时关闭连接中断阻塞读
+// queryA2S runs a single query on an independent A2S Client with context cancellation support and also returns the
+// total length of the datagrams received (challenge replies and split packets included)
+// Each request has its own client to avoid concurrent conflicts; the ctx deadline is used as the UDP timeout,
+// and the connection is closed on cancellation to unblock pending reads
+func queryA2S[T any](ctx context.Context, addr string, query func(*a2s.Client) (*T, error)) (*T, int, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	var opts []func(*a2s.Client) error
+	if deadline, ok := ctx.Deadline(); ok {
+		if remain := time.Until(deadline); remain > 0 && remain < a2s.DefaultTimeout {
+			opts = append(opts, a2s.TimeoutOption(remain))
+		}
+	}
+
+	// 创建独立的A2S Client(Create independent A2S Client)
+	client, err := a2s.NewClient(addr, opts...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("create a2s client failed: %w", err)
+	}
+	defer client.Close() // 确保client资源释放(Ensure client resource release)
+	counter := countReads(client)
+
+	type result struct {
+		val *T
+		err error
+	}
+	done := make(chan result, 1)
+	go func() {
+		// go-a2s 解析截断/损坏的数据包时会越界 panic, 转为错误避免进程崩溃
+		// go-a2s panics with an out-of-range read on truncated/corrupted packets, turn it into an error instead of crashing
+		defer func() {
+			if p := recover(); p != nil {
+				done <- result{err: fmt.Errorf("malformed a2s response: %v", p)}
+			}
+		}()
+		val, err := query(client)
+		done <- result{val: val, err: err}
+	}()
+
+	select {
+	case <-ctx.Done():
+		return nil, counter.bytes(), ctx.Err()
+	case r := <-done:
+		return r.val, counter.bytes(), r.err
+	}
+}
+
+// countingConn 统计收到的数据报字节数的 net.Conn(UDP 每次 Read 读取一个完整数据报)
+// countingConn is a net.Conn counting the bytes of the datagrams received (each UDP Read returns one whole datagram)
+type countingConn struct {
+	net.Conn
+	n atomic.Int64
+}
+
+// Read implements net.Conn
+func (c *countingConn) Read(p []byte) (int, error) {
+	n, err := c.Conn.Read(p)
+	c.n.Add(int64(n))
+	return n, err
+}
+
+// bytes 已收到的字节数(nil 时为 0) | Bytes received so far (0 if nil)
+func (c *countingConn) bytes() int {
+	if c == nil {
+		return 0
+	}
+	return int(c.n.Load())
+}
+
+// countReads 将 go-a2s Client 的连接替换为 countingConn
+// go-a2s 未提供读取钩子, 只能替换其未导出的 conn 字段; 字段不存在(库版本变化)时返回 nil, 字节数记为 0
+// countReads swaps the go-a2s Client connection for a countingConn
+// go-a2s has no read hook, so its unexported conn field is replaced; nil is returned (bytes reported as 0)
+// when the field is missing because the library changed
+func countReads(client *a2s.Client) *countingConn {
+	field := reflect.ValueOf(client).Elem().FieldByName("conn")
+	if !field.IsValid() || field.Type() != reflect.TypeOf((*net.Conn)(nil)).Elem() {
+		return nil
+	}
+	field = reflect.NewAt(field.Type(), unsafe.Pointer(field.UnsafeAddr())).Elem()
+	conn, ok := field.Interface().(net.Conn)
+	if !ok || conn == nil {
+		return nil
+	}
+	counter := &countingConn{Conn: conn}
+	field.Set(reflect.ValueOf(net.Conn(counter)))
+	return counter
+}
+
+// sleepCtx 可被 context 中断的休眠
+// sleepCtx sleeps for d or until ctx is done, whichever comes first
+func sleepCtx(ctx context.Context, d time.Duration) {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+	case <-timer.C:
+	}
+}
@@ -0,0 +1,187 @@
+package server_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/GoFurry/gf-steam-sdk/pkg/observe"
+	gfsteam "github.com/GoFurry/gf-steam-sdk/pkg/steam"
+	"github.com/GoFurry/gf-steam-sdk/pkg/steamtest"
+	ue "github.com/GoFurry/gf-steam-sdk/pkg/util/errors"
+)
+
+// recorder 记录 A2S 查询上报的事件 | Records the events reported for A2S queries
+type recorder struct {
+	mu     sync.Mutex
+	events []observe.Event
+}
+
+func (r *recorder) RequestStart(ctx context.Context, _ observe.RequestInfo) context.Context {
+	return ctx
+}
+
+func (r *recorder) RequestEnd(_ context.Context, ev observe.Event) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, ev)
+}
+
+// last 取接口最后一次上报的事件 | The last event reported for the endpoint
+func (r *recorder) last(t *testing.T, endpoint string) observe.Event {
+	t.Helper()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i := len(r.events) - 1; i >= 0; i-- {
+		if r.events[i].Endpoint == endpoint {
+			return r.events[i]
+		}
+	}
+	t.Fatalf("no event for %s", endpoint)
+	return observe.Event{}
+}
+
+// newA2S 启动 A2S 模拟服务器并创建带观察者的 SDK | Start a fake A2S server and an SDK with an observer
+func newA2S(t *testing.T) (*gfsteam.SteamSDK, *steamtest.A2SServer, *recorder) {
+	t.Helper()
+	a2sSrv := steamtest.NewA2SServer()
+	t.Cleanup(a2sSrv.Close)
+	srv := steamtest.NewServer()
+	t.Cleanup(srv.Close)
+	rec := &recorder{}
+	sdk, err := srv.NewSDK(srv.Config().WithObserver(rec))
+	if err != nil {
+		t.Fatalf("create sdk: %v", err)
+	}
+	t.Cleanup(func() { _ = sdk.Close() })
+	return sdk, a2sSrv, rec
+}
+
+// count 统计查询的请求包数 | Count the request packets of a query
+func count(reqs []steamtest.A2SRequest, endpoint string) int {
+	n := 0
+	for _, r := range reqs {
+		if r.Endpoint == endpoint {
+			n++
+		}
+	}
+	return n
+}
+
+func TestDetailListRetriesDroppedPacket(t *testing.T) {
+	if testing.Short() {
+		t.Skip("waits for the a2s read timeout")
+	}
+	sdk, a2sSrv, _ := newA2S(t)
+	// 首个挑战码请求丢包, 第一次尝试在 go-a2s 默认超时后失败
+	// The first challenge request is dropped, so the first attempt fails after the go-a2s default timeout
+	a2sSrv.Fail(steamtest.A2SInfo, steamtest.A2SDrop(), 1)
+
+	results, errs, err := sdk.Server.GetServerDetailListCtx(context.Background(), []string{a2sSrv.Addr}, 100, 1, 10*time.Second, 2)
+	if err != nil {
+		t.Fatalf("detail list: %v", err)
+	}
+	if errs[0] != nil {
+		t.Fatalf("want the retry to succeed, got %v", errs[0])
+	}
+	if results[0].Server.Name != a2sSrv.Info().Name {
+		t.Fatalf("want server %q, got %q", a2sSrv.Info().Name, results[0].Server.Name)
+	}
+	// 丢包 + 重试的挑战码与数据请求 | The dropped packet plus the retried challenge and data requests
+	if n := count(a2sSrv.Requests(), steamtest.A2SInfo); n != 3 {
+		t.Fatalf("want 3 info packets, got %d", n)
+	}
+}
+
+func TestQueryTimesOutWithContext(t *testing.T) {
+	sdk, a2sSrv, rec := newA2S(t)
+	a2sSrv.Fail(steamtest.A2SInfo, steamtest.A2SDrop(), 0)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err := sdk.Server.QueryServerInfoCtx(ctx, a2sSrv.Addr)
+	if !errors.Is(err, ue.ErrRequestFailed) {
+		t.Fatalf("want ErrRequestFailed, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("query ignored the ctx deadline, took %v", elapsed)
+	}
+	if ev := rec.last(t, steamtest.A2SInfo); ev.Err == nil || ev.Bytes != 0 {
+		t.Fatalf("want a failed event without bytes, got %+v", ev)
+	}
+}
+
+func TestQueryMalformedPacket(t *testing.T) {
+	sdk, a2sSrv, _ := newA2S(t)
+	a2sSrv.Fail(steamtest.A2SPlayer, steamtest.A2SMalformed(), 0)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if _, err := sdk.Server.QueryServerPlayersCtx(ctx, a2sSrv.Addr); !errors.Is(err, ue.ErrRequestFailed) {
+		t.Fatalf("want ErrRequestFailed, got %v", err)
+	}
+}
+
+func TestSplitResponsesReassemble(t *testing.T) {
+	const splitSize = 32
+	tests := []struct {
+		name     string
+		endpoint string
+		query    func(ctx context.Context, sdk *gfsteam.SteamSDK, addr string) (any, error)
+		want     func(a2sSrv *steamtest.A2SServer) any
+	}{
+		{
+			name:     "players",
+			endpoint: steamtest.A2SPlayer,
+			query: func(ctx context.Context, sdk *gfsteam.SteamSDK, addr string) (any, error) {
+				return sdk.Server.QueryServerPlayersCtx(ctx, addr)
+			},
+			want: func(a2sSrv *steamtest.A2SServer) any { return a2sSrv.Players() },
+		},
+		{
+			name:     "rules",
+			endpoint: steamtest.A2SRules,
+			query: func(ctx context.Context, sdk *gfsteam.SteamSDK, addr string) (any, error) {
+				return sdk.Server.QueryServerRulesCtx(ctx, addr)
+			},
+			want: func(a2sSrv *steamtest.A2SServer) any { return a2sSrv.Rules() },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sdk, a2sSrv, rec := newA2S(t)
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+			defer cancel()
+
+			whole, err := tt.query(ctx, sdk, a2sSrv.Addr)
+			if err != nil {
+				t.Fatalf("unsplit query: %v", err)
+			}
+			wholeBytes := rec.last(t, tt.endpoint).Bytes
+
+			a2sSrv.SetSplitSize(splitSize)
+			split, err := tt.query(ctx, sdk, a2sSrv.Addr)
+			if err != nil {
+				t.Fatalf("split query: %v", err)
+			}
+			if !reflect.DeepEqual(split, whole) || !reflect.DeepEqual(split, tt.want(a2sSrv)) {
+				t.Fatalf("split answer differs:\n got %+v\nwant %+v", split, tt.want(a2sSrv))
+			}
+
+			// Bytes 为全部数据报长度: 挑战码响应 9 字节, 每个分包另加 12 字节头
+			// Bytes covers every datagram: a 9-byte challenge reply, plus a 12-byte header per split packet
+			payload := wholeBytes - 9
+			if payload <= splitSize {
+				t.Fatalf("fixture too small to split: %d bytes", payload)
+			}
+			packets := (payload + splitSize - 1) / splitSize
+			if got, want := rec.last(t, tt.endpoint).Bytes, wholeBytes+12*packets; got != want {
+				t.Fatalf("want %d bytes for %d split packets, got %d", want, packets, got)
+			}
+		})
+	}
+}
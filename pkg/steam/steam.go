@@ -1,15 +1,16 @@
 package steam
 
 import (
-	"fmt"
-
 	"github.com/GoFurry/gf-steam-sdk/internal/client"
+	"github.com/GoFurry/gf-steam-sdk/pkg/cache"
 	"github.com/GoFurry/gf-steam-sdk/pkg/config"
+	"github.com/GoFurry/gf-steam-sdk/pkg/observe"
 	"github.com/GoFurry/gf-steam-sdk/pkg/steam/api/dev"
 	"github.com/GoFurry/gf-steam-sdk/pkg/steam/api/store"
 	"github.com/GoFurry/gf-steam-sdk/pkg/steam/crawler"
 	"github.com/GoFurry/gf-steam-sdk/pkg/steam/server"
 	"github.com/GoFurry/gf-steam-sdk/pkg/steam/util"
+	"github.com/GoFurry/gf-steam-sdk/pkg/steam/watcher"
 )
 
 // 文档参考 | Documentation references:
@@ -21,6 +22,8 @@ import (
 // Aggregates all core business modules, provides a unified SDK call entry, supports chain configuration extension
 // 聚合所有核心业务模块, 提供统一的 SDK 调用入口, 支持链式配置扩展
 type SteamSDK struct {
+	client *client.Client // 共享内部 Client | Shared internal client
+
 	Develop *dev.DevService         // 玩家模块 | Develop module API from api.steampowered.com
 	Store   *store.StoreService     // 商店模块 | Store module (接口数据来自商店界面) API from store.steampowered.com
 	Crawler *crawler.CrawlerService // 爬虫模块 | Crawler module (网页爬取/反爬策略)
@@ -32,25 +35,18 @@ type SteamSDK struct {
 // 初始化内部 Client 并完成所有业务模块的实例化, 支持配置校验和错误兜底
 //   - cfg: Global config (supports chain config for proxy/rate limit/timeout)
 func NewSteamSDK(cfg *config.SteamConfig) (*SteamSDK, error) {
-	// 兜底API Key | Fallback API Key (avoid request failure due to empty value)
-	if cfg.APIKey == "" {
-		cfg.APIKey = "steam-api-key"
-	}
-	// 兜底AccessToken | Fallback AccessToken (avoid request failure due to empty value)
-	if cfg.AccessToken == "" {
-		cfg.AccessToken = "steam-access-token"
-	}
+	// API Key/AccessToken 可为空, 此时可通过 option.WithAPIKey/option.WithAccessToken 按请求传入, 未设置则不发送
+	// API Key/AccessToken may be empty: pass them per call via option.WithAPIKey/option.WithAccessToken, unset params are omitted
 	// 创建内部 Client | Create internal Client (integrates retry/proxy/rate limit)
 	cli, err := client.NewClient(cfg)
 	if err != nil {
-		if cfg.IsDebug {
-			fmt.Printf("[Error] NewSteamSDK NewClient error: %v\n", err)
-		}
+		cfg.Log().Error("create steam client failed", "err", err)
 		return nil, err
 	}
 
 	// 初始化所有模块 Service | Initialize all module services
 	return &SteamSDK{
+		client:  cli,
 		Develop: dev.NewDevService(cli),
 		Crawler: crawler.NewCrawlerService(cfg),
 		Server:  server.NewServerService(cli),
@@ -59,6 +55,24 @@ func NewSteamSDK(cfg *config.SteamConfig) (*SteamSDK, error) {
 	}, nil
 }
 
+// Stats 获取 SDK 运行统计(每个 API Key 的当日用量/剩余额度/隔离状态、响应缓存统计)
+// Stats returns SDK runtime stats (per-key daily usage/remaining quota/quarantine state, response cache stats)
+func (s *SteamSDK) Stats() observe.Stats {
+	return s.client.Stats()
+}
+
+// CacheStats 获取响应缓存统计(命中/未命中/304 重新校验/淘汰等), 未启用缓存时返回零值
+// CacheStats returns response cache stats (hits/misses/304 revalidations/evictions, ...), zero value if caching is disabled
+func (s *SteamSDK) CacheStats() cache.Stats {
+	return s.client.CacheStats()
+}
+
+// NewWishlistWatcher 基于 SDK 的官方 API 与商店模块创建愿望单降价监视器
+// NewWishlistWatcher creates a wishlist price-drop watcher on top of the SDK's develop and store modules
+func (s *SteamSDK) NewWishlistWatcher(cfg watcher.WishlistWatcherConfig) (*watcher.WishlistWatcher, error) {
+	return watcher.NewWishlistWatcher(s.Develop, s.Store, cfg)
+}
+
 // Close 释放所有模块资源
 func (s *SteamSDK) Close() error {
 	defer func() {
@@ -0,0 +1,186 @@
+package util
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/net/html"
+)
+
+// allowedTags 白名单标签及其允许的属性, 覆盖 ParseBBCode 的全部输出
+// allowedTags whitelists tags and their allowed attributes, covering everything ParseBBCode emits
+var allowedTags = map[string][]string{
+	"a": {"href"}, "img": {"src", "alt"}, "video": {"src", "controls"}, "iframe": {"src", "frameborder", "allowfullscreen"},
+	"strong": nil, "b": nil, "em": nil, "i": nil, "u": nil, "s": nil, "h1": nil, "h2": nil, "h3": nil,
+	"p": nil, "br": nil, "hr": nil, "ul": nil, "ol": nil, "li": nil, "blockquote": nil, "code": nil, "pre": nil,
+}
+
+// droppedTags 连同内容一起丢弃的标签 (Tags dropped together with their content)
+var droppedTags = map[string]bool{"script": true, "style": true, "noscript": true, "object": true, "embed": true}
+
+// blockTags 提取纯文本时视为空白的块级标签 (Block tags treated as whitespace when extracting text)
+var blockTags = map[string]bool{
+	"br": true, "p": true, "div": true, "li": true, "ul": true, "ol": true, "h1": true, "h2": true, "h3": true,
+	"h4": true, "h5": true, "h6": true, "hr": true, "blockquote": true, "pre": true, "tr": true, "td": true, "th": true,
+	"img": true, "video": true, "iframe": true,
+}
+
+// iframePrefix 允许嵌入的 iframe 地址前缀(仅 ParseBBCode 生成的 YouTube 播放器)
+// iframePrefix is the only iframe source allowed (the YouTube player ParseBBCode emits)
+const iframePrefix = "https://www.youtube.com/embed/"
+
+// SanitizeHTML filters HTML down to a safe whitelist 将 HTML 过滤为安全的白名单子集
+// 未列入白名单的标签被移除(保留文本), script/style 等标签连同内容移除,
+// 事件属性等非白名单属性被丢弃, 链接和资源地址仅允许 http/https
+// Tags outside the whitelist are removed (their text is kept), script/style and similar tags are removed with their content,
+// non-whitelisted attributes such as event handlers are dropped, and links/sources must be http or https
+// 参数说明 (Parameters):
+//
+//	input - 待过滤的 HTML, 如 ParseBBCode 或 MarkdownToHTML 的输出 (HTML to filter, e.g. the output of ParseBBCode or MarkdownToHTML)
+//
+// 返回值 (Returns):
+//
+//	过滤后的 HTML 字符串 (Sanitized HTML string)
+func SanitizeHTML(input string) string {
+	var (
+		buf     strings.Builder
+		dropped int // 当前所在丢弃标签的嵌套层数 (Nesting depth inside dropped tags)
+		iframes int // 被拒绝且尚未闭合的 iframe 数 (Rejected iframes not yet closed)
+	)
+	z := html.NewTokenizer(strings.NewReader(input))
+	for {
+		tt := z.Next()
+		if tt == html.ErrorToken {
+			return buf.String()
+		}
+		tok := z.Token()
+		switch tt {
+		case html.StartTagToken, html.SelfClosingTagToken:
+			if droppedTags[tok.Data] {
+				if tt == html.StartTagToken {
+					dropped++
+				}
+				continue
+			}
+			if dropped > 0 {
+				continue
+			}
+			if attrs, ok := allowedTags[tok.Data]; ok {
+				if tok.Data == "iframe" && !strings.HasPrefix(attrValue(tok, "src"), iframePrefix) {
+					if tt == html.StartTagToken {
+						iframes++
+					}
+					continue
+				}
+				tok.Attr = filterAttrs(tok.Attr, attrs)
+				buf.WriteString(tok.String())
+			}
+		case html.EndTagToken:
+			if droppedTags[tok.Data] {
+				if dropped > 0 {
+					dropped--
+				}
+				continue
+			}
+			if tok.Data == "iframe" && iframes > 0 {
+				iframes--
+				continue
+			}
+			if _, ok := allowedTags[tok.Data]; ok && dropped == 0 {
+				buf.WriteString(tok.String())
+			}
+		case html.TextToken:
+			if dropped == 0 {
+				buf.WriteString(html.EscapeString(tok.Data))
+			}
+		}
+	}
+}
+
+// HTMLToText extracts plain text from HTML 提取 HTML 中的纯文本
+// 块级标签和 <br> 视为空白, 连续空白折叠为一个空格, 超出长度时按字符截断并追加 "…"
+// Block tags and <br> count as whitespace, whitespace runs collapse to one space, and text longer than max is cut by rune with "…" appended
+// 参数说明 (Parameters):
+//
+//	input - 原始 HTML (Source HTML)
+//	max   - 最大字符数, <=0 表示不截断 (Max runes, <=0 for no limit)
+//
+// 返回值 (Returns):
+//
+//	纯文本字符串 (Plain text string)
+func HTMLToText(input string, max int) string {
+	var (
+		buf     strings.Builder
+		dropped int
+	)
+	z := html.NewTokenizer(strings.NewReader(input))
+	for done := false; !done; {
+		switch tt := z.Next(); tt {
+		case html.ErrorToken:
+			done = true
+		case html.StartTagToken, html.SelfClosingTagToken, html.EndTagToken:
+			tok := z.Token()
+			if droppedTags[tok.Data] && tt != html.SelfClosingTagToken {
+				if tt == html.StartTagToken {
+					dropped++
+				} else if dropped > 0 {
+					dropped--
+				}
+			}
+			if blockTags[tok.Data] {
+				buf.WriteByte(' ')
+			}
+		case html.TextToken:
+			if dropped == 0 {
+				buf.WriteString(html.UnescapeString(string(z.Text())))
+			}
+		}
+	}
+
+	text := strings.Join(strings.Fields(buf.String()), " ")
+	if max > 0 && utf8.RuneCountInString(text) > max {
+		text = strings.TrimSpace(string([]rune(text)[:max])) + "…"
+	}
+	return text
+}
+
+// filterAttrs keeps the whitelisted attributes and drops unsafe URLs 保留白名单属性并丢弃不安全的地址
+func filterAttrs(attrs []html.Attribute, allowed []string) []html.Attribute {
+	out := attrs[:0]
+	for _, a := range attrs {
+		if a.Namespace != "" || !contains(allowed, a.Key) {
+			continue
+		}
+		if (a.Key == "href" || a.Key == "src") && !safeURL(a.Val) {
+			continue
+		}
+		out = append(out, a)
+	}
+	return out
+}
+
+// safeURL reports whether the URL uses http or https 判断地址是否为 http/https
+func safeURL(u string) bool {
+	u = strings.ToLower(strings.TrimSpace(u))
+	return strings.HasPrefix(u, "https://") || strings.HasPrefix(u, "http://")
+}
+
+// attrValue gets an attribute value of the token 获取标签的属性值
+func attrValue(tok html.Token, key string) string {
+	for _, a := range tok.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+	return ""
+}
+
+// contains reports whether s is in list 判断 s 是否在列表中
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
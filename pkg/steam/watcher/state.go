@@ -0,0 +1,157 @@
+package watcher
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/GoFurry/gf-steam-sdk/pkg/util"
+	"github.com/bytedance/sonic"
+)
+
+// WishlistState 愿望单监视状态, 记录上次轮询时每个应用的价格与发行情况
+// WishlistState is the wishlist watcher state, holding each app's price and release status from the last poll
+type WishlistState struct {
+	SteamID   string              `json:"steamid"`    // 用户 SteamID | User SteamID
+	Apps      map[uint64]AppState `json:"apps"`       // 应用ID -> 状态 | AppID -> state
+	UpdatedAt int64               `json:"updated_at"` // 上次轮询时间(Unix时间戳) | Last poll time (Unix timestamp)
+}
+
+// AppState 单个应用的监视状态
+// AppState is the watched state of one app
+type AppState struct {
+	Name            string `json:"name"`             // 名称 | Name
+	HasPrice        bool   `json:"has_price"`        // 是否有价格(免费或未定价时为 false) | Whether a price is set (false for free or unpriced apps)
+	FinalPrice      int64  `json:"final_price"`      // 现价(1/100 货币单位) | Final price (1/100 of the currency unit)
+	DiscountPercent int    `json:"discount_percent"` // 折扣百分比 | Discount percent
+	BelowThreshold  bool   `json:"below_threshold"`  // 是否已低于阈值 | Whether the price is already below the threshold
+	ComingSoon      bool   `json:"coming_soon"`      // 是否即将推出 | Whether the app is coming soon
+}
+
+// StateStore 监视状态存储接口, 实现需保证并发安全
+// StateStore is the watcher state storage interface, implementations must be safe for concurrent use
+type StateStore interface {
+	Load(steamID string) (*WishlistState, error)     // 读取状态, 不存在时返回 nil, nil | Load state, nil, nil if there is none
+	Save(steamID string, state *WishlistState) error // 保存状态 | Save state
+}
+
+// MemoryStateStore 内存状态存储, 仅在进程内有效
+// MemoryStateStore keeps state in memory for the lifetime of the process
+type MemoryStateStore struct {
+	mu     sync.Mutex
+	states map[string]*WishlistState
+}
+
+// NewMemoryStateStore 创建内存状态存储
+// NewMemoryStateStore creates an in-memory state store
+func NewMemoryStateStore() *MemoryStateStore {
+	return &MemoryStateStore{states: make(map[string]*WishlistState)}
+}
+
+// Load 实现 StateStore 接口, 返回副本
+func (m *MemoryStateStore) Load(steamID string) (*WishlistState, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	state, ok := m.states[steamID]
+	if !ok {
+		return nil, nil
+	}
+	return state.clone(), nil
+}
+
+// Save 实现 StateStore 接口, 保存副本
+func (m *MemoryStateStore) Save(steamID string, state *WishlistState) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.states[steamID] = state.clone()
+	return nil
+}
+
+// FileStateStore 文件状态存储
+// 每个用户的状态保存为一个 JSON 文件, 进程重启后仍然有效
+// FileStateStore keeps state on disk
+// Each user's state is a JSON file, so state survives process restarts
+type FileStateStore struct {
+	dir string
+	mu  sync.Mutex
+}
+
+// NewFileStateStore 创建文件状态存储, 目录不存在时自动创建
+// 参数:
+//   - dir: 状态目录(为空时使用 util.WISHLIST_STATE_DIR) | State directory (util.WISHLIST_STATE_DIR if empty)
+//
+// 返回值:
+//   - *FileStateStore: 文件状态存储实例 | File state store instance
+//   - error: 创建目录失败时返回错误 | Error if the directory cannot be created
+func NewFileStateStore(dir string) (*FileStateStore, error) {
+	if dir == "" {
+		dir = util.WISHLIST_STATE_DIR
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("create state dir failed: %w", err)
+	}
+	return &FileStateStore{dir: dir}, nil
+}
+
+// path SteamID 对应的状态文件路径 | State file path of a SteamID
+func (f *FileStateStore) path(steamID string) string {
+	return filepath.Join(f.dir, "wishlist_"+filepath.Base(steamID)+".json")
+}
+
+// Load 实现 StateStore 接口
+func (f *FileStateStore) Load(steamID string) (*WishlistState, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	data, err := os.ReadFile(f.path(steamID))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read state file failed: %w", err)
+	}
+	var state WishlistState
+	if err := sonic.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("parse state file failed: %w", err)
+	}
+	return &state, nil
+}
+
+// Save 实现 StateStore 接口, 先写临时文件再重命名, 避免中断时留下半写入的文件
+func (f *FileStateStore) Save(steamID string, state *WishlistState) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	data, err := sonic.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("marshal state failed: %w", err)
+	}
+	tmp, err := os.CreateTemp(f.dir, "wishlist_*.tmp")
+	if err != nil {
+		return fmt.Errorf("create state file failed: %w", err)
+	}
+	_, werr := tmp.Write(data)
+	cerr := tmp.Close()
+	if werr != nil || cerr != nil {
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("write state file failed: %w", errors.Join(werr, cerr))
+	}
+	if err := os.Rename(tmp.Name(), f.path(steamID)); err != nil {
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("replace state file failed: %w", err)
+	}
+	return nil
+}
+
+// clone 深拷贝状态 | Deep-copy the state
+func (s *WishlistState) clone() *WishlistState {
+	if s == nil {
+		return nil
+	}
+	copied := *s
+	copied.Apps = make(map[uint64]AppState, len(s.Apps))
+	for id, app := range s.Apps {
+		copied.Apps[id] = app
+	}
+	return &copied
+}
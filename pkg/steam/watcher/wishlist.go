@@ -0,0 +1,265 @@
+// Package watcher 提供基于轮询的 Steam 数据变化监视
+// 包含愿望单降价监视器、类型化事件以及可替换的状态存储
+// Package watcher provides polling-based change watchers for Steam data
+// Includes the wishlist price-drop watcher, typed events and pluggable state stores
+
+package watcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/GoFurry/gf-steam-sdk/pkg/models"
+	"github.com/GoFurry/gf-steam-sdk/pkg/option"
+	"github.com/GoFurry/gf-steam-sdk/pkg/steam/api/dev"
+	"github.com/GoFurry/gf-steam-sdk/pkg/steam/api/store"
+	"github.com/GoFurry/gf-steam-sdk/pkg/util"
+	ue "github.com/GoFurry/gf-steam-sdk/pkg/util/errors"
+)
+
+// WishlistEventType 愿望单事件类型
+// WishlistEventType is the type of a wishlist event
+type WishlistEventType string
+
+const (
+	EventDiscountStarted     WishlistEventType = "discount_started"      // 开始打折 | A discount started
+	EventPriceBelowThreshold WishlistEventType = "price_below_threshold" // 价格低于阈值 | The price dropped below the threshold
+	EventReleased            WishlistEventType = "released"              // 已发行 | The app was released
+	EventError               WishlistEventType = "error"                 // 轮询错误 | Polling error
+)
+
+// WishlistEvent 愿望单事件
+// WishlistEvent is an event emitted by the wishlist watcher
+type WishlistEvent struct {
+	Type          WishlistEventType  `json:"type"`           // 事件类型 | Event type
+	SteamID       string             `json:"steamid"`        // 用户 SteamID | User SteamID
+	AppID         uint64             `json:"appid"`          // 应用ID(整体轮询错误时为 0) | AppID (0 for whole-poll errors)
+	Name          string             `json:"name"`           // 应用名称 | App name
+	Price         *models.StorePrice `json:"price"`          // 当前价格 | Current price
+	PreviousFinal int64              `json:"previous_final"` // 上次轮询的现价(未知时为 0) | Final price at the last poll (0 if unknown)
+	Threshold     int64              `json:"threshold"`      // 触发的价格阈值 | Price threshold that fired
+	At            time.Time          `json:"at"`             // 检测时间 | Detection time
+	Err           error              `json:"-"`              // 错误(仅 EventError) | Error (EventError only)
+}
+
+// WishlistWatcherConfig 愿望单监视器配置
+// WishlistWatcherConfig configures a wishlist watcher
+type WishlistWatcherConfig struct {
+	SteamID     string                 // 用户 SteamID(必填, 愿望单需公开) | User SteamID (required, wishlist must be public)
+	CC          string                 // 国家/地区代码, 决定价格与货币 | Country code deciding price and currency
+	Lang        string                 // 语言 | Language
+	Interval    time.Duration          // 轮询间隔(<=0 时使用 util.WISHLIST_WATCH_INTERVAL) | Poll interval (util.WISHLIST_WATCH_INTERVAL if <=0)
+	Threshold   int64                  // 默认价格阈值, 1/100 货币单位(<=0 表示不启用) | Default price threshold in 1/100 of the currency unit (<=0 disables)
+	Thresholds  map[uint64]int64       // 按应用覆盖的价格阈值 | Per-app threshold overrides
+	Store       StateStore             // 状态存储(nil 时使用内存存储) | State store (in-memory if nil)
+	EmitInitial bool                   // 首次见到应用时是否按当前状态发出事件 | Emit events for the current state the first time an app is seen
+	Buffer      int                    // 事件通道缓冲(<=0 时使用 util.WISHLIST_WATCH_BUFFER) | Event channel buffer (util.WISHLIST_WATCH_BUFFER if <=0)
+	Options     []option.RequestOption // 每次请求附带的选项 | Options applied to every request
+}
+
+// WishlistWatcher 愿望单降价监视器
+// 定期将用户愿望单与商店价格关联, 在开始打折、价格低于阈值或应用发行时发出事件; 状态经 StateStore 在多次运行间保留
+// WishlistWatcher periodically joins a user's wishlist with store pricing and emits events when a discount starts,
+// the price drops below a threshold or an app is released; state persists between runs through the StateStore
+type WishlistWatcher struct {
+	develop *dev.DevService
+	store   *store.StoreService
+	cfg     WishlistWatcherConfig
+	mu      sync.Mutex // 串行化轮询, 避免并发读写同一状态 | Serializes polls so the same state is never read and written concurrently
+}
+
+// NewWishlistWatcher 创建愿望单监视器
+// 参数:
+//   - develop: 官方 API 模块(获取愿望单) | Develop module (wishlist)
+//   - storeService: 商店模块(获取价格与发行状态) | Store module (price and release status)
+//   - cfg: 监视器配置 | Watcher config
+//
+// 返回值:
+//   - *WishlistWatcher: 监视器实例 | Watcher instance
+//   - error: 缺少模块或 SteamID 时返回参数错误 | Param error if a module or the SteamID is missing
+func NewWishlistWatcher(develop *dev.DevService, storeService *store.StoreService, cfg WishlistWatcherConfig) (*WishlistWatcher, error) {
+	if develop == nil || storeService == nil {
+		return nil, ue.NewWithType(ue.ErrTypeParam, "wishlist watcher needs the develop and store modules", nil)
+	}
+	if cfg.SteamID == "" {
+		return nil, ue.NewWithType(ue.ErrTypeParam, "wishlist watcher needs a steamid", nil)
+	}
+	if cfg.Interval <= 0 {
+		cfg.Interval = util.WISHLIST_WATCH_INTERVAL
+	}
+	if cfg.Buffer <= 0 {
+		cfg.Buffer = util.WISHLIST_WATCH_BUFFER
+	}
+	if cfg.Store == nil {
+		cfg.Store = NewMemoryStateStore()
+	}
+	return &WishlistWatcher{develop: develop, store: storeService, cfg: cfg}, nil
+}
+
+// Watch 开始轮询, 立即执行一次, 之后按 Interval 重复; 事件写入返回的通道, ctx 结束后通道关闭
+// 轮询失败以 EventError 事件发出, 不会中止监视
+// Watch starts polling right away and then every Interval; events are sent on the returned channel, which is closed once ctx is done
+// A failed poll is sent as an EventError event and does not stop the watcher
+func (w *WishlistWatcher) Watch(ctx context.Context) <-chan WishlistEvent {
+	events := make(chan WishlistEvent, w.cfg.Buffer)
+	go func() {
+		defer close(events)
+		ticker := time.NewTicker(w.cfg.Interval)
+		defer ticker.Stop()
+		for {
+			polled, err := w.Poll(ctx)
+			if err != nil && ctx.Err() == nil {
+				polled = append(polled, WishlistEvent{Type: EventError, SteamID: w.cfg.SteamID, At: time.Now(), Err: err})
+			}
+			for _, e := range polled {
+				select {
+				case events <- e:
+				case <-ctx.Done():
+					return
+				}
+			}
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return events
+}
+
+// Poll 执行一次轮询并保存状态, 返回本次检测到的事件
+// 单个应用查询失败时以 EventError 事件返回并保留其旧状态; 应用在当前地区不可用时跳过
+// Poll runs one poll, saves the state and returns the events it detected
+// A failed app lookup is returned as an EventError event and keeps the app's previous state; apps unavailable in the region are skipped
+//
+// 返回值:
+//   - []WishlistEvent: 本次事件 | Events of this poll
+//   - error: 读取愿望单或读写状态失败时返回错误(状态存储的错误原样包装) | Error if the wishlist cannot be fetched or the state cannot be loaded/saved (store errors are wrapped unchanged)
+func (w *WishlistWatcher) Poll(ctx context.Context) ([]WishlistEvent, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	prev, err := w.cfg.Store.Load(w.cfg.SteamID)
+	if err != nil {
+		return nil, fmt.Errorf("load wishlist watcher state failed: %w", err)
+	}
+	if prev == nil {
+		prev = &WishlistState{SteamID: w.cfg.SteamID}
+	}
+
+	items, err := w.develop.GetWishlistCtx(ctx, w.cfg.SteamID, w.cfg.Options...)
+	if err != nil {
+		return nil, err
+	}
+	details, errs := w.fetchDetails(ctx, items)
+	if ctx.Err() != nil {
+		return nil, ue.NewWithType(ue.ErrTypeRequest, "wishlist poll canceled", ctx.Err())
+	}
+
+	now := time.Now()
+	next := &WishlistState{SteamID: w.cfg.SteamID, Apps: make(map[uint64]AppState, len(items)), UpdatedAt: now.Unix()}
+	var events []WishlistEvent
+	for idx, item := range items {
+		old, seen := prev.Apps[item.AppID]
+		switch {
+		case errs[idx] != nil:
+			events = append(events, WishlistEvent{Type: EventError, SteamID: w.cfg.SteamID, AppID: item.AppID, Name: old.Name, At: now, Err: errs[idx]})
+			fallthrough
+		case details[idx] == nil:
+			// 保留旧状态, 下次轮询继续比较 | Keep the previous state so the next poll still compares against it
+			if seen {
+				next.Apps[item.AppID] = old
+			}
+			continue
+		}
+
+		d := details[idx]
+		threshold := w.threshold(item.AppID)
+		cur := AppState{Name: d.Name, ComingSoon: d.ComingSoon}
+		if d.Price != nil {
+			cur.HasPrice = true
+			cur.FinalPrice = d.Price.Final
+			cur.DiscountPercent = d.Price.DiscountPercent
+			cur.BelowThreshold = threshold > 0 && d.Price.Final < threshold
+		}
+		next.Apps[item.AppID] = cur
+		if !seen && !w.cfg.EmitInitial {
+			continue // 首次见到的应用仅作为基线 | A newly seen app only sets the baseline
+		}
+
+		base := WishlistEvent{SteamID: w.cfg.SteamID, AppID: item.AppID, Name: d.Name, Price: d.Price, PreviousFinal: old.FinalPrice, At: now}
+		if cur.DiscountPercent > 0 && old.DiscountPercent == 0 {
+			e := base
+			e.Type = EventDiscountStarted
+			events = append(events, e)
+		}
+		if cur.BelowThreshold && !old.BelowThreshold {
+			e := base
+			e.Type, e.Threshold = EventPriceBelowThreshold, threshold
+			events = append(events, e)
+		}
+		if seen && old.ComingSoon && !cur.ComingSoon {
+			e := base
+			e.Type = EventReleased
+			events = append(events, e)
+		}
+	}
+
+	if err := w.cfg.Store.Save(w.cfg.SteamID, next); err != nil {
+		return events, fmt.Errorf("save wishlist watcher state failed: %w", err)
+	}
+	return events, nil
+}
+
+// fetchDetails 以有限并发查询愿望单应用的价格与发行状态, 结果顺序与 items 一致
+// 应用在当前地区不可用时对应结果与错误均为 nil
+// fetchDetails looks up price and release status of the wishlist apps with bounded concurrency, results follow the items order
+// Both result and error are nil for apps unavailable in the region
+func (w *WishlistWatcher) fetchDetails(ctx context.Context, items []models.WishlistItem) ([]*models.AppDetails, []error) {
+	results := make([]*models.AppDetails, len(items))
+	errs := make([]error, len(items))
+	query := &store.DetailsQuery{Filters: []string{"basic", util.STORE_PRICE_FILTER, "release_date"}}
+	if w.cfg.CC != "" {
+		query.CC = &w.cfg.CC
+	}
+	if w.cfg.Lang != "" {
+		query.Lang = &w.cfg.Lang
+	}
+
+	var (
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, util.WISHLIST_WATCH_CONCURRENCY) // 限制同时进行的请求数 | Bound in-flight requests
+	)
+	for idx, item := range items {
+		wg.Add(1)
+		go func(index int, appID uint64) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			details, err := w.store.GetAppDetailsBriefCtx(ctx, appID, query, w.cfg.Options...)
+			switch {
+			case errors.Is(err, ue.ErrAppNotFound):
+				// 该地区不可用 | Not available in this region
+			case err != nil:
+				errs[index] = err
+			default:
+				results[index] = &details
+			}
+		}(idx, item.AppID)
+	}
+	wg.Wait()
+	return results, errs
+}
+
+// threshold 应用的价格阈值, 优先使用按应用配置 | Price threshold of an app, per-app overrides first
+func (w *WishlistWatcher) threshold(appID uint64) int64 {
+	if t, ok := w.cfg.Thresholds[appID]; ok {
+		return t
+	}
+	return w.cfg.Threshold
+}
@@ -0,0 +1,58 @@
+package watcher_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/GoFurry/gf-steam-sdk/pkg/steam/watcher"
+	"github.com/GoFurry/gf-steam-sdk/pkg/steamtest"
+	ue "github.com/GoFurry/gf-steam-sdk/pkg/util/errors"
+)
+
+var errStore = errors.New("state store unavailable")
+
+// failingStore 按配置令读取或保存失败的状态存储 | State store failing its loads or saves as configured
+type failingStore struct {
+	failLoad, failSave bool
+}
+
+func (s failingStore) Load(string) (*watcher.WishlistState, error) {
+	if s.failLoad {
+		return nil, errStore
+	}
+	return nil, nil
+}
+
+func (s failingStore) Save(string, *watcher.WishlistState) error {
+	if s.failSave {
+		return errStore
+	}
+	return nil
+}
+
+func TestPollWrapsStateStoreErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		store failingStore
+	}{
+		{"load", failingStore{failLoad: true}},
+		{"save", failingStore{failSave: true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sdk, _ := steamtest.NewSDK(t)
+			w, err := sdk.NewWishlistWatcher(watcher.WishlistWatcherConfig{SteamID: steamtest.SteamID, Store: tt.store})
+			if err != nil {
+				t.Fatalf("new watcher: %v", err)
+			}
+			_, err = w.Poll(context.Background())
+			if !errors.Is(err, errStore) {
+				t.Fatalf("want the store error wrapped, got %v", err)
+			}
+			if typ := ue.GetType(err); typ == ue.ErrTypeParam {
+				t.Fatalf("store failure reported as a param error: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,530 @@
+package steamtest
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"net"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/rumblefrog/go-a2s"
+)
+
+// A2S 查询名, 与 ServerService 上报观察者的接口名一致 | A2S query names, same as the endpoints ServerService reports to observers
+const (
+	A2SInfo   = "A2S/Info"
+	A2SPlayer = "A2S/Player"
+	A2SRules  = "A2S/Rules"
+)
+
+// A2S 协议常量 | A2S protocol constants
+const (
+	a2sSimpleHeader    = -1   // 单包响应头 | Single packet header
+	a2sMultiHeader     = -2   // 分包响应头 | Split packet header
+	a2sInfoRequest     = 0x54 // 'T'
+	a2sPlayerRequest   = 0x55 // 'U'
+	a2sRulesRequest    = 0x56 // 'V'
+	a2sChallengeReply  = 0x41 // 'A'
+	a2sInfoResponse    = 0x49 // 'I'
+	a2sPlayerResponse  = 0x44 // 'D'
+	a2sRulesResponse   = 0x45 // 'E'
+	a2sDefaultSplit    = 1248 // Source 引擎默认分包大小 | Source engine default split size
+	a2sInfoQueryString = "Source Engine Query"
+)
+
+// a2sChallenge 模拟服务器下发的挑战码 | Challenge number handed out by the fake server
+var a2sChallenge = [4]byte{0x4B, 0xA1, 0xD5, 0x22}
+
+// A2SFault 编排的 A2S 故障, 作用于对应查询的一个请求包(含获取挑战码的请求包)
+// A2SFault is a scripted A2S fault; it applies to one request packet of the query (challenge requests included)
+type A2SFault struct {
+	Drop     bool          // 丢弃请求不回复 | Drop the request without answering
+	Delay    time.Duration // 延迟回复 | Delay the answer
+	Body     []byte        // 替换响应包(nil 使用正常响应) | Replacement packet (nil keeps the normal answer)
+	truncate bool          // 截断正常响应 | Truncate the normal answer
+}
+
+// A2SDrop 丢包: 不回复, 客户端等待至超时
+// A2SDrop drops the packet: nothing is answered and the client waits until its timeout
+//
+// 返回值:
+//   - A2SFault: 故障 | Fault
+func A2SDrop() A2SFault {
+	return A2SFault{Drop: true}
+}
+
+// A2SDelay 延迟回复, 超过客户端超时即等同丢包
+// A2SDelay delays the answer; a delay beyond the client timeout behaves like a drop
+//
+// 参数:
+//   - d: 延迟 | Delay
+//
+// 返回值:
+//   - A2SFault: 故障 | Fault
+func A2SDelay(d time.Duration) A2SFault {
+	return A2SFault{Delay: d}
+}
+
+// A2SMalformed 返回截断一半的正常响应(含挑战码响应), 模拟损坏的数据包
+// A2SMalformed answers the normal packet cut in half (challenge replies included) to emulate corrupted data
+//
+// 返回值:
+//   - A2SFault: 故障 | Fault
+func A2SMalformed() A2SFault {
+	return A2SFault{truncate: true}
+}
+
+// A2SRequest A2S 模拟服务器收到的请求包
+// A2SRequest is a request packet received by the fake A2S server
+type A2SRequest struct {
+	Endpoint  string // 查询名(A2SInfo/A2SPlayer/A2SRules) | Query name
+	Challenge bool   // 是否携带有效挑战码 | Whether a valid challenge was included
+	From      string // 客户端地址 | Client address
+}
+
+// a2sScripted 编排中的 A2S 故障及剩余次数(<0 表示一直生效)
+// a2sScripted is a queued A2S fault and how many more packets it applies to (<0 means forever)
+type a2sScripted struct {
+	fault     A2SFault
+	remaining int
+}
+
+// A2SServer A2S 模拟服务器
+// 在本地 UDP 端口响应 A2S_INFO、A2S_PLAYER 和 A2S_RULES(挑战码流程与线上一致), 超过分包大小的玩家/规则响应按 Source 引擎格式分包;
+// 可按查询编排丢包、延迟和损坏数据; 可并发使用
+// A2SServer is a fake A2S server
+// It answers A2S_INFO, A2S_PLAYER and A2S_RULES on a local UDP port (with the production challenge flow) and splits
+// player/rules answers larger than the split size in the Source engine format; drops, delays and corrupted data can be
+// scripted per query; safe for concurrent use
+type A2SServer struct {
+	Addr string // 监听地址 "ip:port" | Listen address "ip:port"
+
+	conn *net.UDPConn
+	done chan struct{}
+
+	mu            sync.Mutex
+	info          a2s.ServerInfo
+	players       a2s.PlayerInfo
+	rules         a2s.RulesInfo
+	infoChallenge bool                     // A2S_INFO 是否要求挑战码 | Whether A2S_INFO requires a challenge
+	splitSize     int                      // 分包大小 | Split size
+	packetID      uint32                   // 分包响应 ID | Split response ID
+	failures      map[string][]a2sScripted // 查询名 -> 故障队列 | Query -> fault queue
+	requests      []A2SRequest             // 已收到的请求包 | Request packets received so far
+}
+
+// NewA2SServer 在 127.0.0.1 的随机 UDP 端口启动 A2S 模拟服务器, 使用完毕需调用 Close
+// 返回值:
+//   - *A2SServer: 模拟服务器 | Fake server
+func NewA2SServer() *A2SServer {
+	conn, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		panic("steamtest: failed to listen on a udp port: " + err.Error())
+	}
+	s := &A2SServer{Addr: conn.LocalAddr().String(), conn: conn, done: make(chan struct{})}
+	s.Reset()
+	go s.serve()
+	return s
+}
+
+// Close 关闭模拟服务器 | Close the fake server
+func (s *A2SServer) Close() {
+	_ = s.conn.Close()
+	<-s.done
+}
+
+// Reset 恢复内置样例、挑战码和分包设置, 清空编排的故障和请求记录
+// Reset restores the built-in fixtures, challenge and split settings and clears scripted faults and recorded requests
+func (s *A2SServer) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.info = defaultA2SInfo()
+	s.players = defaultA2SPlayers()
+	s.rules = defaultA2SRules()
+	s.infoChallenge = true
+	s.splitSize = a2sDefaultSplit
+	s.failures = map[string][]a2sScripted{}
+	s.requests = nil
+}
+
+// Info 获取当前 A2S_INFO 响应内容 | Current A2S_INFO answer
+func (s *A2SServer) Info() a2s.ServerInfo {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.info
+}
+
+// SetInfo 替换 A2S_INFO 响应内容 | Replace the A2S_INFO answer
+func (s *A2SServer) SetInfo(info a2s.ServerInfo) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.info = info
+}
+
+// Players 获取当前 A2S_PLAYER 响应内容 | Current A2S_PLAYER answer
+func (s *A2SServer) Players() a2s.PlayerInfo {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.players
+}
+
+// SetPlayers 替换 A2S_PLAYER 响应内容(Count 按玩家数量重新计算)
+// SetPlayers replaces the A2S_PLAYER answer (Count is recomputed from the players)
+func (s *A2SServer) SetPlayers(players []*a2s.Player) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.players = a2s.PlayerInfo{Count: uint8(len(players)), Players: players}
+}
+
+// Rules 获取当前 A2S_RULES 响应内容 | Current A2S_RULES answer
+func (s *A2SServer) Rules() a2s.RulesInfo {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.rules
+}
+
+// SetRules 替换 A2S_RULES 响应内容(Count 按规则数量重新计算)
+// SetRules replaces the A2S_RULES answer (Count is recomputed from the rules)
+func (s *A2SServer) SetRules(rules map[string]string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rules = a2s.RulesInfo{Count: uint16(len(rules)), Rules: rules}
+}
+
+// SetInfoChallenge 设置 A2S_INFO 是否要求挑战码(默认要求, 与 2020 年后的线上服务器一致)
+// SetInfoChallenge sets whether A2S_INFO requires a challenge (required by default, like production servers since 2020)
+func (s *A2SServer) SetInfoChallenge(required bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.infoChallenge = required
+}
+
+// SetSplitSize 设置分包大小, 超过该大小的玩家/规则响应按多包发送
+// 参数:
+//   - size: 每包负载字节数(<=0 恢复默认 1248) | Payload bytes per packet (<=0 restores the default 1248)
+func (s *A2SServer) SetSplitSize(size int) {
+	if size <= 0 {
+		size = a2sDefaultSplit
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.splitSize = size
+}
+
+// Fail 为查询编排故障, 多次调用按顺序排队, 故障用尽后恢复正常响应
+// 参数:
+//   - endpoint: 查询名 A2SInfo/A2SPlayer/A2SRules | Query name
+//   - f: 故障 | Fault
+//   - times: 作用的请求包数(<=0 表示一直生效, 直到 Reset) | Request packets affected (<=0 applies until Reset)
+func (s *A2SServer) Fail(endpoint string, f A2SFault, times int) {
+	if times <= 0 {
+		times = -1
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures[endpoint] = append(s.failures[endpoint], a2sScripted{fault: f, remaining: times})
+}
+
+// Requests 获取已收到的请求包 | Request packets received so far
+func (s *A2SServer) Requests() []A2SRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]A2SRequest(nil), s.requests...)
+}
+
+// serve 读取并响应请求包, 连接关闭后退出 | Read and answer request packets until the connection is closed
+func (s *A2SServer) serve() {
+	defer close(s.done)
+	buf := make([]byte, 1400)
+	for {
+		n, from, err := s.conn.ReadFromUDP(buf)
+		if err != nil {
+			return
+		}
+		s.handle(append([]byte(nil), buf[:n]...), from)
+	}
+}
+
+// handle 处理一个请求包 | Handle one request packet
+func (s *A2SServer) handle(data []byte, from *net.UDPAddr) {
+	endpoint, challenge, ok := parseA2SRequest(data)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	s.requests = append(s.requests, A2SRequest{Endpoint: endpoint, Challenge: challenge, From: from.String()})
+	fault := s.nextFault(endpoint)
+	packets := s.answer(endpoint, challenge)
+	s.mu.Unlock()
+
+	if fault != nil {
+		switch {
+		case fault.Drop:
+			return
+		case fault.Body != nil:
+			packets = [][]byte{fault.Body}
+		case fault.truncate:
+			packets = [][]byte{packets[0][:len(packets[0])/2]}
+		}
+		if fault.Delay > 0 {
+			time.AfterFunc(fault.Delay, func() { s.send(packets, from) })
+			return
+		}
+	}
+	s.send(packets, from)
+}
+
+// send 发送响应包 | Send answer packets
+func (s *A2SServer) send(packets [][]byte, to *net.UDPAddr) {
+	for _, p := range packets {
+		if _, err := s.conn.WriteToUDP(p, to); err != nil {
+			return
+		}
+	}
+}
+
+// nextFault 取出查询的下一个故障(需持有锁) | Pop the next fault of the query (lock held)
+func (s *A2SServer) nextFault(endpoint string) *A2SFault {
+	queue := s.failures[endpoint]
+	if len(queue) == 0 {
+		return nil
+	}
+	f := queue[0].fault
+	if queue[0].remaining > 0 {
+		queue[0].remaining--
+		if queue[0].remaining == 0 {
+			queue = queue[1:]
+		}
+	}
+	s.failures[endpoint] = queue
+	return &f
+}
+
+// answer 构建请求包的正常响应(需持有锁): 缺少挑战码时下发挑战码, 否则返回数据(必要时分包)
+// answer builds the normal answer of a request packet (lock held): a challenge when it is missing, otherwise the data (split if needed)
+func (s *A2SServer) answer(endpoint string, challenge bool) [][]byte {
+	if !challenge && (endpoint != A2SInfo || s.infoChallenge) {
+		p := simpleA2SPacket(a2sChallengeReply)
+		p.Write(a2sChallenge[:])
+		return [][]byte{p.Bytes()}
+	}
+	switch endpoint {
+	case A2SInfo:
+		// go-a2s 不支持分包的 A2S_INFO, 与线上一致始终单包返回
+		// go-a2s cannot reassemble a split A2S_INFO, so it is always a single packet like in production
+		return [][]byte{encodeA2SInfo(s.info)}
+	case A2SPlayer:
+		return s.split(encodeA2SPlayers(s.players))
+	default:
+		return s.split(encodeA2SRules(s.rules))
+	}
+}
+
+// split 按 Source 引擎格式分包(需持有锁) | Split a packet in the Source engine format (lock held)
+func (s *A2SServer) split(payload []byte) [][]byte {
+	if len(payload) <= s.splitSize {
+		return [][]byte{payload}
+	}
+	s.packetID++
+	total := (len(payload) + s.splitSize - 1) / s.splitSize
+	packets := make([][]byte, 0, total)
+	for i := 0; i < total; i++ {
+		chunk := payload[i*s.splitSize : min((i+1)*s.splitSize, len(payload))]
+		var p bytes.Buffer
+		writeLE(&p, int32(a2sMultiHeader))
+		writeLE(&p, s.packetID)
+		p.WriteByte(byte(total))
+		p.WriteByte(byte(i))
+		writeLE(&p, uint16(s.splitSize))
+		p.Write(chunk)
+		packets = append(packets, p.Bytes())
+	}
+	return packets
+}
+
+// parseA2SRequest 解析请求包 | Parse a request packet
+//
+// 返回值:
+//   - endpoint: 查询名 | Query name
+//   - challenge: 是否携带有效挑战码 | Whether a valid challenge was included
+//   - ok: 是否为支持的请求 | Whether the request is supported
+func parseA2SRequest(data []byte) (endpoint string, challenge bool, ok bool) {
+	if len(data) < 5 || int32(binary.LittleEndian.Uint32(data)) != a2sSimpleHeader {
+		return "", false, false
+	}
+	body := data[5:]
+	switch data[4] {
+	case a2sInfoRequest:
+		query := append([]byte(a2sInfoQueryString), 0)
+		if !bytes.HasPrefix(body, query) {
+			return "", false, false
+		}
+		return A2SInfo, bytes.Equal(body[len(query):], a2sChallenge[:]), true
+	case a2sPlayerRequest:
+		return A2SPlayer, bytes.Equal(body, a2sChallenge[:]), true
+	case a2sRulesRequest:
+		return A2SRules, bytes.Equal(body, a2sChallenge[:]), true
+	}
+	return "", false, false
+}
+
+// encodeA2SInfo 编码 A2S_INFO 响应 | Encode an A2S_INFO answer
+func encodeA2SInfo(info a2s.ServerInfo) []byte {
+	p := simpleA2SPacket(a2sInfoResponse)
+	p.WriteByte(info.Protocol)
+	writeCString(p, info.Name)
+	writeCString(p, info.Map)
+	writeCString(p, info.Folder)
+	writeCString(p, info.Game)
+	writeLE(p, info.ID)
+	p.WriteByte(info.Players)
+	p.WriteByte(info.MaxPlayers)
+	p.WriteByte(info.Bots)
+	p.WriteByte(map[a2s.ServerType]byte{a2s.ServerType_Dedicated: 'd', a2s.ServerType_NonDedicated: 'l', a2s.ServerType_SourceTV: 'p'}[info.ServerType])
+	p.WriteByte(map[a2s.ServerOS]byte{a2s.ServerOS_Linux: 'l', a2s.ServerOS_Windows: 'w', a2s.ServerOS_Mac: 'm'}[info.ServerOS])
+	p.WriteByte(boolByte(info.Visibility))
+	p.WriteByte(boolByte(info.VAC))
+	writeCString(p, info.Version)
+
+	if info.EDF == 0 {
+		return p.Bytes()
+	}
+	ext := info.ExtendedServerInfo
+	if ext == nil {
+		ext = &a2s.ExtendedServerInfo{}
+	}
+	p.WriteByte(info.EDF)
+	if info.EDF&0x80 != 0 {
+		writeLE(p, ext.Port)
+	}
+	if info.EDF&0x10 != 0 {
+		writeLE(p, ext.SteamID)
+	}
+	if info.EDF&0x40 != 0 {
+		tv := info.SourceTV
+		if tv == nil {
+			tv = &a2s.SourceTVInfo{}
+		}
+		writeLE(p, tv.Port)
+		writeCString(p, tv.Name)
+	}
+	if info.EDF&0x20 != 0 {
+		writeCString(p, ext.Keywords)
+	}
+	if info.EDF&0x01 != 0 {
+		writeLE(p, ext.GameID)
+	}
+	return p.Bytes()
+}
+
+// encodeA2SPlayers 编码 A2S_PLAYER 响应 | Encode an A2S_PLAYER answer
+func encodeA2SPlayers(players a2s.PlayerInfo) []byte {
+	p := simpleA2SPacket(a2sPlayerResponse)
+	p.WriteByte(players.Count)
+	for _, pl := range players.Players {
+		p.WriteByte(pl.Index)
+		writeCString(p, pl.Name)
+		writeLE(p, pl.Score)
+		writeLE(p, math.Float32bits(pl.Duration))
+	}
+	return p.Bytes()
+}
+
+// encodeA2SRules 编码 A2S_RULES 响应(按规则名排序, 便于比对) | Encode an A2S_RULES answer (sorted by name for stable output)
+func encodeA2SRules(rules a2s.RulesInfo) []byte {
+	p := simpleA2SPacket(a2sRulesResponse)
+	writeLE(p, rules.Count)
+	names := make([]string, 0, len(rules.Rules))
+	for name := range rules.Rules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		writeCString(p, name)
+		writeCString(p, rules.Rules[name])
+	}
+	return p.Bytes()
+}
+
+// simpleA2SPacket 以单包头和类型字节开始的数据包 | Packet starting with the single packet header and a type byte
+func simpleA2SPacket(kind byte) *bytes.Buffer {
+	p := &bytes.Buffer{}
+	writeLE(p, int32(a2sSimpleHeader))
+	p.WriteByte(kind)
+	return p
+}
+
+// writeLE 小端写入定长数值 | Write a fixed-size value in little endian
+func writeLE(p *bytes.Buffer, v any) {
+	_ = binary.Write(p, binary.LittleEndian, v)
+}
+
+// writeCString 写入以 0 结尾的字符串 | Write a NUL-terminated string
+func writeCString(p *bytes.Buffer, s string) {
+	p.WriteString(s)
+	p.WriteByte(0)
+}
+
+// boolByte 布尔值编码为 0/1 | Encode a bool as 0/1
+func boolByte(b bool) byte {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// defaultA2SInfo 内置 A2S_INFO 样例 | Built-in A2S_INFO fixture
+func defaultA2SInfo() a2s.ServerInfo {
+	return a2s.ServerInfo{
+		Protocol:   17,
+		Name:       "steamtest | Dust II 24/7",
+		Map:        "de_dust2",
+		Folder:     "csgo",
+		Game:       "Counter-Strike 2",
+		ID:         730,
+		Players:    3,
+		MaxPlayers: 24,
+		Bots:       1,
+		ServerType: a2s.ServerType_Dedicated,
+		ServerOS:   a2s.ServerOS_Linux,
+		Visibility: false,
+		VAC:        true,
+		Version:    "1.40.2.3",
+		EDF:        0x80 | 0x10 | 0x20 | 0x01,
+		ExtendedServerInfo: &a2s.ExtendedServerInfo{
+			Port:     27015,
+			SteamID:  90200000000000001,
+			Keywords: "secure,competitive,valve_ds",
+			GameID:   730,
+		},
+	}
+}
+
+// defaultA2SPlayers 内置 A2S_PLAYER 样例 | Built-in A2S_PLAYER fixture
+func defaultA2SPlayers() a2s.PlayerInfo {
+	players := []*a2s.Player{
+		{Index: 0, Name: "GoFurry", Score: 27, Duration: 1834.5},
+		{Index: 0, Name: "s1mple", Score: 19, Duration: 1201.25},
+		{Index: 0, Name: "BOT Albert", Score: 4, Duration: 3600},
+	}
+	return a2s.PlayerInfo{Count: uint8(len(players)), Players: players}
+}
+
+// defaultA2SRules 内置 A2S_RULES 样例 | Built-in A2S_RULES fixture
+func defaultA2SRules() a2s.RulesInfo {
+	rules := map[string]string{
+		"mp_autoteambalance": "1",
+		"mp_friendlyfire":    "0",
+		"mp_maxrounds":       "24",
+		"mp_roundtime":       "1.92",
+		"mp_timelimit":       "0",
+		"sv_cheats":          "0",
+		"sv_gravity":         "800",
+		"sv_password":        "0",
+		"tv_enable":          "1",
+	}
+	return a2s.RulesInfo{Count: uint16(len(rules)), Rules: rules}
+}
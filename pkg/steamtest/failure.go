@@ -0,0 +1,106 @@
+package steamtest
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Steam 错误响应体(与线上返回的 HTML 一致) | Steam error bodies (same HTML as production)
+const (
+	notFoundBody         = "<html><head><title>Not Found</title></head><body><h1>Not Found</h1></body></html>"
+	methodNotAllowedBody = "<html><head><title>Method Not Allowed</title></head><body><h1>Method Not Allowed</h1>This API must be called with a HTTP %s request</body></html>"
+	unauthorizedBody     = "<html><head><title>Unauthorized</title></head><body><h1>Unauthorized</h1>Access is denied. Retrying will not help. Please verify your <pre>key=</pre> parameter.</body></html>"
+	forbiddenBody        = "<html><head><title>Forbidden</title></head><body><h1>Forbidden</h1>Access is denied. Retrying will not help. Please verify your <pre>key=</pre> parameter.</body></html>"
+	tooManyRequestsBody  = "<html><head><title>Too Many Requests</title></head><body><h1>Too Many Requests</h1></body></html>"
+	serverErrorBody      = "<html><head><title>Internal Server Error</title></head><body><h1>Internal Server Error</h1></body></html>"
+)
+
+// privateResponse 资料未公开时接口的响应 | Response of an endpoint for a private profile
+type privateResponse struct {
+	status int    // 状态码 | Status code
+	body   string // 响应体 | Response body
+	html   bool   // 是否为 HTML 错误页 | Whether the body is an HTML error page
+}
+
+// privateResponses 资料未公开时各接口的响应(与 Steam 一致), 未列出的接口以 200 返回 {"response":{}}
+// privateResponses holds each endpoint's response for a private profile (same as Steam); unlisted endpoints answer 200 {"response":{}}
+var privateResponses = map[string]privateResponse{
+	// 好友列表以 401 HTML 页面拒绝 | The friend list is refused with a 401 HTML page
+	"ISteamUser/GetFriendList": {status: http.StatusUnauthorized, body: unauthorizedBody, html: true},
+	// 仍返回玩家, 但 communityvisibilitystate 不为 3 且只有公开字段 | The player is still returned, with communityvisibilitystate != 3 and public fields only
+	"ISteamUser/GetPlayerSummaries": {status: http.StatusOK, body: `{"response":{"players":[{"steamid":"` + SteamID + `","communityvisibilitystate":1,"profilestate":1,` +
+		`"personaname":"Robin","profileurl":"https://steamcommunity.com/id/robinwalker/",` +
+		`"avatar":"https://avatars.steamstatic.com/81b5478529dce13bf24b55ac42c1af7058aaf7a9.jpg",` +
+		`"avatarmedium":"https://avatars.steamstatic.com/81b5478529dce13bf24b55ac42c1af7058aaf7a9_medium.jpg",` +
+		`"avatarfull":"https://avatars.steamstatic.com/81b5478529dce13bf24b55ac42c1af7058aaf7a9_full.jpg",` +
+		`"avatarhash":"81b5478529dce13bf24b55ac42c1af7058aaf7a9","personastate":0,"personastateflags":0}]}}`},
+	"ISteamUser/GetUserGroupList": {status: http.StatusOK, body: `{"response":{"success":false}}`},
+	// 以 403 和 JSON 响应体拒绝(不是 Key 无效的 HTML 页面) | Refused with a 403 and a JSON body (not the bad-key HTML page)
+	"ISteamUserStats/GetPlayerAchievements": {status: http.StatusForbidden, body: `{"playerstats":{"error":"Profile is not public","success":false}}`},
+}
+
+// Failure 编排的失败响应
+// Failure is a scripted failure response
+type Failure struct {
+	Status  int         // 状态码 | Status code
+	Header  http.Header // 额外响应头(含 Content-Type 时覆盖自动识别) | Extra response headers (a Content-Type overrides detection)
+	Body    []byte      // 响应体(合法 JSON 按 JSON 返回, 否则按 HTML) | Response body (served as JSON when valid JSON, otherwise as HTML)
+	private bool        // 按接口返回资料未公开的响应体 | Answer with the endpoint's private-profile body
+}
+
+// RateLimited 429 限流响应
+// 参数:
+//   - retryAfter: Retry-After 响应头(向上取整到秒, <=0 不发送) | Retry-After header (rounded up to seconds, omitted if <=0)
+//
+// 返回值:
+//   - Failure: 失败响应 | Failure response
+func RateLimited(retryAfter time.Duration) Failure {
+	header := http.Header{}
+	if retryAfter > 0 {
+		header.Set("Retry-After", strconv.FormatInt(int64((retryAfter+time.Second-1)/time.Second), 10))
+	}
+	return Failure{Status: http.StatusTooManyRequests, Header: header, Body: []byte(tooManyRequestsBody)}
+}
+
+// ServerError 500 服务器错误响应
+// 返回值:
+//   - Failure: 失败响应 | Failure response
+func ServerError() Failure {
+	return Failure{Status: http.StatusInternalServerError, Body: []byte(serverErrorBody)}
+}
+
+// Forbidden 403 响应(Key 无效或被封禁)
+// 返回值:
+//   - Failure: 失败响应 | Failure response
+func Forbidden() Failure {
+	return Failure{Status: http.StatusForbidden, Body: []byte(forbiddenBody)}
+}
+
+// PrivateProfile 资料未公开
+// 状态码与响应体按接口与 Steam 保持一致: 多数接口以 200 返回 {"response":{}}, GetPlayerSummaries 返回 communityvisibilitystate 为 1 的玩家,
+// GetPlayerAchievements 返回 403 与 JSON 错误, GetFriendList 返回 401 Unauthorized 页面
+// PrivateProfile answers like Steam does for a private profile, with the status and body of each endpoint:
+// most endpoints answer 200 {"response":{}}, GetPlayerSummaries returns the player with communityvisibilitystate 1,
+// GetPlayerAchievements answers 403 with a JSON error and GetFriendList answers a 401 Unauthorized page
+//
+// 返回值:
+//   - Failure: 失败响应 | Failure response
+func PrivateProfile() Failure {
+	return Failure{Status: http.StatusOK, private: true}
+}
+
+// response 获取失败在接口上的状态码、响应体及 Content-Type | Status, body and Content-Type of the failure for the endpoint
+func (f Failure) response(endpoint string) (status int, body []byte, contentType string) {
+	if f.private {
+		if r, ok := privateResponses[endpoint]; ok {
+			return r.status, []byte(r.body), contentTypeOf(r.html)
+		}
+		return http.StatusOK, []byte(`{"response":{}}`), jsonContentType
+	}
+	if ct := f.Header.Get("Content-Type"); ct != "" {
+		return f.Status, f.Body, ct
+	}
+	return f.Status, f.Body, contentTypeOf(!json.Valid(f.Body))
+}
@@ -0,0 +1,43 @@
+package steamtest_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/GoFurry/gf-steam-sdk/pkg/steamtest"
+)
+
+func TestFailureContentType(t *testing.T) {
+	const endpoint = "IPlayerService/GetOwnedGames"
+	tests := []struct {
+		name    string
+		failure steamtest.Failure
+		want    string
+	}{
+		{"html page", steamtest.ServerError(), "text/html; charset=UTF-8"},
+		{"json body", steamtest.Failure{Status: http.StatusOK, Body: []byte(`{"response":{}}`)}, "application/json; charset=UTF-8"},
+		{"plain text", steamtest.Failure{Status: http.StatusBadGateway, Body: []byte("bad gateway")}, "text/html; charset=UTF-8"},
+		{"explicit header", steamtest.Failure{
+			Status: http.StatusOK,
+			Header: http.Header{"Content-Type": {"text/plain"}},
+			Body:   []byte(`{"response":{}}`),
+		}, "text/plain"},
+		{"private profile", steamtest.PrivateProfile(), "application/json; charset=UTF-8"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := steamtest.NewServer()
+			defer srv.Close()
+			srv.Fail(endpoint, tt.failure, 1)
+
+			resp, err := http.Get(srv.URL + "/" + endpoint + "/v1/?key=" + steamtest.APIKey)
+			if err != nil {
+				t.Fatalf("get: %v", err)
+			}
+			resp.Body.Close()
+			if got := resp.Header.Get("Content-Type"); got != tt.want {
+				t.Fatalf("want Content-Type %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package steamtest
+
+import (
+	"embed"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// 样例数据中的默认 ID, 可直接作为调用参数 | IDs used across the fixtures, handy as call arguments
+const (
+	SteamID       = "76561197960435530" // 样例玩家 SteamID | Fixture player SteamID
+	FriendSteamID = "76561198000000001" // 样例家庭组成员 SteamID | Fixture family member SteamID
+	FamilyGroupID = "1136785"           // 样例家庭组 ID | Fixture family group ID
+	AppID         = 620                 // 样例成就所属游戏(Portal 2) | Fixture achievements app (Portal 2)
+)
+
+// 模拟服务器接受的默认凭证 | Credentials configured by Server.Config
+const (
+	APIKey      = "STEAMTEST00000000000000000000000" // 测试用 API Key | Test API key
+	AccessToken = "steamtest-access-token"           // 测试用 Access Token | Test access token
+)
+
+//go:embed fixtures/*.json
+var fixtureFS embed.FS
+
+// route 模拟接口定义
+// route describes one emulated endpoint
+type route struct {
+	method  string // HTTP 方法 | HTTP method
+	version string // 接口版本 | Endpoint version
+	auth    bool   // 是否需要 key 或 access_token | Whether key or access_token is required
+}
+
+// routes 模拟的接口("Interface/Method" -> 定义), 覆盖 DevService 与 StoreService 封装的全部接口(商店接口无版本)
+// routes lists the emulated endpoints ("Interface/Method" -> route), covering everything DevService and StoreService wrap (store endpoints have no version)
+var routes = map[string]route{
+	"api/appdetails":                 {http.MethodGet, "", false},
+	"api/packagedetails":             {http.MethodGet, "", false},
+	"api/featured":                   {http.MethodGet, "", false},
+	"api/featuredcategories":         {http.MethodGet, "", false},
+	"api/storesearch":                {http.MethodGet, "", false},
+	"appreviews":                     {http.MethodGet, "", false},
+	"search/results":                 {http.MethodGet, "", false},
+	"search/suggest":                 {http.MethodGet, "", false},
+	"IAccountCartService/GetCart":    {http.MethodGet, "v1", true},
+	"IAccountCartService/DeleteCart": {http.MethodPost, "v1", true},
+	"IBillingService/GetRecurringSubscriptionsCount":        {http.MethodGet, "v1", true},
+	"ICommunityService/GetApps":                             {http.MethodGet, "v1", false},
+	"IFamilyGroupsService/GetChangeLog":                     {http.MethodGet, "v1", true},
+	"IFamilyGroupsService/GetFamilyGroup":                   {http.MethodGet, "v1", true},
+	"IFamilyGroupsService/GetFamilyGroupForUser":            {http.MethodGet, "v1", true},
+	"IFamilyGroupsService/GetPlaytimeSummary":               {http.MethodPost, "v1", true},
+	"IFamilyGroupsService/GetSharedLibraryApps":             {http.MethodGet, "v1", true},
+	"ILoyaltyRewardsService/GetEquippedProfileItems":        {http.MethodGet, "v1", false},
+	"ILoyaltyRewardsService/GetReactionsSummaryForUser":     {http.MethodGet, "v1", false},
+	"ILoyaltyRewardsService/GetSummary":                     {http.MethodGet, "v1", false},
+	"IPlayerService/GetBadges":                              {http.MethodGet, "v1", true},
+	"IPlayerService/GetCommunityBadgeProgress":              {http.MethodGet, "v1", true},
+	"IPlayerService/GetOwnedGames":                          {http.MethodGet, "v1", true},
+	"IPlayerService/GetRecentlyPlayedGames":                 {http.MethodGet, "v1", true},
+	"IPlayerService/GetSteamLevel":                          {http.MethodGet, "v1", true},
+	"ISteamApps/GetAppList":                                 {http.MethodGet, "v2", false},
+	"ISteamApps/GetServersAtAddress":                        {http.MethodGet, "v1", false},
+	"ISteamApps/UpToDateCheck":                              {http.MethodGet, "v1", false},
+	"ISteamNews/GetNewsForApp":                              {http.MethodGet, "v2", false},
+	"ISteamUser/GetFriendList":                              {http.MethodGet, "v1", true},
+	"ISteamUser/GetPlayerBans":                              {http.MethodGet, "v1", true},
+	"ISteamUser/GetPlayerSummaries":                         {http.MethodGet, "v2", true},
+	"ISteamUser/GetUserGroupList":                           {http.MethodGet, "v1", true},
+	"ISteamUser/ResolveVanityURL":                           {http.MethodGet, "v1", true},
+	"ISteamUserStats/GetGlobalAchievementPercentagesForApp": {http.MethodGet, "v2", false},
+	"ISteamUserStats/GetNumberOfCurrentPlayers":             {http.MethodGet, "v1", false},
+	"ISteamUserStats/GetPlayerAchievements":                 {http.MethodGet, "v1", true},
+	"ISteamUserStats/GetSchemaForGame":                      {http.MethodGet, "v2", true},
+	"ISteamUserStats/GetUserStatsForGame":                   {http.MethodGet, "v2", true},
+	"IStoreBrowseService/GetItems":                          {http.MethodGet, "v1", false},
+	"IStoreBrowseService/GetDLCForApps":                     {http.MethodGet, "v1", false},
+	"IStoreService/GetAppList":                              {http.MethodGet, "v1", true},
+	"IWishlistService/GetWishlist":                          {http.MethodGet, "v1", false},
+	"IWishlistService/GetWishlistItemCount":                 {http.MethodGet, "v1", false},
+	"IWishlistService/GetWishlistSortedFiltered":            {http.MethodGet, "v1", false},
+}
+
+// defaultFixtures 内置响应样例(接口名 -> 响应体) | Built-in fixtures (endpoint -> body)
+var defaultFixtures = loadFixtures()
+
+// loadFixtures 读取内置样例, 文件名为 "<Interface>_<Method>.json"
+// loadFixtures reads the embedded fixtures named "<Interface>_<Method>.json"
+func loadFixtures() map[string][]byte {
+	fixtures := make(map[string][]byte, len(routes))
+	for endpoint := range routes {
+		body, err := fixtureFS.ReadFile("fixtures/" + strings.Replace(endpoint, "/", "_", 1) + ".json")
+		if err != nil {
+			panic("steamtest: missing fixture for " + endpoint)
+		}
+		fixtures[endpoint] = body
+	}
+	return fixtures
+}
+
+// Endpoints 获取模拟的全部接口名(已排序)
+// 返回值:
+//   - []string: 接口名列表, 格式为 "Interface/Method" | Endpoint names in "Interface/Method" form
+func Endpoints() []string {
+	out := make([]string, 0, len(routes))
+	for endpoint := range routes {
+		out = append(out, endpoint)
+	}
+	sort.Strings(out)
+	return out
+}
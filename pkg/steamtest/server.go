@@ -0,0 +1,295 @@
+// Package steamtest 提供进程内的 Steam Web API 模拟服务器, 用于 CI 等无法访问 Steam 的环境
+// 模拟 DevService 封装的全部接口并内置真实结构的响应样例, 支持按接口编排 429/500/资料未公开等失败,
+// 并可直接创建请求发往模拟服务器的 SDK; A2SServer 在本地 UDP 端口模拟 A2S 游戏服务器, 供 ServerService 离线测试
+// Package steamtest provides an in-process fake Steam Web API server for environments that cannot reach Steam (CI, ...)
+// It emulates every endpoint DevService wraps with realistic fixtures, scripts failures (429, 500, private profile, ...)
+// per endpoint and builds SDKs whose requests go to the fake server; A2SServer emulates an A2S game server on a local
+// UDP port so ServerService can be tested offline
+//
+//	func TestOwnedGames(t *testing.T) {
+//		sdk, srv := steamtest.NewSDK(t)
+//		srv.Fail("IPlayerService/GetOwnedGames", steamtest.RateLimited(0), 1)
+//		games, err := sdk.Develop.GetOwnedGames(steamtest.SteamID, false)
+//		...
+//	}
+package steamtest
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/GoFurry/gf-steam-sdk/pkg/config"
+	"github.com/GoFurry/gf-steam-sdk/pkg/steam"
+)
+
+// Request 模拟服务器收到的请求
+// Request is a request received by the fake server
+type Request struct {
+	Method   string      // HTTP 方法 | HTTP method
+	Endpoint string      // 接口名 "Interface/Method" | Endpoint "Interface/Method"
+	Path     string      // 请求路径 | Request path
+	Query    url.Values  // 查询参数(含 key/access_token) | Query params (key/access_token included)
+	Header   http.Header // 请求头 | Request headers
+}
+
+// scripted 编排中的失败及剩余次数(<0 表示一直失败)
+// scripted is a queued failure and how many more requests it answers (<0 means forever)
+type scripted struct {
+	failure   Failure
+	remaining int
+}
+
+// Server Steam Web API 模拟服务器
+// 按 "Interface/Method" 返回样例数据, 并校验 HTTP 方法、接口版本和凭证; 可并发使用
+// Server is a fake Steam Web API server
+// It answers fixtures by "Interface/Method" and checks the HTTP method, endpoint version and credentials; safe for concurrent use
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	fixtures map[string][]byte     // 接口名 -> 响应体 | Endpoint -> body
+	failures map[string][]scripted // 接口名 -> 失败队列 | Endpoint -> failure queue
+	requests []Request             // 已收到的请求 | Requests received so far
+}
+
+// NewServer 启动模拟服务器, 使用完毕需调用 Close
+// 返回值:
+//   - *Server: 模拟服务器 | Fake server
+func NewServer() *Server {
+	s := &Server{}
+	s.Reset()
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// NewSDK 启动模拟服务器并创建连接到它的 SDK, 测试结束时自动关闭
+// 参数:
+//   - tb: 测试实例 | Test instance
+//
+// 返回值:
+//   - *steam.SteamSDK: 请求发往模拟服务器的 SDK | SDK whose requests go to the fake server
+//   - *Server: 模拟服务器 | Fake server
+func NewSDK(tb testing.TB) (*steam.SteamSDK, *Server) {
+	tb.Helper()
+	s := NewServer()
+	tb.Cleanup(s.Close)
+	sdk, err := s.NewSDK(nil)
+	if err != nil {
+		tb.Fatalf("steamtest: create sdk failed: %v", err)
+	}
+	tb.Cleanup(func() { _ = sdk.Close() })
+	return sdk, s
+}
+
+// Config 创建连接到模拟服务器的配置
+// 使用测试凭证 APIKey/AccessToken, 不走代理, 放宽限流, 退避缩短到毫秒级, 关闭熔断
+// Config builds a config wired to the fake server
+// It uses the APIKey/AccessToken test credentials, no proxy, a relaxed rate limit, millisecond backoff and no circuit breaker
+//
+// 返回值:
+//   - *config.SteamConfig: 配置实例(可继续链式修改) | Config instance (chain calls supported)
+func (s *Server) Config() *config.SteamConfig {
+	policy := config.NewDefaultRetryPolicy()
+	policy.Backoff = config.ExponentialBackoff(time.Millisecond, 10*time.Millisecond)
+	return config.NewDefaultConfig().
+		WithAPIKey(APIKey).
+		WithAPIKeyPool(nil, "").
+		WithAccessToken(AccessToken).
+		WithProxyURL("").
+		WithProxyPool(nil).
+		WithRateLimit(1000, 1000).
+		WithRetryPolicy(policy).
+		WithCircuitBreaker(0, 0).
+		WithEndpoints(s.Endpoints())
+}
+
+// NewSDK 创建连接到模拟服务器的 SDK
+// 参数:
+//   - cfg: 配置(nil 则使用 Config(); 否则将其 API 地址指向模拟服务器) | Config (Config() if nil, otherwise its API endpoint is pointed at the fake server)
+//
+// 返回值:
+//   - *steam.SteamSDK: SDK 实例 | SDK instance
+//   - error: 创建失败时返回错误 | Error if creation fails
+func (s *Server) NewSDK(cfg *config.SteamConfig) (*steam.SteamSDK, error) {
+	if cfg == nil {
+		cfg = s.Config()
+	} else {
+		cfg.WithEndpoints(s.Endpoints())
+	}
+	return steam.NewSteamSDK(cfg)
+}
+
+// Endpoints 指向模拟服务器的基础地址(API 与商店) | Base URLs pointing at the fake server (API and store)
+func (s *Server) Endpoints() config.Endpoints {
+	return config.Endpoints{API: s.URL + "/", Store: s.URL + "/"}
+}
+
+// SetFixture 替换接口的响应体
+// 参数:
+//   - endpoint: 接口名 "Interface/Method" | Endpoint "Interface/Method"
+//   - body: 响应体 | Response body
+func (s *Server) SetFixture(endpoint string, body []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fixtures[endpoint] = append([]byte(nil), body...)
+}
+
+// Fixture 获取接口当前的响应体
+// 参数:
+//   - endpoint: 接口名 "Interface/Method" | Endpoint "Interface/Method"
+//
+// 返回值:
+//   - []byte: 响应体副本(未知接口为 nil) | Copy of the body (nil for unknown endpoints)
+func (s *Server) Fixture(endpoint string) []byte {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	body, ok := s.fixtures[endpoint]
+	if !ok {
+		return nil
+	}
+	return append([]byte(nil), body...)
+}
+
+// Fail 为接口编排失败, 多次调用按顺序排队, 失败用尽后恢复返回样例
+// 参数:
+//   - endpoint: 接口名 "Interface/Method" | Endpoint "Interface/Method"
+//   - f: 失败响应 | Failure response
+//   - times: 连续失败次数(<=0 表示一直失败, 直到 Reset) | Consecutive failures (<=0 fails until Reset)
+func (s *Server) Fail(endpoint string, f Failure, times int) {
+	if times <= 0 {
+		times = -1
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures[endpoint] = append(s.failures[endpoint], scripted{failure: f, remaining: times})
+}
+
+// Requests 获取已收到的请求 | Requests received so far
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// Reset 恢复内置样例, 清空编排的失败和请求记录
+// Reset restores the built-in fixtures and clears scripted failures and recorded requests
+func (s *Server) Reset() {
+	fixtures := make(map[string][]byte, len(defaultFixtures))
+	for endpoint, body := range defaultFixtures {
+		fixtures[endpoint] = body
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fixtures = fixtures
+	s.failures = map[string][]scripted{}
+	s.requests = nil
+}
+
+// serveHTTP 处理模拟请求 | Serve a fake request
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	endpoint, version := splitPath(r.URL.Path)
+	query := r.URL.Query()
+
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{
+		Method:   r.Method,
+		Endpoint: endpoint,
+		Path:     r.URL.Path,
+		Query:    query,
+		Header:   r.Header.Clone(),
+	})
+	rt, known := routes[endpoint]
+	var failure *Failure
+	if known {
+		failure = s.nextFailure(endpoint)
+	}
+	body := s.fixtures[endpoint]
+	s.mu.Unlock()
+
+	switch {
+	case !known || rt.version != version:
+		writeHTML(w, http.StatusNotFound, notFoundBody)
+	case failure != nil:
+		for k, v := range failure.Header {
+			w.Header()[k] = v
+		}
+		status, body, contentType := failure.response(endpoint)
+		writeBody(w, status, contentType, body)
+	case r.Method != rt.method:
+		writeHTML(w, http.StatusMethodNotAllowed, fmt.Sprintf(methodNotAllowedBody, rt.method))
+	case rt.auth && query.Get("key") == "" && query.Get("access_token") == "":
+		writeHTML(w, http.StatusUnauthorized, unauthorizedBody)
+	default:
+		writeJSON(w, http.StatusOK, body)
+	}
+}
+
+// nextFailure 取出接口的下一个失败(需持有锁) | Pop the next failure of the endpoint (lock held)
+func (s *Server) nextFailure(endpoint string) *Failure {
+	queue := s.failures[endpoint]
+	if len(queue) == 0 {
+		return nil
+	}
+	f := queue[0].failure
+	if queue[0].remaining > 0 {
+		queue[0].remaining--
+		if queue[0].remaining == 0 {
+			queue = queue[1:]
+		}
+	}
+	s.failures[endpoint] = queue
+	return &f
+}
+
+// splitPath 将 "/Interface/Method/v1/" 拆分为接口名和版本, 商店路径中的数字 ID(如 /appreviews/620)不计入接口名
+// splitPath splits "/Interface/Method/v1/" into the endpoint name and version; a numeric ID in a store path (e.g. /appreviews/620) is dropped
+func splitPath(path string) (endpoint, version string) {
+	segs := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segs) == 2 {
+		if _, err := strconv.ParseUint(segs[1], 10, 64); err == nil {
+			return segs[0], ""
+		}
+	}
+	if len(segs) != 3 {
+		return strings.Trim(path, "/"), ""
+	}
+	return segs[0] + "/" + segs[1], segs[2]
+}
+
+// 响应 Content-Type | Response Content-Types
+const (
+	jsonContentType = "application/json; charset=UTF-8"
+	htmlContentType = "text/html; charset=UTF-8"
+)
+
+// contentTypeOf 按是否为 HTML 选择 Content-Type | Pick the Content-Type by whether the body is HTML
+func contentTypeOf(html bool) string {
+	if html {
+		return htmlContentType
+	}
+	return jsonContentType
+}
+
+// writeJSON 输出 JSON 响应 | Write a JSON response
+func writeJSON(w http.ResponseWriter, status int, body []byte) {
+	writeBody(w, status, jsonContentType, body)
+}
+
+// writeHTML 输出 HTML 错误响应 | Write an HTML error response
+func writeHTML(w http.ResponseWriter, status int, body string) {
+	writeBody(w, status, htmlContentType, []byte(body))
+}
+
+// writeBody 按 Content-Type 输出响应 | Write a response with the given Content-Type
+func writeBody(w http.ResponseWriter, status int, contentType string, body []byte) {
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(status)
+	_, _ = w.Write(body)
+}
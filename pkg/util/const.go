@@ -23,12 +23,18 @@ const (
 
 // Steam API 常量 | Steam API constants
 const (
-	STEAM_STORE_BASE_URL                 = "https://store.steampowered.com/"
-	STEAM_API_BASE_URL                   = "https://api.steampowered.com/"                                              // Steam API基础地址 | Steam API base URL
-	STEAM_ICON_URL                       = "https://media.steampowered.com/steamcommunity/public/images/apps/%d/%s.jpg" // 游戏图标URL模板 | Game icon URL template
-	STEAM_CAPSULE_URL                    = "https://cdn.akamai.steamstatic.com/steam/apps/%d/header.jpg"                // 游戏封面URL模板 | Game capsule URL template
-	STEAM_COMMUNITY_ASSETS_IMAGES_URL    = "https://shared.fastly.steamstatic.com/community_assets/images/items/"
-	STEAM_LOYALTY_REACTION_ICON_BASE_URL = "https://store.fastly.steamstatic.com/public/images/loyalty/reactions/still/"
+	STEAM_STORE_BASE_URL                 = "https://store.steampowered.com/"                                    // Steam 商店基础地址 | Steam store base URL
+	STEAM_API_BASE_URL                   = "https://api.steampowered.com/"                                      // Steam API基础地址 | Steam API base URL
+	STEAM_COMMUNITY_BASE_URL             = "https://steamcommunity.com/"                                        // Steam 社区基础地址 | Steam community base URL
+	STEAM_CDN_BASE_URL                   = "https://cdn.akamai.steamstatic.com/"                                // 游戏封面 CDN | Game capsule CDN
+	STEAM_MEDIA_BASE_URL                 = "https://media.steampowered.com/"                                    // 游戏图标地址 | Game icon host
+	STEAM_SHARED_CDN_BASE_URL            = "https://shared.fastly.steamstatic.com/"                             // 社区道具素材 CDN | Community item asset CDN
+	STEAM_STORE_CDN_BASE_URL             = "https://store.fastly.steamstatic.com/"                              // 商店静态资源 CDN | Store static asset CDN
+	STEAM_ICON_URL                       = STEAM_MEDIA_BASE_URL + "steamcommunity/public/images/apps/%d/%s.jpg" // 游戏图标URL模板 | Game icon URL template
+	STEAM_CAPSULE_URL                    = STEAM_CDN_BASE_URL + "steam/apps/%d/header.jpg"                      // 游戏封面URL模板 | Game capsule URL template
+	STEAM_COMMUNITY_ASSETS_IMAGES_URL    = STEAM_SHARED_CDN_BASE_URL + "community_assets/images/items/"         // 社区道具素材地址 | Community item asset URL
+	STEAM_LOYALTY_REACTION_ICON_BASE_URL = STEAM_STORE_CDN_BASE_URL + "public/images/loyalty/reactions/still/"  // 互动图标地址 | Reaction icon URL
+	STEAM_GROUP_ID_BASE                  = uint64(103582791429521408)                                           // 群组 64 位 SteamID 基数 | Base of 64-bit group SteamIDs
 )
 
 // 基础默认配置 | Basic default config
@@ -40,6 +46,68 @@ const (
 	RETRY_SLEEP_BASE    = 300             // 重试基础延迟(毫秒) | Retry base delay (milliseconds)
 )
 
+// 重试与熔断默认配置 | Retry and circuit breaker default config
+const (
+	DEFAULT_RETRY_MAX_BACKOFF = 10 * time.Second // 单次退避上限 | Max delay of a single backoff
+	DEFAULT_RETRY_MAX_ELAPSED = 30 * time.Second // 重试总耗时上限 | Max total time spent retrying
+	DEFAULT_BREAKER_THRESHOLD = 5                // 连续失败多少次后熔断 | Consecutive failures that open the circuit
+	DEFAULT_BREAKER_COOLDOWN  = 30 * time.Second // 熔断后多久允许探测请求 | Time before a half-open probe is allowed
+	RETRY_ERROR_CLASS_TIMEOUT = "timeout"        // 超时类错误 | Timeout errors
+	RETRY_ERROR_CLASS_NETWORK = "network"        // 连接类错误(拒绝/重置/DNS/EOF) | Connection errors (refused/reset/DNS/EOF)
+)
+
+// API Key 池默认配置 | API key pool default config
+const (
+	DEFAULT_KEY_STRATEGY    = "round_robin"    // 默认 Key 选择策略 | Default key selection strategy
+	DEFAULT_KEY_DAILY_LIMIT = 100000           // Steam 单 Key 每日调用上限 | Steam daily call limit per key
+	DEFAULT_KEY_QUARANTINE  = 10 * time.Minute // Key 被拒绝(429/Key 无效的 403)后的隔离时长 | Quarantine after a key is rejected (429/bad-key 403)
+)
+
+// 缓存默认配置 | Cache default config
+const (
+	CACHE_BACKEND_MEMORY      = "memory"          // 内存 LRU 缓存 | In-memory LRU cache
+	CACHE_BACKEND_DISK        = "disk"            // 磁盘缓存 | On-disk cache
+	DEFAULT_CACHE_TTL         = 5 * time.Minute   // 默认缓存 TTL | Default cache TTL
+	DEFAULT_CACHE_MAX_ENTRIES = 1000              // 默认内存缓存条目上限 | Default in-memory cache capacity
+	DEFAULT_CACHE_DIR         = "./storage/cache" // 默认磁盘缓存目录 | Default disk cache dir
+)
+
+// 录制/回放默认配置 | Record/replay default config
+const (
+	VCR_MODE_OFF    = "off"                  // 不启用 | Disabled
+	VCR_MODE_RECORD = "record"               // 录制真实流量到磁带 | Record real traffic into the cassette
+	VCR_MODE_REPLAY = "replay"               // 从磁带回放, 未录制的请求失败 | Replay from the cassette, unmatched requests fail
+	DEFAULT_VCR_DIR = "./testdata/cassettes" // 默认磁带目录 | Default cassette directory
+)
+
+// 新闻默认配置 | News default config
+const (
+	NEWS_FEED_TYPE_BBCODE    = 1   // 社区公告, 正文为 BBCode | Community announcement, contents are BBCode
+	NEWS_EXCERPT_LENGTH      = 200 // 纯文本摘要字符数 | Plain-text excerpt length in runes
+	NEWS_BBCODE_NESTED_DEPTH = 3   // BBCode 嵌套解析层数 | BBCode nesting depth to parse
+)
+
+// 商店默认配置 | Store default config
+const (
+	STORE_PRICE_SCALE        = 100              // 商店价格以 1/100 货币单位表示 | Store prices are in 1/100 of the currency unit
+	STORE_PRICE_FILTER       = "price_overview" // 仅返回价格的 filters | filters value returning only the price
+	STORE_REGION_CONCURRENCY = 4                // 多地区价格查询并发数 | Concurrency of multi-region price lookups
+	REVIEW_FIRST_CURSOR      = "*"              // 评测首页游标 | Cursor of the first review page
+	REVIEW_PAGE_SIZE_MAX     = 100              // 评测每页最大条数 | Max reviews per page
+	REVIEW_FILTER_RECENT     = "recent"         // 按创建时间倒序的评测 filter | Review filter ordering by creation time, newest first
+	SEARCH_PAGE_SIZE         = 50               // 搜索结果默认每页条数 | Default search results page size
+	STORE_BROWSE_BATCH_SIZE  = 100              // IStoreBrowseService 每次请求的应用数上限 | Max apps per IStoreBrowseService request
+	STORE_BROWSE_TAG_COUNT   = 20               // 按权重返回的标签数 | Number of weighted tags returned
+)
+
+// 愿望单监视默认配置 | Wishlist watcher default config
+const (
+	WISHLIST_WATCH_INTERVAL    = 6 * time.Hour                // 默认轮询间隔 | Default poll interval
+	WISHLIST_WATCH_CONCURRENCY = 4                            // 应用价格查询并发数 | Concurrency of app price lookups
+	WISHLIST_WATCH_BUFFER      = 64                           // 事件通道缓冲 | Event channel buffer
+	WISHLIST_STATE_DIR         = "./storage/watcher/wishlist" // 默认状态文件目录 | Default state file directory
+)
+
 // 爬虫默认配置 | Crawler default config
 const (
 	CRAWLER_MAX_DEPTH   = 1                        // 默认爬虫深度 | Default crawler depth
@@ -29,10 +29,11 @@ const (
 // SteamError is the custom error structure
 // Encapsulates error type, error code, description and original error, supports standard library error handling methods
 type SteamError struct {
-	Type    ErrType // 错误类型 | Error type
-	Code    int     // 错误码 | Error code
-	Message string  // 错误描述 | Error description
-	Err     error   // 原始错误 | Original error
+	Type     ErrType // 错误类型 | Error type
+	Code     int     // 错误码 | Error code
+	Message  string  // 错误描述 | Error description
+	Err      error   // 原始错误 | Original error
+	Endpoint string  // 出错的接口(如 IPlayerService/GetOwnedGames) | Endpoint that failed (e.g. IPlayerService/GetOwnedGames)
 }
 
 // Error 实现 error 接口
@@ -40,10 +41,14 @@ type SteamError struct {
 // 返回值:
 //   - string: 错误字符串 | Error string
 func (e *SteamError) Error() string {
+	msg := e.Message
+	if e.Endpoint != "" {
+		msg = e.Endpoint + ": " + msg
+	}
 	if e.Err == nil {
-		return fmt.Sprintf("[%s] %s", e.Type, e.Message)
+		return fmt.Sprintf("[%s] %s", e.Type, msg)
 	}
-	return fmt.Sprintf("[%s] %s: %v", e.Type, e.Message, e.Err)
+	return fmt.Sprintf("[%s] %s: %v", e.Type, msg, e.Err)
 }
 
 // Unwrap 支持标准库 errors.Unwrap
@@ -119,6 +124,49 @@ var (
 		Err:     errors.New("http request failed"),
 	}
 
+	// 以下请求错误均包装 ErrRequestFailed, errors.Is(err, ErrRequestFailed) 仍然成立
+	// The request errors below all wrap ErrRequestFailed, so errors.Is(err, ErrRequestFailed) still holds
+
+	// ErrCircuitOpen 目标主机熔断中, 请求未发出
+	ErrCircuitOpen = &SteamError{
+		Type:    ErrTypeRequest,
+		Code:    30003,
+		Message: "circuit breaker open, host is degraded",
+		Err:     ErrRequestFailed,
+	}
+
+	// ErrUnauthorized API Key/Access Token 无效或无权限(401/403)
+	ErrUnauthorized = &SteamError{
+		Type:    ErrTypeRequest,
+		Code:    30004,
+		Message: "unauthorized, invalid api key or access token (401/403)",
+		Err:     ErrRequestFailed,
+	}
+
+	// ErrBadRequest 请求参数错误(400)
+	ErrBadRequest = &SteamError{
+		Type:    ErrTypeRequest,
+		Code:    30005,
+		Message: "bad request, invalid request params (400)",
+		Err:     ErrRequestFailed,
+	}
+
+	// ErrServerError Steam 服务端错误(5xx)
+	ErrServerError = &SteamError{
+		Type:    ErrTypeRequest,
+		Code:    30006,
+		Message: "steam server error (5xx)",
+		Err:     ErrRequestFailed,
+	}
+
+	// ErrTimeout 请求超时
+	ErrTimeout = &SteamError{
+		Type:    ErrTypeRequest,
+		Code:    30007,
+		Message: "steam api request timeout",
+		Err:     ErrRequestFailed,
+	}
+
 	// ErrAchievementFailed 成就查询失败
 	ErrAchievementFailed = &SteamError{
 		Type:    ErrTypeAPI,
@@ -126,6 +174,54 @@ var (
 		Message: "steam api return success=false (achievements not found or permission denied)",
 		Err:     errors.New("achievements query failed"),
 	}
+
+	// ErrPrivateProfile 玩家资料或游戏详情未公开(Steam 返回 200 但内容为空)
+	ErrPrivateProfile = &SteamError{
+		Type:    ErrTypeAPI,
+		Code:    40002,
+		Message: "steam profile or game details are private",
+		Err:     errors.New("profile is not public"),
+	}
+
+	// ErrNoStats 游戏没有统计/成就数据
+	ErrNoStats = &SteamError{
+		Type:    ErrTypeAPI,
+		Code:    40003,
+		Message: "requested app has no stats",
+		Err:     errors.New("no stats"),
+	}
+
+	// ErrAppNotFound 应用不存在或无效
+	ErrAppNotFound = &SteamError{
+		Type:    ErrTypeAPI,
+		Code:    40004,
+		Message: "steam app not found",
+		Err:     errors.New("app not found"),
+	}
+
+	// ErrPrivateFriendList 好友列表未公开(Steam 返回 401), 包装 ErrPrivateProfile
+	ErrPrivateFriendList = &SteamError{
+		Type:    ErrTypeAPI,
+		Code:    40005,
+		Message: "steam friend list is private",
+		Err:     ErrPrivateProfile,
+	}
+
+	// ErrVanityURLNotFound 自定义 URL 没有对应的账号或群组
+	ErrVanityURLNotFound = &SteamError{
+		Type:    ErrTypeAPI,
+		Code:    40006,
+		Message: "steam vanity url not found",
+		Err:     errors.New("no match"),
+	}
+
+	// ErrPackageNotFound 商店礼包不存在或在当前地区不可用
+	ErrPackageNotFound = &SteamError{
+		Type:    ErrTypeAPI,
+		Code:    40007,
+		Message: "steam package not found",
+		Err:     errors.New("package not found"),
+	}
 )
 
 // New 快速创建自定义SteamError
@@ -159,6 +255,38 @@ func NewWithType(errType ErrType, msg string, err error) error {
 	}
 }
 
+// WithEndpoint 为错误附加接口名
+// SteamError 会被复制后设置 Endpoint(不修改预设错误), errors.Is 仍可匹配原预设错误; 其他错误原样返回
+// 参数:
+//   - err: 错误实例 | Error instance
+//   - endpoint: 接口名(如 IPlayerService/GetOwnedGames) | Endpoint name (e.g. IPlayerService/GetOwnedGames)
+//
+// 返回值:
+//   - error: 附加接口名后的错误 | Error carrying the endpoint
+func WithEndpoint(err error, endpoint string) error {
+	var steamErr *SteamError
+	if !errors.As(err, &steamErr) {
+		return err
+	}
+	copied := *steamErr
+	copied.Endpoint = endpoint
+	return &copied
+}
+
+// GetEndpoint 获取出错的接口名
+// 参数:
+//   - err: 错误实例 | Error instance
+//
+// 返回值:
+//   - string: 接口名(非SteamError或未设置返回空) | Endpoint name (empty for non-SteamError or unset)
+func GetEndpoint(err error) string {
+	var steamErr *SteamError
+	if errors.As(err, &steamErr) {
+		return steamErr.Endpoint
+	}
+	return ""
+}
+
 // GetType 获取错误类型
 // 参数:
 //   - err: 错误实例 | Error instance
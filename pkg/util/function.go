@@ -8,6 +8,7 @@ package util
 import (
 	"errors"
 	"fmt"
+	"net/http"
 	"os"
 	"os/exec"
 	"runtime"
@@ -59,6 +60,36 @@ func TimeString2Unix(timeStr string) (int64, error) {
 	return t.Unix(), nil
 }
 
+// ParseRetryAfter 解析 Retry-After 响应头
+// 支持秒数("120")和 HTTP 日期("Wed, 21 Oct 2015 07:28:00 GMT")两种格式
+// 参数:
+//   - value: Retry-After 头的值 | Retry-After header value
+//   - now: 当前时间(用于计算 HTTP 日期的剩余时长) | Current time (for the HTTP-date form)
+//
+// 返回值:
+//   - time.Duration: 需要等待的时长(已过期的日期返回 0) | Time to wait (0 for a date already passed)
+//   - bool: 是否解析成功 | Whether the value was parsed
+func ParseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+	if secs, err := strconv.ParseInt(value, 10, 64); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+	t, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+	if d := t.Sub(now); d > 0 {
+		return d, true
+	}
+	return 0, true
+}
+
 // String2Int 字符串转 int
 // 参数:
 //   - numString: 数字字符串 | Numeric string
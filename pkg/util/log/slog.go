@@ -0,0 +1,147 @@
+package log
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// RedactedValue 脱敏占位符 | Placeholder for redacted secrets
+const RedactedValue = "[REDACTED]"
+
+// sensitiveKeys 值需整体脱敏的字段名(小写) | Attribute keys whose whole value is redacted (lower case)
+var sensitiveKeys = map[string]bool{
+	"key":          true,
+	"api_key":      true,
+	"access_token": true,
+	"webapi_token": true,
+	"token":        true,
+	"password":     true,
+	"proxy_pass":   true,
+	"cookie":       true,
+}
+
+var (
+	// secretParamRe 匹配 URL 中的 key/access_token 等查询参数 | Matches key/access_token-like query params in URLs
+	secretParamRe = regexp.MustCompile(`(?i)([?&](?:key|api_key|access_token|webapi_token|token)=)[^&\s"'#]*`)
+	// userinfoRe 匹配 URL 中的代理认证信息 user:pass@ | Matches user:pass@ credentials in URLs
+	userinfoRe = regexp.MustCompile(`(://[^/\s:@"']+:)[^@/\s"']*@`)
+)
+
+// Redact 脱敏字符串中的 API Key、Access Token 和代理密码
+// 参数:
+//   - s: 原始字符串(URL、错误信息等) | Raw string (URL, error message, ...)
+//
+// 返回值:
+//   - string: 脱敏后的字符串 | Redacted string
+func Redact(s string) string {
+	if !strings.Contains(s, "=") && !strings.Contains(s, "@") {
+		return s
+	}
+	s = secretParamRe.ReplaceAllString(s, "${1}"+RedactedValue)
+	return userinfoRe.ReplaceAllString(s, "${1}"+RedactedValue+"@")
+}
+
+// redactHandler 脱敏 slog.Handler
+// 敏感字段名的值整体替换, 字符串/错误/URL 值中的 Key、Token 和代理密码按规则替换
+// redactHandler is a slog.Handler that redacts secrets
+// Values of sensitive keys are replaced entirely; keys, tokens and proxy passwords inside string/error/URL values are masked
+type redactHandler struct {
+	next slog.Handler
+}
+
+// NewRedactHandler 包装 slog.Handler, 输出前自动脱敏
+// 参数:
+//   - next: 下游 Handler | Downstream handler
+//
+// 返回值:
+//   - slog.Handler: 脱敏 Handler | Redacting handler
+func NewRedactHandler(next slog.Handler) slog.Handler {
+	if h, ok := next.(*redactHandler); ok {
+		return h
+	}
+	return &redactHandler{next: next}
+}
+
+// Enabled 实现 slog.Handler 接口
+func (h *redactHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+// Handle 实现 slog.Handler 接口
+func (h *redactHandler) Handle(ctx context.Context, r slog.Record) error {
+	out := slog.NewRecord(r.Time, r.Level, Redact(r.Message), r.PC)
+	r.Attrs(func(a slog.Attr) bool {
+		out.AddAttrs(redactAttr(a))
+		return true
+	})
+	return h.next.Handle(ctx, out)
+}
+
+// WithAttrs 实现 slog.Handler 接口
+func (h *redactHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	redacted := make([]slog.Attr, len(attrs))
+	for i, a := range attrs {
+		redacted[i] = redactAttr(a)
+	}
+	return &redactHandler{next: h.next.WithAttrs(redacted)}
+}
+
+// WithGroup 实现 slog.Handler 接口
+func (h *redactHandler) WithGroup(name string) slog.Handler {
+	return &redactHandler{next: h.next.WithGroup(name)}
+}
+
+// redactAttr 脱敏单个属性 | Redact one attribute
+func redactAttr(a slog.Attr) slog.Attr {
+	a.Value = a.Value.Resolve()
+	if sensitiveKeys[strings.ToLower(a.Key)] {
+		return slog.String(a.Key, RedactedValue)
+	}
+	switch a.Value.Kind() {
+	case slog.KindString:
+		a.Value = slog.StringValue(Redact(a.Value.String()))
+	case slog.KindGroup:
+		group := a.Value.Group()
+		redacted := make([]slog.Attr, len(group))
+		for i, g := range group {
+			redacted[i] = redactAttr(g)
+		}
+		a.Value = slog.GroupValue(redacted...)
+	case slog.KindAny:
+		switch v := a.Value.Any().(type) {
+		case error:
+			a.Value = slog.StringValue(Redact(v.Error()))
+		case *url.URL:
+			a.Value = slog.StringValue(Redact(v.String()))
+		case fmt.Stringer:
+			a.Value = slog.StringValue(Redact(v.String()))
+		}
+	}
+	return a
+}
+
+// NewSDKLogger 构建 SDK 内部使用的 slog.Logger
+// 传入 logger 时在其 Handler 外包装脱敏; 未传入时调试模式输出到标准输出(Debug 级别), 否则丢弃
+// 参数:
+//   - logger: 调用方 Logger(可为 nil) | Caller logger (may be nil)
+//   - debug: 是否调试模式 | Whether debug mode is on
+//
+// 返回值:
+//   - *slog.Logger: 带脱敏的 Logger | Redacting logger
+func NewSDKLogger(logger *slog.Logger, debug bool) *slog.Logger {
+	var h slog.Handler
+	switch {
+	case logger != nil:
+		h = logger.Handler()
+	case debug:
+		h = slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug})
+	default:
+		return slog.New(slog.DiscardHandler)
+	}
+	return slog.New(NewRedactHandler(h))
+}
@@ -0,0 +1,420 @@
+// Package vcr 提供录制/回放 http.RoundTripper, 用于基于真实 Steam 流量的确定性测试
+// 录制模式将每个请求/响应对保存为磁带目录中的 JSON 文件(key/access_token/Cookie 已脱敏),
+// 回放模式从磁带返回响应, 未录制的请求直接失败而不会访问网络
+// Package vcr provides a record/replay http.RoundTripper for deterministic tests built on real Steam traffic
+// Record mode saves every request/response pair as a JSON file in a cassette directory (key/access_token/cookies scrubbed),
+// replay mode answers from the cassette and fails unmatched requests instead of touching the network
+//
+//	cfg := config.NewDefaultConfig().WithVCR(util.VCR_MODE_REPLAY, "testdata/cassettes")
+//	sdk, _ := steam.NewSteamSDK(cfg)
+package vcr
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/GoFurry/gf-steam-sdk/pkg/util"
+	steamLog "github.com/GoFurry/gf-steam-sdk/pkg/util/log"
+	"github.com/bytedance/sonic"
+)
+
+// ErrInteractionNotFound 回放模式下磁带中没有匹配的请求
+// ErrInteractionNotFound is returned in replay mode when the cassette has no matching request
+var ErrInteractionNotFound = errors.New("vcr: no recorded interaction")
+
+// cassetteExt 磁带文件后缀 | Cassette file extension
+const cassetteExt = ".json"
+
+// bodyEncodingBase64 非 UTF-8 响应体的编码方式 | Encoding of non UTF-8 bodies
+const bodyEncodingBase64 = "base64"
+
+// minSecretLen 响应中按值脱敏的最短长度, 避免误伤短字符串
+// minSecretLen is the shortest secret scrubbed by value from responses, so short strings are left alone
+const minSecretLen = 8
+
+var (
+	// secretParams 需脱敏的查询/表单参数 | Query/form params that are scrubbed
+	secretParams = map[string]bool{"key": true, "api_key": true, "access_token": true, "webapi_token": true, "token": true}
+	// secretHeaders 需脱敏的请求头 | Request headers that are scrubbed
+	secretHeaders = []string{"Cookie", "Authorization", "Proxy-Authorization"}
+	// unsafeNameRe 文件名中不安全的字符 | Characters unsafe in file names
+	unsafeNameRe = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+	// cassetteJSON 磁带编码(键排序、不转义 HTML, 便于审阅和 diff) | Cassette encoding (sorted keys, unescaped HTML for readable diffs)
+	cassetteJSON = sonic.Config{SortMapKeys: true}.Froze()
+)
+
+// Interaction 一次录制的请求/响应对(磁带文件内容)
+// Interaction is one recorded request/response pair (the content of a cassette file)
+type Interaction struct {
+	Request    Request   `json:"request"`     // 请求(已脱敏) | Request (scrubbed)
+	Response   Response  `json:"response"`    // 响应(已脱敏) | Response (scrubbed)
+	RecordedAt time.Time `json:"recorded_at"` // 录制时间 | Recording time
+}
+
+// Request 录制的请求 | Recorded request
+type Request struct {
+	Method string      `json:"method"`         // HTTP 方法 | HTTP method
+	URL    string      `json:"url"`            // 地址(密钥已替换) | URL (secrets replaced)
+	Header http.Header `json:"header"`         // 请求头 | Request headers
+	Body   string      `json:"body,omitempty"` // 请求体 | Request body
+}
+
+// Response 录制的响应 | Recorded response
+type Response struct {
+	Status       int         `json:"status"`                  // 状态码 | Status code
+	Header       http.Header `json:"header"`                  // 响应头 | Response headers
+	Body         string      `json:"body"`                    // 响应体 | Response body
+	BodyEncoding string      `json:"body_encoding,omitempty"` // 为 base64 时 Body 为二进制内容的编码 | "base64" when Body encodes binary content
+}
+
+// Scrubber 写入磁带前对请求/响应对做额外脱敏 | Extra scrubbing applied before an interaction is written
+type Scrubber func(*Interaction)
+
+// Transport 录制/回放 RoundTripper, 可并发使用
+// Transport is a record/replay RoundTripper, safe for concurrent use
+type Transport struct {
+	mode      string            // 模式 record/replay | Mode record/replay
+	dir       string            // 磁带目录 | Cassette directory
+	next      http.RoundTripper // 录制时的底层 RoundTripper | Underlying RoundTripper when recording
+	scrubbers []Scrubber        // 额外脱敏 | Extra scrubbers
+
+	mu sync.Mutex // 串行化磁带写入 | Serializes cassette writes
+}
+
+// New 创建录制/回放 Transport
+// 参数:
+//   - mode: util.VCR_MODE_RECORD 或 util.VCR_MODE_REPLAY | util.VCR_MODE_RECORD or util.VCR_MODE_REPLAY
+//   - dir: 磁带目录(为空使用 util.DEFAULT_VCR_DIR) | Cassette directory (util.DEFAULT_VCR_DIR if empty)
+//   - next: 录制时的底层 RoundTripper(nil 则使用 http.DefaultTransport, 回放时不使用) | Underlying RoundTripper for recording (http.DefaultTransport if nil, unused in replay)
+//   - scrubbers: 额外脱敏(如去除页面中的登录令牌) | Extra scrubbers (e.g. strip login tokens from pages)
+//
+// 返回值:
+//   - *Transport: Transport 实例 | Transport instance
+//   - error: 模式不支持, 或磁带目录不可用(回放时无法读取, 录制时无法创建)时返回错误 | Error if the mode is unsupported or the cassette dir is unusable (unreadable for replay, not creatable for record)
+func New(mode, dir string, next http.RoundTripper, scrubbers ...Scrubber) (*Transport, error) {
+	if dir == "" {
+		dir = util.DEFAULT_VCR_DIR
+	}
+	if err := Check(mode, dir); err != nil {
+		return nil, err
+	}
+	if mode == util.VCR_MODE_RECORD {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return nil, fmt.Errorf("vcr: create cassette dir failed: %w", err)
+		}
+	}
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &Transport{mode: mode, dir: dir, next: next, scrubbers: scrubbers}, nil
+}
+
+// Check 校验模式与磁带目录, 不修改文件系统(录制目录在 New 时才创建)
+// 回放时目录必须可读取; 录制时路径已存在则必须是目录
+// Check validates the mode and cassette dir without touching the filesystem (the record dir is only created by New)
+// The dir must be readable for replay; for record an existing path must be a directory
+//
+// 参数:
+//   - mode: util.VCR_MODE_RECORD 或 util.VCR_MODE_REPLAY | util.VCR_MODE_RECORD or util.VCR_MODE_REPLAY
+//   - dir: 磁带目录(为空使用 util.DEFAULT_VCR_DIR) | Cassette directory (util.DEFAULT_VCR_DIR if empty)
+//
+// 返回值:
+//   - error: 模式不支持或磁带目录不可用时返回错误 | Error if the mode is unsupported or the cassette dir is unusable
+func Check(mode, dir string) error {
+	if !ValidMode(mode) || mode == util.VCR_MODE_OFF {
+		return fmt.Errorf("vcr: unsupported mode %q", mode)
+	}
+	if dir == "" {
+		dir = util.DEFAULT_VCR_DIR
+	}
+	if mode == util.VCR_MODE_REPLAY {
+		if _, err := os.ReadDir(dir); err != nil {
+			return fmt.Errorf("vcr: read cassette dir failed: %w", err)
+		}
+		return nil
+	}
+	if info, err := os.Stat(dir); err == nil && !info.IsDir() {
+		return fmt.Errorf("vcr: cassette path %s is not a directory", dir)
+	}
+	return nil
+}
+
+// ValidMode 是否为支持的模式(off/record/replay, 空字符串视为 off)
+// 参数:
+//   - mode: 模式 | Mode
+//
+// 返回值:
+//   - bool: 是否支持 | Whether supported
+func ValidMode(mode string) bool {
+	switch mode {
+	case "", util.VCR_MODE_OFF, util.VCR_MODE_RECORD, util.VCR_MODE_REPLAY:
+		return true
+	}
+	return false
+}
+
+// RoundTrip 实现 http.RoundTripper
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	reqBody, err := readRequestBody(req)
+	if err != nil {
+		return nil, fmt.Errorf("vcr: read request body failed: %w", err)
+	}
+	path := t.path(req, reqBody)
+
+	if t.mode == util.VCR_MODE_REPLAY {
+		return t.replay(req, path)
+	}
+	return t.record(req, reqBody, path)
+}
+
+// replay 从磁带返回响应 | Answer from the cassette
+func (t *Transport) replay(req *http.Request, path string) (*http.Response, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("%w for %s %s (cassette %s)", ErrInteractionNotFound, req.Method, steamLog.Redact(req.URL.String()), path)
+	}
+	var it Interaction
+	if err = sonic.Unmarshal(data, &it); err != nil {
+		return nil, fmt.Errorf("vcr: decode cassette %s failed: %w", path, err)
+	}
+	body := []byte(it.Response.Body)
+	if it.Response.BodyEncoding == bodyEncodingBase64 {
+		if body, err = base64.StdEncoding.DecodeString(it.Response.Body); err != nil {
+			return nil, fmt.Errorf("vcr: decode cassette %s body failed: %w", path, err)
+		}
+	}
+	return newResponse(req, it.Response.Status, it.Response.Header, body), nil
+}
+
+// record 发出真实请求并写入磁带 | Send the real request and write it to the cassette
+func (t *Transport) record(req *http.Request, reqBody []byte, path string) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	it := &Interaction{
+		Request: Request{
+			Method: req.Method,
+			URL:    scrubURL(req.URL).String(),
+			Header: scrubHeader(req.Header, secretHeaders...),
+			Body:   string(scrubForm(reqBody)),
+		},
+		Response: Response{
+			Status: resp.StatusCode,
+			Header: scrubHeader(resp.Header, "Set-Cookie"),
+		},
+		RecordedAt: time.Now().UTC(),
+	}
+	// 响应中出现的密钥原值(如页面回显的 Cookie)一并替换
+	// Secrets echoed back in the response (e.g. cookies embedded in pages) are replaced as well
+	scrubbed := respBody
+	for _, secret := range requestSecrets(req, reqBody) {
+		scrubbed = bytes.ReplaceAll(scrubbed, []byte(secret), []byte(steamLog.RedactedValue))
+	}
+	if utf8.Valid(scrubbed) {
+		it.Response.Body = string(scrubbed)
+	} else {
+		it.Response.Body = base64.StdEncoding.EncodeToString(scrubbed)
+		it.Response.BodyEncoding = bodyEncodingBase64
+	}
+	for _, s := range t.scrubbers {
+		s(it)
+	}
+
+	if err = t.write(path, it); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// write 写入磁带文件, 先写临时文件再重命名 | Write a cassette file via a temp file and rename
+func (t *Transport) write(path string, it *Interaction) error {
+	data, err := cassetteJSON.MarshalIndent(it, "", "  ")
+	if err != nil {
+		return fmt.Errorf("vcr: encode interaction failed: %w", err)
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if err = os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("vcr: create cassette dir failed: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("vcr: write cassette failed: %w", err)
+	}
+	_, err = tmp.Write(append(data, '\n'))
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), path)
+	}
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("vcr: write cassette failed: %w", err)
+	}
+	return nil
+}
+
+// path 请求对应的磁带文件
+// 按主机分目录, 文件名为路径加上方法、路径、查询参数和请求体(密钥已替换)的摘要, 因此更换 Key 不影响匹配
+// path is the cassette file of a request
+// Files are grouped by host and named after the path plus a digest of the method, path, query and body (secrets replaced),
+// so rotating keys does not break matching
+func (t *Transport) path(req *http.Request, body []byte) string {
+	u := scrubURL(req.URL)
+	h := sha256.New()
+	h.Write([]byte(req.Method + " " + u.Path + "?" + u.RawQuery + "\n"))
+	h.Write(scrubForm(body))
+	digest := hex.EncodeToString(h.Sum(nil))[:16]
+
+	name := strings.Trim(unsafeNameRe.ReplaceAllString(strings.Trim(u.Path, "/"), "_"), "_")
+	if name == "" {
+		name = "index"
+	}
+	host := unsafeNameRe.ReplaceAllString(u.Host, "_")
+	return filepath.Join(t.dir, host, name+"_"+strings.ToLower(req.Method)+"_"+digest+cassetteExt)
+}
+
+// readRequestBody 读取并还原请求体 | Read the request body and put it back
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+// scrubURL 替换密钥参数并按键排序查询参数 | Replace secret params and sort the query by key
+func scrubURL(u *url.URL) *url.URL {
+	out := *u
+	out.User = nil
+	out.RawQuery = scrubValues(u.Query()).Encode()
+	return &out
+}
+
+// scrubForm 表单请求体中的密钥参数替换为占位符 | Replace secret params in a form body
+func scrubForm(body []byte) []byte {
+	if len(body) == 0 {
+		return body
+	}
+	values, err := url.ParseQuery(string(body))
+	if err != nil {
+		return body
+	}
+	for k := range values {
+		if secretParams[strings.ToLower(k)] {
+			return []byte(scrubValues(values).Encode())
+		}
+	}
+	return body
+}
+
+// scrubValues 密钥参数替换为占位符 | Replace secret params with the placeholder
+func scrubValues(values url.Values) url.Values {
+	for k, vs := range values {
+		if secretParams[strings.ToLower(k)] {
+			for i := range vs {
+				vs[i] = steamLog.RedactedValue
+			}
+		}
+	}
+	return values
+}
+
+// scrubHeader 复制请求头并替换指定字段 | Copy headers and replace the given fields
+func scrubHeader(h http.Header, names ...string) http.Header {
+	out := h.Clone()
+	if out == nil {
+		out = http.Header{}
+	}
+	for _, name := range names {
+		if _, ok := out[http.CanonicalHeaderKey(name)]; ok {
+			out.Set(name, steamLog.RedactedValue)
+		}
+	}
+	return out
+}
+
+// requestSecrets 请求中的密钥原值(查询/表单参数、Cookie), 按长度降序
+// requestSecrets lists the raw secrets of a request (query/form params, cookies), longest first
+func requestSecrets(req *http.Request, body []byte) []string {
+	var secrets []string
+	add := func(values url.Values) {
+		for k, vs := range values {
+			if secretParams[strings.ToLower(k)] {
+				secrets = append(secrets, vs...)
+			}
+		}
+	}
+	add(req.URL.Query())
+	if form, err := url.ParseQuery(string(body)); err == nil {
+		add(form)
+	}
+	for _, c := range req.Cookies() {
+		secrets = append(secrets, c.Value)
+		if v, err := url.QueryUnescape(c.Value); err == nil && v != c.Value {
+			secrets = append(secrets, v)
+		}
+	}
+	if pass, ok := req.URL.User.Password(); ok {
+		secrets = append(secrets, pass)
+	}
+
+	out := secrets[:0]
+	for _, s := range secrets {
+		if len(s) >= minSecretLen {
+			out = append(out, s)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return len(out[i]) > len(out[j]) })
+	return out
+}
+
+// newResponse 构建回放响应 | Build a replayed response
+func newResponse(req *http.Request, status int, header http.Header, body []byte) *http.Response {
+	if header == nil {
+		header = http.Header{}
+	}
+	// 脱敏可能改变响应体长度 | Scrubbing may have changed the body length
+	header.Set("Content-Length", strconv.Itoa(len(body)))
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
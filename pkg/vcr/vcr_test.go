@@ -0,0 +1,249 @@
+package vcr_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/GoFurry/gf-steam-sdk/pkg/option"
+	"github.com/GoFurry/gf-steam-sdk/pkg/steamtest"
+	"github.com/GoFurry/gf-steam-sdk/pkg/util"
+	"github.com/GoFurry/gf-steam-sdk/pkg/vcr"
+)
+
+// cassettes 读取目录下全部磁带内容 | Read every cassette under dir
+func cassettes(t *testing.T, dir string) []string {
+	t.Helper()
+	var out []string
+	err := filepath.WalkDir(dir, func(path string, d os.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		data, err := os.ReadFile(path)
+		out = append(out, string(data))
+		return err
+	})
+	if err != nil {
+		t.Fatalf("read cassettes: %v", err)
+	}
+	return out
+}
+
+func TestRecordReplayAgainstSteamtest(t *testing.T) {
+	dir := t.TempDir()
+	srv := steamtest.NewServer()
+	defer srv.Close()
+
+	recorder, err := srv.NewSDK(srv.Config().WithVCR(util.VCR_MODE_RECORD, dir))
+	if err != nil {
+		t.Fatalf("create recording sdk: %v", err)
+	}
+	defer recorder.Close()
+	recorded, err := recorder.Develop.GetOwnedGames(steamtest.SteamID, false)
+	if err != nil {
+		t.Fatalf("record: %v", err)
+	}
+
+	files := cassettes(t, dir)
+	if len(files) != 1 {
+		t.Fatalf("want 1 cassette, got %d", len(files))
+	}
+	for _, secret := range []string{steamtest.APIKey, steamtest.AccessToken} {
+		if strings.Contains(files[0], secret) {
+			t.Fatalf("cassette leaks %q: %s", secret, files[0])
+		}
+	}
+
+	// 回放不访问服务器, 更换 Key 后仍能匹配 | Replay never reaches the server and still matches after a key change
+	srv.Reset()
+	replayer, err := srv.NewSDK(srv.Config().WithVCR(util.VCR_MODE_REPLAY, dir))
+	if err != nil {
+		t.Fatalf("create replaying sdk: %v", err)
+	}
+	defer replayer.Close()
+	replayed, err := replayer.Develop.GetOwnedGames(steamtest.SteamID, false, option.WithAPIKey("ROTATED-KEY-0000"))
+	if err != nil {
+		t.Fatalf("replay: %v", err)
+	}
+	if len(replayed) != len(recorded) {
+		t.Fatalf("replayed %d games, recorded %d", len(replayed), len(recorded))
+	}
+	if _, err = replayer.Develop.GetOwnedGames(steamtest.FriendSteamID, false); !errors.Is(err, vcr.ErrInteractionNotFound) {
+		t.Fatalf("want ErrInteractionNotFound for an unrecorded request, got %v", err)
+	}
+	if n := len(srv.Requests()); n != 0 {
+		t.Fatalf("replay reached the server %d times", n)
+	}
+}
+
+func TestRecordScrubsSecrets(t *testing.T) {
+	const secret = "SUPERSECRETVALUE"
+	// 回显请求中的密钥, 模拟页面内嵌 Cookie/Token | Echo request secrets, like pages embedding cookies or tokens
+	echo := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		http.SetCookie(w, &http.Cookie{Name: "sessionid", Value: secret})
+		_, _ = io.WriteString(w, r.URL.RawQuery+"|"+string(body)+"|"+r.Header.Get("Cookie"))
+	}))
+	defer echo.Close()
+
+	tests := []struct {
+		name   string
+		method string
+		url    string
+		body   string
+		header http.Header
+	}{
+		{name: "query key", method: http.MethodGet, url: "/?key=" + secret},
+		{name: "query access token", method: http.MethodGet, url: "/?steamid=1&access_token=" + secret},
+		{name: "form token", method: http.MethodPost, url: "/", body: "webapi_token=" + secret + "&appid=620",
+			header: http.Header{"Content-Type": {"application/x-www-form-urlencoded"}}},
+		{name: "cookie", method: http.MethodGet, url: "/", header: http.Header{"Cookie": {"steamLoginSecure=" + secret}}},
+		{name: "authorization", method: http.MethodGet, url: "/", header: http.Header{"Authorization": {"Bearer " + secret}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			rt, err := vcr.New(util.VCR_MODE_RECORD, dir, nil)
+			if err != nil {
+				t.Fatalf("new recorder: %v", err)
+			}
+			req, _ := http.NewRequest(tt.method, echo.URL+tt.url, strings.NewReader(tt.body))
+			for k, v := range tt.header {
+				req.Header[k] = v
+			}
+			resp, err := rt.RoundTrip(req)
+			if err != nil {
+				t.Fatalf("round trip: %v", err)
+			}
+			live, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+
+			// 调用方拿到的是未脱敏的真实响应 | The caller still gets the real, unscrubbed response
+			if tt.name != "authorization" && !strings.Contains(string(live), secret) {
+				t.Fatalf("live response was scrubbed: %s", live)
+			}
+			files := cassettes(t, dir)
+			if len(files) != 1 {
+				t.Fatalf("want 1 cassette, got %d", len(files))
+			}
+			if strings.Contains(files[0], secret) {
+				t.Fatalf("cassette leaks the secret: %s", files[0])
+			}
+		})
+	}
+}
+
+func TestReplayMatching(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		_, _ = io.WriteString(w, r.Method+" "+r.URL.Query().Get("appid")+" "+string(body))
+	}))
+	defer upstream.Close()
+
+	dir := t.TempDir()
+	recorder, err := vcr.New(util.VCR_MODE_RECORD, dir, nil)
+	if err != nil {
+		t.Fatalf("new recorder: %v", err)
+	}
+	send := func(rt http.RoundTripper, method, rawURL, body string) (string, error) {
+		req, _ := http.NewRequest(method, upstream.URL+rawURL, strings.NewReader(body))
+		resp, err := rt.RoundTrip(req)
+		if err != nil {
+			return "", err
+		}
+		defer resp.Body.Close()
+		data, err := io.ReadAll(resp.Body)
+		return string(data), err
+	}
+	if _, err = send(recorder, http.MethodGet, "/x?appid=620&key=KEY-ONE-00000000&cc=us", ""); err != nil {
+		t.Fatalf("record get: %v", err)
+	}
+	if _, err = send(recorder, http.MethodPost, "/x", "appid=620&access_token=TOKEN-ONE-000000"); err != nil {
+		t.Fatalf("record post: %v", err)
+	}
+
+	replayer, err := vcr.New(util.VCR_MODE_REPLAY, dir, nil)
+	if err != nil {
+		t.Fatalf("new replayer: %v", err)
+	}
+	tests := []struct {
+		name   string
+		method string
+		url    string
+		body   string
+		want   string // 回放响应的前缀, 空表示未匹配 | Prefix of the replayed body, empty means no match
+	}{
+		{"same request", http.MethodGet, "/x?appid=620&key=KEY-ONE-00000000&cc=us", "", "GET 620"},
+		{"reordered query and other key", http.MethodGet, "/x?cc=us&key=KEY-TWO-00000000&appid=620", "", "GET 620"},
+		{"different query", http.MethodGet, "/x?appid=570&key=KEY-ONE-00000000&cc=us", "", ""},
+		{"different method", http.MethodPost, "/x?appid=620&key=KEY-ONE-00000000&cc=us", "", ""},
+		{"form with other token", http.MethodPost, "/x", "appid=620&access_token=TOKEN-TWO-000000", "POST  appid=620"},
+		{"different form", http.MethodPost, "/x", "appid=570&access_token=TOKEN-ONE-000000", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := send(replayer, tt.method, tt.url, tt.body)
+			if tt.want == "" {
+				if !errors.Is(err, vcr.ErrInteractionNotFound) {
+					t.Fatalf("want ErrInteractionNotFound, got %q, %v", got, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("replay: %v", err)
+			}
+			if !strings.HasPrefix(got, tt.want) {
+				t.Fatalf("want %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestNewRejectsBadSetup(t *testing.T) {
+	if _, err := vcr.New("rewind", t.TempDir(), nil); err == nil {
+		t.Fatal("want an error for an unsupported mode")
+	}
+	if _, err := vcr.New(util.VCR_MODE_REPLAY, filepath.Join(t.TempDir(), "missing"), nil); err == nil {
+		t.Fatal("want an error for a missing replay dir")
+	}
+}
+
+func TestCheckHasNoSideEffects(t *testing.T) {
+	srv := steamtest.NewServer()
+	defer srv.Close()
+	dir := filepath.Join(t.TempDir(), "cassettes")
+
+	cfg := srv.Config().WithVCR(util.VCR_MODE_RECORD, dir)
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("validate: %v", err)
+	}
+	if _, err := os.Stat(dir); !os.IsNotExist(err) {
+		t.Fatalf("Validate created the cassette dir: %v", err)
+	}
+
+	// 构建 Transport 时才创建目录 | The dir is only created when the transport is built
+	sdk, err := srv.NewSDK(cfg)
+	if err != nil {
+		t.Fatalf("create sdk: %v", err)
+	}
+	defer sdk.Close()
+	if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+		t.Fatalf("want the cassette dir created with the sdk, got %v", err)
+	}
+
+	file := filepath.Join(t.TempDir(), "file")
+	if err = os.WriteFile(file, nil, 0644); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+	if err = vcr.Check(util.VCR_MODE_RECORD, file); err == nil {
+		t.Fatal("want an error for a record path that is a file")
+	}
+	if err = vcr.Check(util.VCR_MODE_REPLAY, filepath.Join(t.TempDir(), "missing")); err == nil {
+		t.Fatal("want an error for a missing replay dir")
+	}
+}